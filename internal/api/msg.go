@@ -18,6 +18,8 @@ import (
 	"github.com/Meikwei/aetim/pkg/apistruct"
 	"github.com/Meikwei/aetim/pkg/authverify"
 	"github.com/Meikwei/aetim/pkg/common/config"
+	"github.com/Meikwei/aetim/pkg/msgprocessor"
+	"github.com/Meikwei/aetim/pkg/protocol/msgext"
 	"github.com/Meikwei/aetim/pkg/rpcclient"
 	"github.com/Meikwei/go-tools/a2r"
 	"github.com/Meikwei/go-tools/apiresp"
@@ -85,11 +87,15 @@ func (m MessageApi) newUserSendMsgReq(_ *gin.Context, params *apistruct.SendMsg)
 	if params.NotOfflinePush {
 		datautil.SetSwitchFromOptions(options, constant.IsOfflinePush, false)
 	}
+	clientMsgID := params.ClientMsgID
+	if clientMsgID == "" {
+		clientMsgID = idutil.GetMsgIDByMD5(params.SendID)
+	}
 	pbData := msg.SendMsgReq{
 		MsgData: &sdkws.MsgData{
 			SendID:           params.SendID,
 			GroupID:          params.GroupID,
-			ClientMsgID:      idutil.GetMsgIDByMD5(params.SendID),
+			ClientMsgID:      clientMsgID,
 			SenderPlatformID: params.SenderPlatformID,
 			SenderNickname:   params.SenderNickname,
 			SenderFaceURL:    params.SenderFaceURL,
@@ -118,6 +124,134 @@ func (m *MessageApi) RevokeMsg(c *gin.Context) {
 	a2r.Call(msg.MsgClient.RevokeMsg, m.Client, c)
 }
 
+func (m *MessageApi) EditMsg(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.EditMsg, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetMsgEditHistory(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetMsgEditHistory, m.ExtClient, c)
+}
+
+func (m *MessageApi) ScheduleMsg(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.ScheduleMsg, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetScheduledMsgs(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetScheduledMsgs, m.ExtClient, c)
+}
+
+func (m *MessageApi) CancelScheduledMsg(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.CancelScheduledMsg, m.ExtClient, c)
+}
+
+func (m *MessageApi) RescheduleMsg(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.RescheduleMsg, m.ExtClient, c)
+}
+
+func (m *MessageApi) AddMsgReaction(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.AddMsgReaction, m.ExtClient, c)
+}
+
+func (m *MessageApi) RemoveMsgReaction(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.RemoveMsgReaction, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetMsgReactions(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetMsgReactions, m.ExtClient, c)
+}
+
+func (m *MessageApi) CreateThread(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.CreateThread, m.ExtClient, c)
+}
+
+func (m *MessageApi) SendThreadMsg(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.SendThreadMsg, m.ExtClient, c)
+}
+
+func (m *MessageApi) PullThreadMsgs(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.PullThreadMsgs, m.ExtClient, c)
+}
+
+func (m *MessageApi) SubscribeThread(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.SubscribeThread, m.ExtClient, c)
+}
+
+func (m *MessageApi) MarkThreadAsRead(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.MarkThreadAsRead, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetUserThreads(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetUserThreads, m.ExtClient, c)
+}
+
+func (m *MessageApi) PinMsg(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.PinMsg, m.ExtClient, c)
+}
+
+func (m *MessageApi) UnpinMsg(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.UnpinMsg, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetPinnedMsgs(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetPinnedMsgs, m.ExtClient, c)
+}
+
+func (m *MessageApi) SearchConversationMsgs(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.SearchConversationMsgs, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetGroupMsgReaders(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetGroupMsgReaders, m.ExtClient, c)
+}
+
+func (m *MessageApi) SetRetentionPolicy(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.SetRetentionPolicy, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetRetentionPolicy(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetRetentionPolicy, m.ExtClient, c)
+}
+
+func (m *MessageApi) CreateMsgExportJob(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.CreateMsgExportJob, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetMsgExportJob(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetMsgExportJob, m.ExtClient, c)
+}
+
+func (m *MessageApi) CreateBroadcastJob(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.CreateBroadcastJob, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetBroadcastJob(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetBroadcastJob, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetBroadcastJobs(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetBroadcastJobs, m.ExtClient, c)
+}
+
+func (m *MessageApi) PauseBroadcastJob(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.PauseBroadcastJob, m.ExtClient, c)
+}
+
+func (m *MessageApi) ResumeBroadcastJob(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.ResumeBroadcastJob, m.ExtClient, c)
+}
+
+func (m *MessageApi) CancelBroadcastJob(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.CancelBroadcastJob, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetBroadcastFailures(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetBroadcastFailures, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetUnreadCount(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetUnreadCount, m.ExtClient, c)
+}
+
 func (m *MessageApi) MarkMsgsAsRead(c *gin.Context) {
 	a2r.Call(msg.MsgClient.MarkMsgsAsRead, m.Client, c)
 }
@@ -244,7 +378,12 @@ func (m *MessageApi) SendMessage(c *gin.Context) {
 	}
 
 	// Respond with a success message and the response payload.
-	apiresp.GinSuccess(c, respPb)
+	apiresp.GinSuccess(c, &apistruct.SendMsgResp{
+		ServerMsgID: respPb.ServerMsgID,
+		ClientMsgID: respPb.ClientMsgID,
+		SendTime:    respPb.SendTime,
+		Seq:         msgprocessor.GetSendMsgRespSeq(respPb),
+	})
 }
 
 func (m *MessageApi) SendBusinessNotification(c *gin.Context) {
@@ -334,6 +473,8 @@ func (m *MessageApi) BatchSendMsg(c *gin.Context) {
 	}
 	for _, recvID := range recvIDs {
 		sendMsgReq.MsgData.RecvID = recvID
+		// every receiver gets its own message, sends with the same client msg ID are deduplicated
+		sendMsgReq.MsgData.ClientMsgID = idutil.GetMsgIDByMD5(sendMsgReq.MsgData.SendID)
 		rpcResp, err := m.Client.SendMsg(c, sendMsgReq)
 		if err != nil {
 			resp.FailedIDs = append(resp.FailedIDs, recvID)
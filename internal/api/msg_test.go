@@ -0,0 +1,42 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/Meikwei/aetim/pkg/apistruct"
+	"github.com/Meikwei/protocol/constant"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUserSendMsgReqClientMsgID(t *testing.T) {
+	var m MessageApi
+	params := &apistruct.SendMsg{
+		SendID:      "u1",
+		ContentType: constant.Text,
+		SessionType: constant.SingleChatType,
+		Content:     map[string]any{"content": "hello"},
+	}
+
+	// a retry with the client's ClientMsgID keeps it, so the msg rpc can dedup the send
+	params.ClientMsgID = "client-msg-id"
+	assert.Equal(t, "client-msg-id", m.newUserSendMsgReq(nil, params).MsgData.ClientMsgID)
+
+	params.ClientMsgID = ""
+	first := m.newUserSendMsgReq(nil, params).MsgData.ClientMsgID
+	assert.NotEmpty(t, first)
+	assert.NotEqual(t, first, m.newUserSendMsgReq(nil, params).MsgData.ClientMsgID)
+}
@@ -60,6 +60,8 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 		userRouterGroup.POST("/get_users", ParseToken, u.GetUsers)
 		userRouterGroup.POST("/get_users_online_status", ParseToken, u.GetUsersOnlineStatus)
 		userRouterGroup.POST("/get_users_online_token_detail", ParseToken, u.GetUsersOnlineTokenDetail)
+		userRouterGroup.POST("/get_user_conns", ParseToken, u.GetUserConns)
+		userRouterGroup.POST("/kick_user_conn", ParseToken, u.KickUserConn)
 		userRouterGroup.POST("/subscribe_users_status", ParseToken, u.SubscriberStatus)
 		userRouterGroup.POST("/get_users_status", ParseToken, u.GetUserStatus)
 		userRouterGroup.POST("/get_subscribe_users_status", ParseToken, u.GetSubscribeUsersStatus)
@@ -167,6 +169,38 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 		msgGroup.POST("/send_business_notification", m.SendBusinessNotification)
 		msgGroup.POST("/pull_msg_by_seq", m.PullMsgBySeqs)
 		msgGroup.POST("/revoke_msg", m.RevokeMsg)
+		msgGroup.POST("/edit_msg", m.EditMsg)
+		msgGroup.POST("/get_msg_edit_history", m.GetMsgEditHistory)
+		msgGroup.POST("/schedule_msg", m.ScheduleMsg)
+		msgGroup.POST("/get_scheduled_msgs", m.GetScheduledMsgs)
+		msgGroup.POST("/cancel_scheduled_msg", m.CancelScheduledMsg)
+		msgGroup.POST("/reschedule_msg", m.RescheduleMsg)
+		msgGroup.POST("/add_msg_reaction", m.AddMsgReaction)
+		msgGroup.POST("/remove_msg_reaction", m.RemoveMsgReaction)
+		msgGroup.POST("/get_msg_reactions", m.GetMsgReactions)
+		msgGroup.POST("/create_thread", m.CreateThread)
+		msgGroup.POST("/send_thread_msg", m.SendThreadMsg)
+		msgGroup.POST("/pull_thread_msgs", m.PullThreadMsgs)
+		msgGroup.POST("/subscribe_thread", m.SubscribeThread)
+		msgGroup.POST("/mark_thread_as_read", m.MarkThreadAsRead)
+		msgGroup.POST("/get_user_threads", m.GetUserThreads)
+		msgGroup.POST("/pin_msg", m.PinMsg)
+		msgGroup.POST("/unpin_msg", m.UnpinMsg)
+		msgGroup.POST("/get_pinned_msgs", m.GetPinnedMsgs)
+		msgGroup.POST("/search_conversation_msgs", m.SearchConversationMsgs)
+		msgGroup.POST("/get_group_msg_readers", m.GetGroupMsgReaders)
+		msgGroup.POST("/set_retention_policy", m.SetRetentionPolicy)
+		msgGroup.POST("/get_retention_policy", m.GetRetentionPolicy)
+		msgGroup.POST("/create_msg_export_job", m.CreateMsgExportJob)
+		msgGroup.POST("/get_msg_export_job", m.GetMsgExportJob)
+		msgGroup.POST("/create_broadcast_job", m.CreateBroadcastJob)
+		msgGroup.POST("/get_broadcast_job", m.GetBroadcastJob)
+		msgGroup.POST("/get_broadcast_jobs", m.GetBroadcastJobs)
+		msgGroup.POST("/pause_broadcast_job", m.PauseBroadcastJob)
+		msgGroup.POST("/resume_broadcast_job", m.ResumeBroadcastJob)
+		msgGroup.POST("/cancel_broadcast_job", m.CancelBroadcastJob)
+		msgGroup.POST("/get_broadcast_failures", m.GetBroadcastFailures)
+		msgGroup.POST("/get_unread_count", m.GetUnreadCount)
 		msgGroup.POST("/mark_msgs_as_read", m.MarkMsgsAsRead)
 		msgGroup.POST("/mark_conversation_as_read", m.MarkConversationAsRead)
 		msgGroup.POST("/get_conversations_has_read_and_max_seq", m.GetConversationsHasReadAndMaxSeq)
@@ -182,6 +216,19 @@ func newGinRouter(disCov discovery.SvcDiscoveryRegistry, config *Config) *gin.En
 		msgGroup.POST("/check_msg_is_send_success", m.CheckMsgIsSendSuccess)
 		msgGroup.POST("/get_server_time", m.GetServerTime)
 	}
+	// Signal
+	signalGroup := r.Group("/signal", ParseToken)
+	{
+		s := NewSignalApi(*messageRpc)
+		signalGroup.POST("/signal_message_assemble", s.SignalMessageAssemble)
+		signalGroup.POST("/get_room_by_group_id", s.SignalGetRoomByGroupID)
+		signalGroup.POST("/get_rooms", s.SignalGetRooms)
+		signalGroup.POST("/get_invitation_info", s.GetSignalInvitationInfo)
+		signalGroup.POST("/get_invitation_info_start_app", s.GetSignalInvitationInfoStartApp)
+		signalGroup.POST("/send_custom_signal", s.SignalSendCustomSignal)
+		signalGroup.POST("/get_invitation_records", s.GetSignalInvitationRecords)
+		signalGroup.POST("/delete_records", s.DeleteSignalRecords)
+	}
 	// Conversation
 	conversationGroup := r.Group("/conversation", ParseToken)
 	{
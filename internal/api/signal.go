@@ -0,0 +1,60 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"github.com/Meikwei/aetim/pkg/rpcclient"
+	"github.com/Meikwei/go-tools/a2r"
+	"github.com/Meikwei/protocol/rtc"
+	"github.com/gin-gonic/gin"
+)
+
+type SignalApi rpcclient.Message
+
+func NewSignalApi(client rpcclient.Message) SignalApi {
+	return SignalApi(client)
+}
+
+func (o *SignalApi) SignalMessageAssemble(c *gin.Context) {
+	a2r.Call(rtc.RtcServiceClient.SignalMessageAssemble, o.RtcClient, c)
+}
+
+func (o *SignalApi) SignalGetRoomByGroupID(c *gin.Context) {
+	a2r.Call(rtc.RtcServiceClient.SignalGetRoomByGroupID, o.RtcClient, c)
+}
+
+func (o *SignalApi) SignalGetRooms(c *gin.Context) {
+	a2r.Call(rtc.RtcServiceClient.SignalGetRooms, o.RtcClient, c)
+}
+
+func (o *SignalApi) GetSignalInvitationInfo(c *gin.Context) {
+	a2r.Call(rtc.RtcServiceClient.GetSignalInvitationInfo, o.RtcClient, c)
+}
+
+func (o *SignalApi) GetSignalInvitationInfoStartApp(c *gin.Context) {
+	a2r.Call(rtc.RtcServiceClient.GetSignalInvitationInfoStartApp, o.RtcClient, c)
+}
+
+func (o *SignalApi) SignalSendCustomSignal(c *gin.Context) {
+	a2r.Call(rtc.RtcServiceClient.SignalSendCustomSignal, o.RtcClient, c)
+}
+
+func (o *SignalApi) GetSignalInvitationRecords(c *gin.Context) {
+	a2r.Call(rtc.RtcServiceClient.GetSignalInvitationRecords, o.RtcClient, c)
+}
+
+func (o *SignalApi) DeleteSignalRecords(c *gin.Context) {
+	a2r.Call(rtc.RtcServiceClient.DeleteSignalRecords, o.RtcClient, c)
+}
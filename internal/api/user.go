@@ -15,6 +15,7 @@
 package api
 
 import (
+	"github.com/Meikwei/aetim/pkg/protocol/gatewayadmin"
 	"github.com/Meikwei/aetim/pkg/rpcclient"
 	"github.com/Meikwei/go-tools/a2r"
 	"github.com/Meikwei/go-tools/apiresp"
@@ -119,6 +120,64 @@ func (u *UserApi) GetUsersOnlineStatus(c *gin.Context) {
 	apiresp.GinSuccess(c, respResult)
 }
 
+// GetUserConns lists the live connections of a user across all gateways.
+func (u *UserApi) GetUserConns(c *gin.Context) {
+	req, err := a2r.ParseRequest[gatewayadmin.GetUserConnsReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	conns, err := u.Discov.GetConns(c, u.MessageGateWayRpcName)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	var resp gatewayadmin.GetUserConnsResp
+	for _, v := range conns {
+		reply, err := gatewayadmin.NewGatewayAdminClient(v).GetUserConns(c, req)
+		if err != nil {
+			log.ZDebug(c, "GetUserConns rpc error", err)
+			if apiresp.ParseError(err).ErrCode == errs.NoPermissionError {
+				apiresp.GinError(c, err)
+				return
+			}
+			continue
+		}
+		resp.Conns = append(resp.Conns, reply.Conns...)
+	}
+	apiresp.GinSuccess(c, &resp)
+}
+
+// KickUserConn kicks a single connection by connID, on whichever gateway holds it.
+func (u *UserApi) KickUserConn(c *gin.Context) {
+	req, err := a2r.ParseRequest[gatewayadmin.KickConnReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	conns, err := u.Discov.GetConns(c, u.MessageGateWayRpcName)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	for _, v := range conns {
+		reply, err := gatewayadmin.NewGatewayAdminClient(v).KickConn(c, req)
+		if err != nil {
+			log.ZDebug(c, "KickConn rpc error", err)
+			if apiresp.ParseError(err).ErrCode == errs.NoPermissionError {
+				apiresp.GinError(c, err)
+				return
+			}
+			continue
+		}
+		if reply.Kicked {
+			apiresp.GinSuccess(c, reply)
+			return
+		}
+	}
+	apiresp.GinError(c, errs.ErrRecordNotFound.WrapMsg("conn not found", "userID", req.UserID, "connID", req.ConnID))
+}
+
 func (u *UserApi) UserRegisterCount(c *gin.Context) {
 	a2r.Call(user.UserClient.UserRegisterCount, u.Client, c)
 }
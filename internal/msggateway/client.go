@@ -18,8 +18,10 @@ import (
 	"context"
 	"fmt"
 	"runtime/debug"
+	"strconv"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/Meikwei/aetim/pkg/msgprocessor"
 	"github.com/Meikwei/go-tools/apiresp"
@@ -65,13 +67,23 @@ type Client struct {
 	conn           LongConn
 	PlatformID     int    `json:"platformID"`
 	IsCompress     bool   `json:"isCompress"`
+	Compression    string `json:"compression"`
+	Encoding       string `json:"encoding"`
 	UserID         string `json:"userID"`
 	IsBackground   bool   `json:"isBackground"`
 	ctx            *UserConnContext
 	longConnServer LongConnServer
+	encoder        Encoder
+	compressor     Compressor
 	closed         atomic.Bool
-	closedErr      error
+	closedErrMu    sync.Mutex
+	closedErr      error // why the connection closed, guarded by closedErrMu
 	token          string
+	resume         *resumeState
+	limit          *connLimit
+	queue          *writeQueue
+	connectTime    time.Time
+	bytesIn        atomic.Int64
 }
 
 // ResetClient updates the client's state with new connection and context information.
@@ -79,18 +91,33 @@ func (c *Client) ResetClient(ctx *UserConnContext, conn LongConn, longConnServer
 	c.w = new(sync.Mutex)
 	c.conn = conn
 	c.PlatformID = stringutil.StringToInt(ctx.GetPlatformID())
-	c.IsCompress = ctx.GetCompression()
+	c.Compression = longConnServer.negotiateCompression(ctx)
+	c.compressor, c.IsCompress = NewCompressor(c.Compression)
+	c.Encoding = ctx.GetEncoding()
+	if encoder, ok := NewEncoder(c.Encoding); ok {
+		c.encoder = encoder
+	} else {
+		c.encoder = longConnServer
+	}
 	c.IsBackground = ctx.GetBackground()
 	c.UserID = ctx.GetUserID()
 	c.ctx = ctx
 	c.longConnServer = longConnServer
 	c.IsBackground = false
 	c.closed.Store(false)
-	c.closedErr = nil
+	c.closedErrMu.Lock()
+	c.setClosedErr(nil)
+	c.closedErrMu.Unlock()
 	c.token = ctx.GetToken()
+	c.resume = new(resumeState)
+	c.limit = nil
+	c.queue = nil
+	c.connectTime = time.Now()
+	c.bytesIn.Store(0)
 }
 
 func (c *Client) pingHandler(_ string) error {
+	c.longConnServer.refreshResume(c)
 	if err := c.conn.SetReadDeadline(pongWait); err != nil {
 		return err
 	}
@@ -98,11 +125,27 @@ func (c *Client) pingHandler(_ string) error {
 	return c.writePongMsg()
 }
 
+// setClosedErr records why the connection closed, the first reason wins. Besides readMessage it is called
+// by the pusher when the write queue overflows and by the drain.
+func (c *Client) setClosedErr(err error) {
+	c.closedErrMu.Lock()
+	defer c.closedErrMu.Unlock()
+	if c.closedErr == nil {
+		c.closedErr = err
+	}
+}
+
+func (c *Client) getClosedErr() error {
+	c.closedErrMu.Lock()
+	defer c.closedErrMu.Unlock()
+	return c.closedErr
+}
+
 // readMessage continuously reads messages from the connection.
 func (c *Client) readMessage() {
 	defer func() {
 		if r := recover(); r != nil {
-			c.closedErr = ErrPanic
+			c.setClosedErr(ErrPanic)
 			fmt.Println("socket have panic err:", r, string(debug.Stack()))
 		}
 		c.close()
@@ -117,14 +160,16 @@ func (c *Client) readMessage() {
 		messageType, message, returnErr := c.conn.ReadMessage()
 		if returnErr != nil {
 			log.ZWarn(c.ctx, "readMessage", returnErr, "messageType", messageType)
-			c.closedErr = returnErr
+			c.setClosedErr(returnErr)
 			return
 		}
 
 		log.ZDebug(c.ctx, "readMessage", "messageType", messageType)
+		c.bytesIn.Add(int64(len(message)))
+		c.longConnServer.refreshResume(c)
 		if c.closed.Load() {
 			// The scenario where the connection has just been closed, but the coroutine has not exited
-			c.closedErr = ErrConnClosed
+			c.setClosedErr(ErrConnClosed)
 			return
 		}
 
@@ -133,19 +178,28 @@ func (c *Client) readMessage() {
 			_ = c.conn.SetReadDeadline(pongWait)
 			parseDataErr := c.handleMessage(message)
 			if parseDataErr != nil {
-				c.closedErr = parseDataErr
+				c.setClosedErr(parseDataErr)
 				return
 			}
 		case MessageText:
-			c.closedErr = ErrNotSupportMessageProtocol
-			return
+			if c.Encoding != JsonEncodingProtocol {
+				c.setClosedErr(ErrNotSupportMessageProtocol)
+				return
+			}
+			_ = c.conn.SetReadDeadline(pongWait)
+			parseDataErr := c.handleMessage(message)
+			if parseDataErr != nil {
+				c.setClosedErr(parseDataErr)
+				return
+			}
 
 		case PingMessage:
 			err := c.writePongMsg()
 			log.ZError(c.ctx, "writePongMsg", err)
 
 		case CloseMessage:
-			c.closedErr = ErrClientClosed
+			c.discardResume()
+			c.setClosedErr(ErrClientClosed)
 			return
 		default:
 		}
@@ -156,7 +210,7 @@ func (c *Client) readMessage() {
 func (c *Client) handleMessage(message []byte) error {
 	if c.IsCompress {
 		var err error
-		message, err = c.longConnServer.DecompressWithPool(message)
+		message, err = c.compressor.DecompressWithPool(message)
 		if err != nil {
 			return errs.Wrap(err)
 		}
@@ -165,7 +219,7 @@ func (c *Client) handleMessage(message []byte) error {
 	var binaryReq = getReq()
 	defer freeReq(binaryReq)
 
-	err := c.longConnServer.Decode(message, binaryReq)
+	err := c.encoder.Decode(message, binaryReq)
 	if err != nil {
 		return err
 	}
@@ -184,6 +238,18 @@ func (c *Client) handleMessage(message []byte) error {
 
 	log.ZDebug(ctx, "gateway req message", "req", binaryReq.String())
 
+	if kick, err := c.longConnServer.allowRequest(c, binaryReq.ReqIdentifier); err != nil {
+		log.ZWarn(ctx, "gateway req rate limited", err, "reqIdentifier", binaryReq.ReqIdentifier, "kick", kick)
+		if !kick {
+			return c.replyMessage(ctx, binaryReq, err, nil)
+		}
+		_ = c.replyMessage(ctx, binaryReq, err, nil)
+		if err := c.longConnServer.KickUserConn(c); err != nil {
+			log.ZWarn(ctx, "kick rate limited conn failed", err)
+		}
+		return errs.New("conn kicked for exceeding rate limits", "reqIdentifier", binaryReq.ReqIdentifier).Wrap()
+	}
+
 	var (
 		resp       []byte
 		messageErr error
@@ -234,6 +300,9 @@ func (c *Client) close() {
 	defer c.w.Unlock()
 
 	c.closed.Store(true)
+	if c.queue != nil {
+		c.queue.close()
+	}
 	c.conn.Close()
 	c.longConnServer.UnRegister(c)
 }
@@ -255,12 +324,14 @@ func (c *Client) replyMessage(ctx context.Context, binaryReq *Req, err error, re
 	}
 
 	if binaryReq.ReqIdentifier == WsLogoutMsg {
+		c.discardResume()
 		return errs.New("user logout", "operationID", binaryReq.OperationID).Wrap()
 	}
 	return nil
 }
 
-func (c *Client) PushMessage(ctx context.Context, msgData *sdkws.MsgData) error {
+// NewPushMessageData marshals msgData into the payload of a WSPushMsg frame.
+func NewPushMessageData(ctx context.Context, msgData *sdkws.MsgData) ([]byte, error) {
 	var msg sdkws.PushMessages
 	conversationID := msgprocessor.GetConversationIDByMsg(msgData)
 	m := map[string]*sdkws.PullMsgs{conversationID: {Msgs: []*sdkws.MsgData{msgData}}}
@@ -272,14 +343,44 @@ func (c *Client) PushMessage(ctx context.Context, msgData *sdkws.MsgData) error
 	log.ZDebug(ctx, "PushMessage", "msg", &msg)
 	data, err := proto.Marshal(&msg)
 	if err != nil {
-		return err
+		return nil, errs.Wrap(err)
 	}
-	resp := Resp{
+	return data, nil
+}
+
+// PushMessage queues a push built by NewPushMessageData. pushID is the ID the push was
+// buffered under for session resume, or 0 if it was not buffered.
+func (c *Client) PushMessage(ctx context.Context, data []byte, pushID int64) error {
+	c.resume.mu.Lock()
+	defer c.resume.mu.Unlock()
+	// The push is in the ring of the session being resumed, or was already replayed
+	if c.resume.pending || (pushID > 0 && pushID <= c.resume.replayedTo) {
+		return nil
+	}
+	return c.enqueue(newPushResp(ctx, data, pushID))
+}
+
+func (c *Client) writePushMsg(ctx context.Context, data []byte, pushID int64) error {
+	return c.writeBinaryMsg(*newPushResp(ctx, data, pushID))
+}
+
+func newPushResp(ctx context.Context, data []byte, pushID int64) *Resp {
+	resp := &Resp{
 		ReqIdentifier: WSPushMsg,
 		OperationID:   mcontext.GetOperationID(ctx),
 		Data:          data,
 	}
-	return c.writeBinaryMsg(resp)
+	if pushID > 0 {
+		resp.MsgIncr = strconv.FormatInt(pushID, 10)
+	}
+	return resp
+}
+
+// discardResume prevents the session from being resumed once the connection closes.
+func (c *Client) discardResume() {
+	c.resume.mu.Lock()
+	c.resume.discard = true
+	c.resume.mu.Unlock()
 }
 
 func (c *Client) KickOnlineMessage() error {
@@ -296,7 +397,7 @@ func (c *Client) writeBinaryMsg(resp Resp) error {
 		return nil
 	}
 
-	encodedBuf, err := c.longConnServer.Encode(resp)
+	encodedBuf, err := c.encoder.Encode(resp)
 	if err != nil {
 		return err
 	}
@@ -309,15 +410,17 @@ func (c *Client) writeBinaryMsg(resp Resp) error {
 		return err
 	}
 
+	messageType := MessageBinary
 	if c.IsCompress {
-		resultBuf, compressErr := c.longConnServer.CompressWithPool(encodedBuf)
+		resultBuf, compressErr := c.compressor.CompressWithPool(encodedBuf)
 		if compressErr != nil {
 			return compressErr
 		}
-		return c.conn.WriteMessage(MessageBinary, resultBuf)
+		encodedBuf = resultBuf
+	} else if c.Encoding == JsonEncodingProtocol {
+		messageType = MessageText
 	}
-
-	return c.conn.WriteMessage(MessageBinary, encodedBuf)
+	return c.conn.WriteMessage(messageType, encodedBuf)
 }
 
 func (c *Client) writePongMsg() error {
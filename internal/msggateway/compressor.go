@@ -21,11 +21,15 @@ import (
 	"sync"
 
 	"github.com/Meikwei/go-tools/errs"
+	"github.com/klauspost/compress/zstd"
 )
 
 var (
 	gzipWriterPool = sync.Pool{New: func() any { return gzip.NewWriter(nil) }}
 	gzipReaderPool = sync.Pool{New: func() any { return new(gzip.Reader) }}
+
+	gzipCompressor = NewGzipCompressor()
+	zstdCompressor = NewZstdCompressor()
 )
 
 type Compressor interface {
@@ -111,3 +115,76 @@ func (g *GzipCompressor) DecompressWithPool(compressedData []byte) ([]byte, erro
 	}
 	return decompressedData, nil
 }
+
+type ZstdCompressor struct {
+	compressProtocol string
+	encoder          *zstd.Encoder
+	decoder          *zstd.Decoder
+}
+
+func NewZstdCompressor() *ZstdCompressor {
+	// EncodeAll and DecodeAll may be called concurrently, so a single encoder and
+	// decoder are shared by all connections instead of being pooled. Decoded output
+	// is capped at the websocket message size so a small frame cannot expand without bound.
+	encoder, _ := zstd.NewWriter(nil)
+	decoder, _ := zstd.NewReader(nil, zstd.WithDecoderMaxMemory(maxMessageSize))
+	return &ZstdCompressor{compressProtocol: "zstd", encoder: encoder, decoder: decoder}
+}
+
+func (z *ZstdCompressor) Compress(rawData []byte) ([]byte, error) {
+	zstdBuffer := bytes.Buffer{}
+	zw, err := zstd.NewWriter(&zstdBuffer)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "ZstdCompressor.Compress: NewWriter creation failed")
+	}
+
+	if _, err := zw.Write(rawData); err != nil {
+		return nil, errs.WrapMsg(err, "ZstdCompressor.Compress: writing to zstd writer failed")
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, errs.WrapMsg(err, "ZstdCompressor.Compress: closing zstd writer failed")
+	}
+
+	return zstdBuffer.Bytes(), nil
+}
+
+func (z *ZstdCompressor) CompressWithPool(rawData []byte) ([]byte, error) {
+	return z.encoder.EncodeAll(rawData, make([]byte, 0, len(rawData))), nil
+}
+
+func (z *ZstdCompressor) DeCompress(compressedData []byte) ([]byte, error) {
+	reader, err := zstd.NewReader(bytes.NewReader(compressedData), zstd.WithDecoderMaxMemory(maxMessageSize))
+	if err != nil {
+		return nil, errs.WrapMsg(err, "ZstdCompressor.DeCompress: NewReader creation failed")
+	}
+	defer reader.Close()
+	decompressedData, err := io.ReadAll(io.LimitReader(reader, maxMessageSize+1))
+	if err != nil {
+		return nil, errs.WrapMsg(err, "ZstdCompressor.DeCompress: reading from zstd reader failed")
+	}
+	if len(decompressedData) > maxMessageSize {
+		return nil, errs.New("ZstdCompressor.DeCompress: decompressed data exceeds max message size").Wrap()
+	}
+	return decompressedData, nil
+}
+
+func (z *ZstdCompressor) DecompressWithPool(compressedData []byte) ([]byte, error) {
+	decompressedData, err := z.decoder.DecodeAll(compressedData, nil)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "ZstdCompressor.DecompressWithPool: decoding failed")
+	}
+	return decompressedData, nil
+}
+
+// NewCompressor returns the Compressor for the given compression protocol.
+func NewCompressor(compression string) (Compressor, bool) {
+	switch compression {
+	case GzipCompressionProtocol:
+		return gzipCompressor, true
+	case ZstdCompressionProtocol:
+		return zstdCompressor, true
+	default:
+		return nil, false
+	}
+}
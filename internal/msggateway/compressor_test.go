@@ -86,6 +86,44 @@ func TestCompressDecompressWithConcurrency(t *testing.T) {
 	wg.Wait()
 }
 
+func TestZstdCompressDecompress(t *testing.T) {
+	compressor := NewZstdCompressor()
+
+	for i := 0; i < 2000; i++ {
+		src := mockRandom()
+
+		// the pooled and the streaming variants must be interchangeable
+		dest, err := compressor.CompressWithPool(src)
+		assert.Equal(t, nil, err)
+		res, err := compressor.DeCompress(dest)
+		assert.Equal(t, nil, err)
+		assert.EqualValues(t, src, res)
+
+		dest, err = compressor.Compress(src)
+		assert.Equal(t, nil, err)
+		res, err = compressor.DecompressWithPool(dest)
+		assert.Equal(t, nil, err)
+		assert.EqualValues(t, src, res)
+	}
+}
+
+func TestZstdDecompressLimit(t *testing.T) {
+	compressor := NewZstdCompressor()
+	bomb := make([]byte, maxMessageSize*20)
+
+	streamed, err := compressor.Compress(bomb)
+	assert.Equal(t, nil, err)
+	pooled, err := compressor.CompressWithPool(bomb)
+	assert.Equal(t, nil, err)
+
+	for _, dest := range [][]byte{streamed, pooled} {
+		_, err = compressor.DeCompress(dest)
+		assert.NotNil(t, err)
+		_, err = compressor.DecompressWithPool(dest)
+		assert.NotNil(t, err)
+	}
+}
+
 func BenchmarkCompress(b *testing.B) {
 	src := mockRandom()
 	compressor := NewGzipCompressor()
@@ -0,0 +1,185 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/cache"
+	"github.com/Meikwei/go-tools/discovery"
+	"github.com/Meikwei/go-tools/log"
+	"github.com/Meikwei/go-tools/mcontext"
+	"github.com/Meikwei/go-tools/utils/idutil"
+)
+
+const (
+	// A node whose alive key expires is considered crashed and its routes are removed.
+	connRouteNodeTTL   = 30 * time.Second
+	connRouteKeepAlive = 10 * time.Second
+)
+
+// connRouteOp is a pending write of one connection route.
+type connRouteOp struct {
+	ctx    context.Context
+	userID string
+	route  *cache.ConnRoute
+	online bool
+}
+
+// connRegistry publishes the connections of this node to the cluster wide route registry,
+// so that the push service only sends to the nodes holding the recipients. Route writes
+// are queued and applied in order by a background worker, so the hub register and
+// unregister loop never waits on redis.
+type connRegistry struct {
+	cache  cache.ConnRouteCache
+	lock   sync.RWMutex
+	node   string
+	opLock sync.Mutex
+	ops    []connRouteOp
+	notify chan struct{}
+}
+
+func newConnRegistry(connRouteCache cache.ConnRouteCache) *connRegistry {
+	r := &connRegistry{cache: connRouteCache, notify: make(chan struct{}, 1)}
+	go r.apply()
+	return r
+}
+
+func (r *connRegistry) getNode() string {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.node
+}
+
+func (r *connRegistry) register(ctx context.Context, client *Client) {
+	node := r.getNode()
+	if node == "" {
+		// registered once the node is known, see run
+		return
+	}
+	route := &cache.ConnRoute{Node: node, PlatformID: client.PlatformID, ConnID: client.ctx.GetConnID()}
+	r.push(connRouteOp{ctx: ctx, userID: client.UserID, route: route, online: true})
+}
+
+func (r *connRegistry) unregister(ctx context.Context, client *Client) {
+	r.unregisterConn(ctx, client.UserID, client.PlatformID, client.ctx.GetConnID())
+}
+
+// unregisterConn removes the route of a connection that is already gone, e.g. one whose
+// resume session was suspended.
+func (r *connRegistry) unregisterConn(ctx context.Context, userID string, platformID int, connID string) {
+	node := r.getNode()
+	if node == "" {
+		return
+	}
+	route := &cache.ConnRoute{Node: node, PlatformID: platformID, ConnID: connID}
+	r.push(connRouteOp{ctx: ctx, userID: userID, route: route})
+}
+
+func (r *connRegistry) push(op connRouteOp) {
+	r.opLock.Lock()
+	r.ops = append(r.ops, op)
+	r.opLock.Unlock()
+	select {
+	case r.notify <- struct{}{}:
+	default:
+	}
+}
+
+// apply writes the queued routes. The queue is unbounded so that a slow redis delays
+// routing updates instead of dropping them.
+func (r *connRegistry) apply() {
+	for range r.notify {
+		r.opLock.Lock()
+		ops := r.ops
+		r.ops = nil
+		r.opLock.Unlock()
+		for _, op := range ops {
+			if op.online {
+				if err := r.cache.SetConnRoute(op.ctx, op.userID, op.route); err != nil {
+					log.ZWarn(op.ctx, "set conn route failed", err, "userID", op.userID, "node", op.route.Node)
+				}
+				continue
+			}
+			if err := r.cache.DelConnRoute(op.ctx, op.userID, op.route.Node, op.route.ConnID); err != nil {
+				log.ZWarn(op.ctx, "del conn route failed", err, "userID", op.userID, "node", op.route.Node)
+			}
+		}
+	}
+}
+
+// run waits until the node is registered in discovery, replaces the routes left by a
+// previous run of the same node, then keeps the node alive and removes the routes of
+// crashed nodes.
+func (r *connRegistry) run(disCov discovery.SvcDiscoveryRegistry, clients *UserMap) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	var node string
+	for node == "" {
+		<-ticker.C
+		node = disCov.GetSelfConnTarget()
+	}
+	ctx := mcontext.SetOperationID(context.Background(), idutil.OperationIDGenerator())
+	if err := r.cache.ClearNode(ctx, node); err != nil {
+		log.ZWarn(ctx, "clear conn routes of node failed", err, "node", node)
+	}
+	if err := r.cache.KeepNodeAlive(ctx, node, connRouteNodeTTL); err != nil {
+		log.ZWarn(ctx, "keep node alive failed", err, "node", node)
+	}
+	r.lock.Lock()
+	r.node = node
+	r.lock.Unlock()
+	clients.Range(func(_ string, userClients []*Client) bool {
+		for _, client := range userClients {
+			r.register(ctx, client)
+		}
+		return true
+	})
+	log.ZInfo(ctx, "conn registry ready", "node", node)
+
+	ticker.Reset(connRouteKeepAlive)
+	for range ticker.C {
+		ctx := mcontext.SetOperationID(context.Background(), idutil.OperationIDGenerator())
+		if err := r.cache.KeepNodeAlive(ctx, node, connRouteNodeTTL); err != nil {
+			log.ZWarn(ctx, "keep node alive failed", err, "node", node)
+			continue
+		}
+		r.clearDeadNodes(ctx)
+	}
+}
+
+func (r *connRegistry) clearDeadNodes(ctx context.Context) {
+	nodes, err := r.cache.GetNodes(ctx)
+	if err != nil {
+		log.ZWarn(ctx, "get route nodes failed", err)
+		return
+	}
+	alive, err := r.cache.GetAliveNodes(ctx, nodes)
+	if err != nil {
+		log.ZWarn(ctx, "get alive route nodes failed", err)
+		return
+	}
+	for _, node := range nodes {
+		if alive[node] {
+			continue
+		}
+		log.ZInfo(ctx, "clear conn routes of dead node", "node", node)
+		if err := r.cache.ClearNode(ctx, node); err != nil {
+			log.ZWarn(ctx, "clear conn routes of node failed", err, "node", node)
+		}
+	}
+}
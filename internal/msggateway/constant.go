@@ -25,8 +25,25 @@ const (
 	OperationID             = "operationID"
 	Compression             = "compression"
 	GzipCompressionProtocol = "gzip"
+	ZstdCompressionProtocol = "zstd"
+	PerMessageDeflate       = "permessage-deflate"
 	BackgroundStatus        = "isBackground"
 	SendResponse            = "isMsgResp"
+	ResumeToken             = "resumeToken"
+	LastPushID              = "lastPushID"
+	Transport               = "transport"
+)
+
+const (
+	WebSocketTransport = "websocket"
+	SSETransport       = "sse"
+)
+
+const (
+	Encoding                 = "encoding"
+	GobEncodingProtocol      = "gob"
+	JsonEncodingProtocol     = "json"
+	ProtobufEncodingProtocol = "protobuf"
 )
 
 const (
@@ -43,6 +60,9 @@ const (
 	WSKickOnlineMsg       = 2002
 	WsLogoutMsg           = 2003
 	WsSetBackgroundStatus = 2004
+	WSResume              = 2005
+	WSResyncMsg           = 2006
+	WSReconnectMsg        = 2007
 	WSDataError           = 3001
 )
 
@@ -18,6 +18,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Meikwei/aetim/pkg/common/servererrs"
@@ -138,18 +139,71 @@ func (c *UserConnContext) GetToken() string {
 }
 
 func (c *UserConnContext) GetCompression() bool {
+	return c.GetCompressionProtocol() != ""
+}
+
+// GetCompressionProtocol returns the application level compression protocol requested
+// by the client, or an empty string when the frames are not compressed by the gateway.
+func (c *UserConnContext) GetCompressionProtocol() string {
 	compression, exists := c.Query(Compression)
-	if exists && compression == GzipCompressionProtocol {
-		return true
-	} else {
-		compression, exists := c.GetHeader(Compression)
-		if exists && compression == GzipCompressionProtocol {
-			return true
+	if !exists {
+		compression, exists = c.GetHeader(Compression)
+	}
+	if exists {
+		if _, ok := NewCompressor(compression); ok {
+			return compression
+		}
+	}
+	return ""
+}
+
+// OfferPerMessageDeflate reports whether the client offered the permessage-deflate
+// extension (RFC 7692) in its WebSocket handshake.
+func (c *UserConnContext) OfferPerMessageDeflate() bool {
+	for _, value := range c.Req.Header.Values("Sec-WebSocket-Extensions") {
+		for _, ext := range strings.Split(value, ",") {
+			if name, _, _ := strings.Cut(ext, ";"); strings.TrimSpace(name) == PerMessageDeflate {
+				return true
+			}
 		}
 	}
 	return false
 }
 
+// GetEncoding returns the encoding protocol requested by the client for Req and Resp,
+// falling back to gob when none is given.
+func (c *UserConnContext) GetEncoding() string {
+	if encoding, exists := c.Query(Encoding); exists {
+		return encoding
+	}
+	if encoding, exists := c.GetHeader(Encoding); exists {
+		return encoding
+	}
+	return GobEncodingProtocol
+}
+
+// GetResumeToken returns the resume token of a previous connection the client asks to resume.
+func (c *UserConnContext) GetResumeToken() string {
+	return c.Req.URL.Query().Get(ResumeToken)
+}
+
+// GetLastPushID returns the ID of the last push the client received on the resumed connection.
+func (c *UserConnContext) GetLastPushID() int64 {
+	lastPushID, err := strconv.ParseInt(c.Req.URL.Query().Get(LastPushID), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return lastPushID
+}
+
+// GetTransport returns the transport of the connection, websocket unless the client asks for sse.
+func (c *UserConnContext) GetTransport() string {
+	if c.Req.URL.Query().Get(Transport) == SSETransport {
+		return SSETransport
+	}
+	return WebSocketTransport
+}
+
 func (c *UserConnContext) ShouldSendResp() bool {
 	errResp, exists := c.Query(SendResponse)
 	if exists {
@@ -192,5 +246,8 @@ func (c *UserConnContext) ParseEssentialArgs() error {
 		return servererrs.ErrConnArgsErr.WrapMsg("platformID is not int")
 
 	}
+	if _, ok := NewEncoder(c.GetEncoding()); !ok {
+		return servererrs.ErrConnArgsErr.WrapMsg("encoding is not supported")
+	}
 	return nil
 }
@@ -0,0 +1,175 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/Meikwei/go-tools/log"
+)
+
+const (
+	defaultDrainPeriod          = 60 * time.Second
+	defaultDrainTerminatePeriod = 20 * time.Second
+	defaultDrainBatchInterval   = time.Second
+)
+
+var ErrNodeDraining = errs.New("msg gateway node is draining")
+
+// ReconnectResp is the payload of the WSReconnectMsg frame sent when the node drains.
+// The client should reconnect, through the load balancer, after Delay milliseconds.
+type ReconnectResp struct {
+	Delay int64 `json:"delay"`
+}
+
+type drainConn struct {
+	client *Client
+	connID string
+	batch  int
+}
+
+// Drain stops accepting connections, leaves discovery and moves the clients to other nodes:
+// each one gets a reconnect hint with a jittered delay, and the connections still open are
+// closed in batches over the drain period. It returns once all connections are closed, later
+// calls wait for the first one.
+func (ws *WsServer) Drain(ctx context.Context) {
+	period := time.Duration(ws.msgGatewayConfig.MsgGateway.Drain.Period) * time.Second
+	if period <= 0 {
+		period = defaultDrainPeriod
+	}
+	ws.drainFor(ctx, period)
+}
+
+func (ws *WsServer) drainFor(ctx context.Context, period time.Duration) {
+	ws.drainOnce.Do(func() {
+		ws.drain(ctx, period)
+	})
+}
+
+// terminate drains the node before the process exits on SIGTERM. The connections are closed over the
+// terminate period, which should fit in the grace period of the process, and a drain already running
+// over the longer drain period is not waited for past it.
+func (ws *WsServer) terminate() {
+	period := time.Duration(ws.msgGatewayConfig.MsgGateway.Drain.TerminatePeriod) * time.Second
+	if period <= 0 {
+		period = defaultDrainTerminatePeriod
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), period)
+	defer cancel()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ws.drainFor(ctx, period)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+}
+
+// startDrain drains the node in the background. It reports the number of connections
+// and whether this call started the drain, false means the node was already draining.
+func (ws *WsServer) startDrain(ctx context.Context) (int64, bool) {
+	connNum := ws.onlineUserConnNum.Load()
+	if !ws.draining.CompareAndSwap(false, true) {
+		return connNum, false
+	}
+	go ws.Drain(ctx)
+	return connNum, true
+}
+
+func (ws *WsServer) drain(ctx context.Context, period time.Duration) {
+	ws.draining.Store(true)
+	if ws.disCov != nil {
+		if err := ws.disCov.UnRegister(); err != nil {
+			log.ZWarn(ctx, "unregister draining node failed", err)
+		}
+	}
+
+	var clients []*Client
+	ws.clients.Range(func(_ string, userClients []*Client) bool {
+		clients = append(clients, userClients...)
+		return true
+	})
+	// Pushes no longer reach this node, the sessions can not be resumed without losing them
+	if ws.resume != nil {
+		ws.resume.drop(ctx, clients)
+	}
+
+	interval := time.Duration(ws.msgGatewayConfig.MsgGateway.Drain.BatchInterval) * time.Second
+	if interval <= 0 {
+		interval = defaultDrainBatchInterval
+	}
+	if interval > period {
+		interval = period
+	}
+	batchNum := int(period / interval)
+	log.ZInfo(ctx, "msg gateway draining", "conn num", len(clients), "period", period, "batch num", batchNum)
+
+	rand.Shuffle(len(clients), func(i, j int) { clients[i], clients[j] = clients[j], clients[i] })
+	batches := make([][]*drainConn, batchNum)
+	for i, client := range clients {
+		conn := &drainConn{client: client, connID: client.ctx.GetConnID(), batch: i % batchNum}
+		batches[conn.batch] = append(batches[conn.batch], conn)
+		delay := time.Duration(conn.batch)*interval + time.Duration(rand.Int63n(int64(interval)))
+		data, err := json.Marshal(ReconnectResp{Delay: delay.Milliseconds()})
+		if err != nil {
+			continue
+		}
+		if err := client.enqueue(&Resp{ReqIdentifier: WSReconnectMsg, Data: data}); err != nil {
+			log.ZWarn(client.ctx, "send reconnect hint failed", err)
+		}
+	}
+
+	start := time.Now()
+	for i, batch := range batches {
+		timer := time.NewTimer(time.Until(start.Add(time.Duration(i+1) * interval)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			for _, rest := range batches[i:] {
+				closeDrainConns(rest)
+			}
+			return
+		case <-timer.C:
+		}
+		closeDrainConns(batch)
+	}
+	log.ZInfo(ctx, "msg gateway drained", "conn num", len(clients), "cost", time.Since(start))
+}
+
+// closeDrainConns closes the connections that did not reconnect elsewhere in time.
+func closeDrainConns(conns []*drainConn) {
+	var wg sync.WaitGroup
+	for _, conn := range conns {
+		client := conn.client
+		// the client may have left and been reused for another connection
+		if client.closed.Load() || client.ctx.GetConnID() != conn.connID {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client.setClosedErr(ErrNodeDraining)
+			client.close()
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,102 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockLongConn struct {
+	LongConn
+	closed atomic.Bool
+}
+
+func (m *mockLongConn) Close() error {
+	m.closed.Store(true)
+	return nil
+}
+
+func TestWsServerDrain(t *testing.T) {
+	conf := &Config{}
+	conf.MsgGateway.Drain.Period = 1
+	conf.MsgGateway.Drain.BatchInterval = 1
+	ws, err := NewWsServer(conf)
+	assert.NoError(t, err)
+
+	var conns []*mockLongConn
+	for i := 0; i < 3; i++ {
+		conn := &mockLongConn{}
+		conns = append(conns, conn)
+		client := &Client{
+			w:              new(sync.Mutex),
+			conn:           conn,
+			UserID:         "u" + strconv.Itoa(i),
+			ctx:            &UserConnContext{ConnID: strconv.Itoa(i)},
+			longConnServer: ws,
+			resume:         new(resumeState),
+			queue:          newWriteQueue(1, WriteQueueDrop),
+		}
+		ws.clients.Set(client.UserID, client)
+	}
+
+	ws.Drain(context.Background())
+
+	assert.True(t, ws.draining.Load())
+	ws.clients.Range(func(_ string, clients []*Client) bool {
+		for _, client := range clients {
+			if assert.Len(t, client.queue.frames, 1) {
+				resp := <-client.queue.frames
+				assert.Equal(t, int32(WSReconnectMsg), resp.ReqIdentifier)
+				var hint ReconnectResp
+				assert.NoError(t, json.Unmarshal(resp.Data, &hint))
+				assert.Less(t, hint.Delay, int64(1000))
+			}
+			assert.True(t, client.closed.Load())
+		}
+		return true
+	})
+	for _, conn := range conns {
+		assert.True(t, conn.closed.Load())
+	}
+
+	// a node that is already draining is not drained again
+	_, started := ws.startDrain(context.Background())
+	assert.False(t, started)
+}
+
+func TestWsServerTerminateBounded(t *testing.T) {
+	conf := &Config{}
+	conf.MsgGateway.Drain.Period = 60
+	conf.MsgGateway.Drain.TerminatePeriod = 1
+	conf.MsgGateway.Drain.BatchInterval = 1
+	ws, err := NewWsServer(conf)
+	assert.NoError(t, err)
+
+	// a drain over the long period is already running when the process is terminated
+	_, started := ws.startDrain(context.Background())
+	assert.True(t, started)
+
+	start := time.Now()
+	ws.terminate()
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
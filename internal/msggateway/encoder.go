@@ -17,8 +17,12 @@ package msggateway
 import (
 	"bytes"
 	"encoding/gob"
+	"encoding/json"
+	"fmt"
 
 	"github.com/Meikwei/go-tools/errs"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
 )
 
 type Encoder interface {
@@ -49,3 +53,222 @@ func (g *GobEncoder) Decode(encodeData []byte, decodeData any) error {
 	}
 	return nil
 }
+
+type JsonEncoder struct{}
+
+func NewJsonEncoder() *JsonEncoder {
+	return &JsonEncoder{}
+}
+
+func (j *JsonEncoder) Encode(data any) ([]byte, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "JsonEncoder.Encode failed", "action", "encode")
+	}
+	return b, nil
+}
+
+func (j *JsonEncoder) Decode(encodeData []byte, decodeData any) error {
+	if err := json.Unmarshal(encodeData, decodeData); err != nil {
+		return errs.WrapMsg(err, "JsonEncoder.Decode failed", "action", "decode")
+	}
+	return nil
+}
+
+// ProtobufEncoder encodes Req and Resp with the protobuf wire format, so that clients
+// can generate their codec from the following schema:
+//
+//	message Req {
+//	  int32 reqIdentifier = 1;
+//	  string token = 2;
+//	  string sendID = 3;
+//	  string operationID = 4;
+//	  string msgIncr = 5;
+//	  bytes data = 6;
+//	}
+//
+//	message Resp {
+//	  int32 reqIdentifier = 1;
+//	  string msgIncr = 2;
+//	  string operationID = 3;
+//	  int32 errCode = 4;
+//	  string errMsg = 5;
+//	  bytes data = 6;
+//	}
+type ProtobufEncoder struct{}
+
+func NewProtobufEncoder() *ProtobufEncoder {
+	return &ProtobufEncoder{}
+}
+
+func (p *ProtobufEncoder) Encode(data any) ([]byte, error) {
+	switch v := data.(type) {
+	case Req:
+		return encodeReq(&v), nil
+	case *Req:
+		return encodeReq(v), nil
+	case Resp:
+		return encodeResp(&v), nil
+	case *Resp:
+		return encodeResp(v), nil
+	case proto.Message:
+		b, err := proto.Marshal(v)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "ProtobufEncoder.Encode failed", "action", "encode")
+		}
+		return b, nil
+	default:
+		return nil, errs.New("ProtobufEncoder.Encode unsupported type", "type", fmt.Sprintf("%T", data))
+	}
+}
+
+func (p *ProtobufEncoder) Decode(encodeData []byte, decodeData any) error {
+	var err error
+	switch v := decodeData.(type) {
+	case *Req:
+		err = decodeReq(encodeData, v)
+	case *Resp:
+		err = decodeResp(encodeData, v)
+	case proto.Message:
+		err = proto.Unmarshal(encodeData, v)
+	default:
+		return errs.New("ProtobufEncoder.Decode unsupported type", "type", fmt.Sprintf("%T", decodeData))
+	}
+	if err != nil {
+		return errs.WrapMsg(err, "ProtobufEncoder.Decode failed", "action", "decode")
+	}
+	return nil
+}
+
+func encodeReq(r *Req) []byte {
+	var b []byte
+	b = appendVarintField(b, 1, int64(r.ReqIdentifier))
+	b = appendStringField(b, 2, r.Token)
+	b = appendStringField(b, 3, r.SendID)
+	b = appendStringField(b, 4, r.OperationID)
+	b = appendStringField(b, 5, r.MsgIncr)
+	b = appendBytesField(b, 6, r.Data)
+	return b
+}
+
+func encodeResp(r *Resp) []byte {
+	var b []byte
+	b = appendVarintField(b, 1, int64(r.ReqIdentifier))
+	b = appendStringField(b, 2, r.MsgIncr)
+	b = appendStringField(b, 3, r.OperationID)
+	b = appendVarintField(b, 4, int64(r.ErrCode))
+	b = appendStringField(b, 5, r.ErrMsg)
+	b = appendBytesField(b, 6, r.Data)
+	return b
+}
+
+func decodeReq(b []byte, r *Req) error {
+	return consumeFields(b, func(num protowire.Number, v uint64, s []byte) {
+		switch num {
+		case 1:
+			r.ReqIdentifier = int32(v)
+		case 2:
+			r.Token = string(s)
+		case 3:
+			r.SendID = string(s)
+		case 4:
+			r.OperationID = string(s)
+		case 5:
+			r.MsgIncr = string(s)
+		case 6:
+			r.Data = append([]byte(nil), s...)
+		}
+	})
+}
+
+func decodeResp(b []byte, r *Resp) error {
+	return consumeFields(b, func(num protowire.Number, v uint64, s []byte) {
+		switch num {
+		case 1:
+			r.ReqIdentifier = int32(v)
+		case 2:
+			r.MsgIncr = string(s)
+		case 3:
+			r.OperationID = string(s)
+		case 4:
+			r.ErrCode = int(int32(v))
+		case 5:
+			r.ErrMsg = string(s)
+		case 6:
+			r.Data = append([]byte(nil), s...)
+		}
+	})
+}
+
+func appendVarintField(b []byte, num protowire.Number, v int64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, uint64(v))
+}
+
+func appendStringField(b []byte, num protowire.Number, s string) []byte {
+	if s == "" {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, s)
+}
+
+func appendBytesField(b []byte, num protowire.Number, v []byte) []byte {
+	if len(v) == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+// consumeFields walks the protobuf fields in b, passing varint values as v and
+// length-delimited values as s. Fields of other wire types are skipped.
+func consumeFields(b []byte, fn func(num protowire.Number, v uint64, s []byte)) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch typ {
+		case protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			fn(num, v, nil)
+			b = b[n:]
+		case protowire.BytesType:
+			s, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			fn(num, 0, s)
+			b = b[n:]
+		default:
+			n := protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			b = b[n:]
+		}
+	}
+	return nil
+}
+
+// NewEncoder returns the Encoder registered for the given encoding protocol.
+func NewEncoder(encoding string) (Encoder, bool) {
+	switch encoding {
+	case GobEncodingProtocol:
+		return NewGobEncoder(), true
+	case JsonEncodingProtocol:
+		return NewJsonEncoder(), true
+	case ProtobufEncodingProtocol:
+		return NewProtobufEncoder(), true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncoderReqResp(t *testing.T) {
+	req := Req{
+		ReqIdentifier: WSSendMsg,
+		Token:         "token",
+		SendID:        "sendID",
+		OperationID:   "operationID",
+		MsgIncr:       "1",
+		Data:          mockRandom(),
+	}
+	resp := Resp{
+		ReqIdentifier: WSPushMsg,
+		MsgIncr:       "1",
+		OperationID:   "operationID",
+		ErrCode:       1001,
+		ErrMsg:        "errMsg",
+		Data:          mockRandom(),
+	}
+	for _, encoding := range []string{GobEncodingProtocol, JsonEncodingProtocol, ProtobufEncodingProtocol} {
+		encoder, ok := NewEncoder(encoding)
+		assert.True(t, ok, encoding)
+
+		data, err := encoder.Encode(req)
+		assert.Nil(t, err, encoding)
+		var decodedReq Req
+		assert.Nil(t, encoder.Decode(data, &decodedReq), encoding)
+		assert.Equal(t, req, decodedReq, encoding)
+
+		data, err = encoder.Encode(resp)
+		assert.Nil(t, err, encoding)
+		var decodedResp Resp
+		assert.Nil(t, encoder.Decode(data, &decodedResp), encoding)
+		assert.Equal(t, resp, decodedResp, encoding)
+	}
+
+	_, ok := NewEncoder("xml")
+	assert.False(t, ok)
+}
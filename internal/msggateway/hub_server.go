@@ -20,6 +20,7 @@ import (
 	"github.com/Meikwei/aetim/pkg/authverify"
 	"github.com/Meikwei/aetim/pkg/common/servererrs"
 	"github.com/Meikwei/aetim/pkg/common/startrpc"
+	"github.com/Meikwei/aetim/pkg/protocol/gatewayadmin"
 	"github.com/Meikwei/go-tools/discovery"
 	"github.com/Meikwei/go-tools/errs"
 	"github.com/Meikwei/go-tools/log"
@@ -32,6 +33,7 @@ import (
 func (s *Server) InitServer(ctx context.Context, config *Config, disCov discovery.SvcDiscoveryRegistry, server *grpc.Server) error {
 	s.LongConnServer.SetDiscoveryRegistry(disCov, config)
 	msggateway.RegisterMsgGatewayServer(server, s)
+	gatewayadmin.RegisterGatewayAdminServer(server, s)
 	return nil
 }
 
@@ -122,14 +124,37 @@ func (s *Server) OnlineBatchPushOneMsg(ctx context.Context, req *msggateway.Onli
 
 func (s *Server) SuperGroupOnlineBatchPushOneMsg(ctx context.Context, req *msggateway.OnlineBatchPushOneMsgReq,
 ) (*msggateway.OnlineBatchPushOneMsgResp, error) {
-	var singleUserResults []*msggateway.SingleMsgToUserResults
-	for _, v := range req.PushToUserIDs {
+	data, err := NewPushMessageData(ctx, req.MsgData)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		singleUserResults []*msggateway.SingleMsgToUserResults
+		userClients       = make([][]*Client, len(req.PushToUserIDs))
+		pushClients       []*Client
+	)
+	for i, v := range req.PushToUserIDs {
+		clients, ok := s.LongConnServer.GetUserAllCons(v)
+		if !ok {
+			continue
+		}
+		userClients[i] = clients
+		for _, client := range clients {
+			if client != nil && s.shouldOnlinePush(client) {
+				pushClients = append(pushClients, client)
+			}
+		}
+	}
+	// Buffer the push for session resume before writing it, so that a connection dropping
+	// in between still receives it on reconnect
+	pushIDs := s.LongConnServer.bufferPush(ctx, req.PushToUserIDs, pushClients, data)
+	for i, v := range req.PushToUserIDs {
 		var resp []*msggateway.SingleMsgToUserPlatform
 		results := &msggateway.SingleMsgToUserResults{
 			UserID: v,
 		}
-		clients, ok := s.LongConnServer.GetUserAllCons(v)
-		if !ok {
+		clients := userClients[i]
+		if clients == nil {
 			log.ZDebug(ctx, "push user not online", "userID", v)
 			results.Resp = resp
 			singleUserResults = append(singleUserResults, results)
@@ -145,9 +170,8 @@ func (s *Server) SuperGroupOnlineBatchPushOneMsg(ctx context.Context, req *msgga
 			userPlatform := &msggateway.SingleMsgToUserPlatform{
 				RecvPlatFormID: int32(client.PlatformID),
 			}
-			if !client.IsBackground ||
-				(client.IsBackground && client.PlatformID != constant.IOSPlatformID) {
-				err := client.PushMessage(ctx, req.MsgData)
+			if s.shouldOnlinePush(client) {
+				err := client.PushMessage(ctx, data, pushIDs[client])
 				if err != nil {
 					userPlatform.ResultCode = int64(servererrs.ErrPushMsgErr.Code())
 					resp = append(resp, userPlatform)
@@ -171,6 +195,12 @@ func (s *Server) SuperGroupOnlineBatchPushOneMsg(ctx context.Context, req *msgga
 	}, nil
 }
 
+// shouldOnlinePush reports whether a push is written to the connection, iOS clients in the
+// background receive it through offline push instead.
+func (s *Server) shouldOnlinePush(client *Client) bool {
+	return !client.IsBackground || client.PlatformID != constant.IOSPlatformID
+}
+
 func (s *Server) KickUserOffline(
 	ctx context.Context,
 	req *msggateway.KickUserOfflineReq,
@@ -212,3 +242,67 @@ func (s *Server) MultiTerminalLoginCheck(ctx context.Context, req *msggateway.Mu
 	}
 	return &msggateway.MultiTerminalLoginCheckResp{}, nil
 }
+
+// GetUserConns lists the connections of a user on this node, openim-api merges the lists of all nodes.
+func (s *Server) GetUserConns(ctx context.Context, req *gatewayadmin.GetUserConnsReq) (*gatewayadmin.GetUserConnsResp, error) {
+	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		return nil, errs.ErrNoPermission.WrapMsg("only app manager")
+	}
+	var resp gatewayadmin.GetUserConnsResp
+	clients, ok := s.LongConnServer.GetUserAllCons(req.UserID)
+	if !ok {
+		return &resp, nil
+	}
+	for _, client := range clients {
+		if client == nil || client.closed.Load() {
+			continue
+		}
+		resp.Conns = append(resp.Conns, &gatewayadmin.ConnInfo{
+			UserID:       client.UserID,
+			ConnID:       client.ctx.GetConnID(),
+			PlatformID:   int32(client.PlatformID),
+			Platform:     constant.PlatformIDToName(client.PlatformID),
+			RemoteAddr:   client.ctx.RemoteAddr,
+			ConnectTime:  client.connectTime.UnixMilli(),
+			IsBackground: client.IsBackground,
+			Compression:  client.Compression,
+			Encoding:     client.Encoding,
+			Transport:    client.ctx.GetTransport(),
+			BytesIn:      client.bytesIn.Load(),
+			BytesOut:     client.conn.BytesOut(),
+		})
+	}
+	return &resp, nil
+}
+
+// KickConn kicks a single connection of a user, the other connections of the user stay online.
+func (s *Server) KickConn(ctx context.Context, req *gatewayadmin.KickConnReq) (*gatewayadmin.KickConnResp, error) {
+	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		return nil, errs.ErrNoPermission.WrapMsg("only app manager")
+	}
+	clients, _ := s.LongConnServer.GetUserAllCons(req.UserID)
+	for _, client := range clients {
+		if client == nil || client.ctx.GetConnID() != req.ConnID {
+			continue
+		}
+		log.ZInfo(ctx, "kick user conn", "userID", req.UserID, "connID", req.ConnID, "platformID", client.PlatformID)
+		if err := client.longConnServer.KickUserConn(client); err != nil {
+			log.ZWarn(ctx, "kick user conn failed", err, "userID", req.UserID, "connID", req.ConnID)
+		}
+		return &gatewayadmin.KickConnResp{Kicked: true}, nil
+	}
+	return &gatewayadmin.KickConnResp{}, nil
+}
+
+// Drain starts draining this node, for orchestrators that can not signal the process.
+// It returns at once, the connections are moved over the drain period, see WsServer.Drain.
+func (s *Server) Drain(ctx context.Context, req *gatewayadmin.DrainReq) (*gatewayadmin.DrainResp, error) {
+	if !authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID) {
+		return nil, errs.ErrNoPermission.WrapMsg("only app manager")
+	}
+	// the drain outlives the request
+	drainCtx := mcontext.SetOperationID(context.Background(), mcontext.GetOperationID(ctx))
+	connNum, started := s.LongConnServer.startDrain(drainCtx)
+	log.ZInfo(ctx, "drain requested", "connNum", connNum, "started", started)
+	return &gatewayadmin.DrainResp{ConnNum: connNum, Started: started}, nil
+}
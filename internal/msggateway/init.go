@@ -16,9 +16,13 @@ package msggateway
 
 import (
 	"context"
+	"os"
+	"os/signal"
 	"time"
 
 	"github.com/Meikwei/aetim/pkg/common/config"
+	"github.com/Meikwei/aetim/pkg/common/db/cache"
+	"github.com/Meikwei/go-tools/db/redisutil"
 	"github.com/Meikwei/go-tools/utils/datautil"
 
 	"github.com/Meikwei/go-tools/log"
@@ -26,6 +30,7 @@ import (
 
 type Config struct {
 	MsgGateway      config.MsgGateway
+	RedisConfig     config.Redis
 	ZookeeperConfig config.ZooKeeper
 	Share           config.Share
 	WebhooksConfig  config.Webhooks
@@ -47,18 +52,33 @@ func Start(ctx context.Context, index int, conf *Config) error {
 	if err != nil {
 		return err
 	}
+	rdb, err := redisutil.NewRedisClient(ctx, conf.RedisConfig.Build())
+	if err != nil {
+		return err
+	}
 	longServer, err := NewWsServer(
 		conf,
+		WithResumeCache(cache.NewResumeCache(rdb)),
+		WithConnRouteCache(cache.NewConnRouteCache(rdb)),
 		WithPort(wsPort),
 		WithMaxConnNum(int64(conf.MsgGateway.LongConnSvr.WebsocketMaxConnNum)),
 		WithHandshakeTimeout(time.Duration(conf.MsgGateway.LongConnSvr.WebsocketTimeout)*time.Second),
 		WithMessageMaxMsgLength(conf.MsgGateway.LongConnSvr.WebsocketMaxMsgLen),
+		WithPerMessageDeflate(conf.MsgGateway.LongConnSvr.EnablePerMessageDeflate),
 	)
 	if err != nil {
 		return err
 	}
 
 	hubServer := NewServer(rpcPort, prometheusPort, longServer, conf)
+	if len(drainSignals) > 0 {
+		go func() {
+			sigs := make(chan os.Signal, 1)
+			signal.Notify(sigs, drainSignals...)
+			<-sigs
+			hubServer.LongConnServer.Drain(ctx)
+		}()
+	}
 	netDone := make(chan error)
 	go func() {
 		err = hubServer.Start(ctx, index, conf)
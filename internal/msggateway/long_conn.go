@@ -17,6 +17,7 @@ package msggateway
 import (
 	"encoding/json"
 	"net/http"
+	"sync/atomic"
 	"time"
 
 	"github.com/Meikwei/go-tools/apiresp"
@@ -49,16 +50,22 @@ type LongConn interface {
 	SetPingHandler(handler PingPongHandler)
 	// GenerateLongConn Check the connection of the current and when it was sent are the same
 	GenerateLongConn(w http.ResponseWriter, r *http.Request) error
+	// BytesOut returns the bytes written to the connection, control frames and transport framing included.
+	BytesOut() int64
 }
 type GWebSocket struct {
 	protocolType     int
 	conn             *websocket.Conn
 	handshakeTimeout time.Duration
 	writeBufferSize  int
+	// enableCompression allows permessage-deflate to be negotiated during the handshake.
+	enableCompression bool
+	bytesOut          atomic.Int64
 }
 
-func newGWebSocket(protocolType int, handshakeTimeout time.Duration, wbs int) *GWebSocket {
-	return &GWebSocket{protocolType: protocolType, handshakeTimeout: handshakeTimeout, writeBufferSize: wbs}
+func newGWebSocket(protocolType int, handshakeTimeout time.Duration, wbs int, enableCompression bool) *GWebSocket {
+	return &GWebSocket{protocolType: protocolType, handshakeTimeout: handshakeTimeout, writeBufferSize: wbs,
+		enableCompression: enableCompression}
 }
 
 func (d *GWebSocket) Close() error {
@@ -67,8 +74,9 @@ func (d *GWebSocket) Close() error {
 
 func (d *GWebSocket) GenerateLongConn(w http.ResponseWriter, r *http.Request) error {
 	upgrader := &websocket.Upgrader{
-		HandshakeTimeout: d.handshakeTimeout,
-		CheckOrigin:      func(r *http.Request) bool { return true },
+		HandshakeTimeout:  d.handshakeTimeout,
+		CheckOrigin:       func(r *http.Request) bool { return true },
+		EnableCompression: d.enableCompression,
 	}
 	if d.writeBufferSize > 0 { // default is 4kb.
 		upgrader.WriteBufferSize = d.writeBufferSize
@@ -85,7 +93,27 @@ func (d *GWebSocket) GenerateLongConn(w http.ResponseWriter, r *http.Request) er
 
 func (d *GWebSocket) WriteMessage(messageType int, message []byte) error {
 	// d.setSendConn(d.conn)
-	return d.conn.WriteMessage(messageType, message)
+	if err := d.conn.WriteMessage(messageType, message); err != nil {
+		return err
+	}
+	d.bytesOut.Add(wsFrameLen(len(message)))
+	return nil
+}
+
+// wsFrameLen is the size of an unmasked server frame, before permessage-deflate.
+func wsFrameLen(payloadLen int) int64 {
+	switch {
+	case payloadLen <= 125:
+		return int64(payloadLen) + 2
+	case payloadLen <= 65535:
+		return int64(payloadLen) + 4
+	default:
+		return int64(payloadLen) + 10
+	}
+}
+
+func (d *GWebSocket) BytesOut() int64 {
+	return d.bytesOut.Load()
 }
 
 // func (d *GWebSocket) setSendConn(sendConn *websocket.Conn) {
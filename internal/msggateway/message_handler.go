@@ -25,6 +25,7 @@ import (
 	"github.com/Meikwei/go-tools/utils/jsonutil"
 	"github.com/Meikwei/protocol/msg"
 	"github.com/Meikwei/protocol/push"
+	"github.com/Meikwei/protocol/rtc"
 	"github.com/Meikwei/protocol/sdkws"
 	"github.com/go-playground/validator/v10"
 	"google.golang.org/protobuf/proto"
@@ -160,14 +161,20 @@ func (g GrpcHandler) SendMessage(ctx context.Context, data *Req) ([]byte, error)
 	return c, nil
 }
 
-func (g GrpcHandler) SendSignalMessage(context context.Context, data *Req) ([]byte, error) {
-	resp, err := g.msgRpcClient.SendMsg(context, nil)
+// SendSignalMessage handles call signaling. The request data is a protobuf encoded rtc.SignalReq
+// and the response data a protobuf encoded rtc.SignalResp.
+func (g GrpcHandler) SendSignalMessage(ctx context.Context, data *Req) ([]byte, error) {
+	var signalReq rtc.SignalReq
+	if err := proto.Unmarshal(data.Data, &signalReq); err != nil {
+		return nil, errs.WrapMsg(err, "SendSignalMessage: error unmarshaling request", "action", "unmarshal", "dataType", "SignalReq")
+	}
+	resp, err := g.msgRpcClient.SignalMessageAssemble(ctx, &rtc.SignalMessageAssembleReq{SignalReq: &signalReq})
 	if err != nil {
 		return nil, err
 	}
-	c, err := proto.Marshal(resp)
+	c, err := proto.Marshal(resp.SignalResp)
 	if err != nil {
-		return nil, errs.WrapMsg(err, "error marshaling response", "action", "marshal", "dataType", "SendMsgResp")
+		return nil, errs.WrapMsg(err, "SendSignalMessage: error marshaling response", "action", "marshal", "dataType", "SignalResp")
 	}
 	return c, nil
 }
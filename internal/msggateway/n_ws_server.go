@@ -49,6 +49,12 @@ type LongConnServer interface {
 	KickUserConn(client *Client) error
 	UnRegister(c *Client)
 	SetKickHandlerInfo(i *kickHandler)
+	negotiateCompression(ctx *UserConnContext) string
+	bufferPush(ctx context.Context, userIDs []string, clients []*Client, data []byte) map[*Client]int64
+	refreshResume(client *Client)
+	allowRequest(client *Client, reqIdentifier int32) (kick bool, err error)
+	Drain(ctx context.Context)
+	startDrain(ctx context.Context) (int64, bool)
 	Compressor
 	Encoder
 	MessageHandler
@@ -67,6 +73,13 @@ type WsServer struct {
 	onlineUserConnNum atomic.Int64
 	handshakeTimeout  time.Duration
 	writeBufferSize   int
+	enableDeflate     bool
+	resume            *resumer
+	registry          *connRegistry
+	rateLimiter       *rateLimiter
+	draining          atomic.Bool
+	drainOnce         sync.Once
+	sseConns          sync.Map // connID -> *SSEConn
 	validate          *validator.Validate
 	userClient        *rpcclient.UserRpcClient
 	authClient        *rpcclient.Auth
@@ -89,6 +102,9 @@ func (ws *WsServer) SetDiscoveryRegistry(disCov discovery.SvcDiscoveryRegistry,
 	ws.authClient = rpcclient.NewAuth(disCov, config.Share.RpcRegisterName.Auth)
 	ws.userClient = &u
 	ws.disCov = disCov
+	if ws.registry != nil {
+		go ws.registry.run(disCov, ws.clients)
+	}
 }
 
 func (ws *WsServer) SetUserOnlineStatus(ctx context.Context, client *Client, status int32) {
@@ -112,6 +128,41 @@ func (ws *WsServer) Validate(_ any) error {
 	return nil
 }
 
+// negotiateCompression picks the compression used by a connection. Application level
+// compression requested by the client takes precedence over permessage-deflate, so that
+// frames are never compressed twice.
+func (ws *WsServer) negotiateCompression(ctx *UserConnContext) string {
+	if compression := ctx.GetCompressionProtocol(); compression != "" {
+		return compression
+	}
+	if ws.enableDeflate && ctx.OfferPerMessageDeflate() {
+		return PerMessageDeflate
+	}
+	return ""
+}
+
+// bufferPush buffers a push for session resume, see resumer.buffer.
+func (ws *WsServer) bufferPush(ctx context.Context, userIDs []string, clients []*Client, data []byte) map[*Client]int64 {
+	if ws.resume == nil {
+		return nil
+	}
+	return ws.resume.buffer(ctx, userIDs, clients, data)
+}
+
+func (ws *WsServer) refreshResume(client *Client) {
+	if ws.resume != nil {
+		ws.resume.refresh(client)
+	}
+}
+
+// allowRequest applies the rate limits to a request, see rateLimiter.allow.
+func (ws *WsServer) allowRequest(client *Client, reqIdentifier int32) (bool, error) {
+	if ws.rateLimiter == nil {
+		return false, nil
+	}
+	return ws.rateLimiter.allow(client, reqIdentifier)
+}
+
 func (ws *WsServer) GetUserAllCons(userID string) ([]*Client, bool) {
 	return ws.clients.GetAll(userID)
 }
@@ -126,12 +177,13 @@ func NewWsServer(msgGatewayConfig *Config, opts ...Option) (*WsServer, error) {
 		o(&config)
 	}
 	v := validator.New()
-	return &WsServer{
+	ws := &WsServer{
 		msgGatewayConfig: msgGatewayConfig,
 		port:             config.port,
 		wsMaxConnNum:     config.maxConnNum,
 		writeBufferSize:  config.writeBufferSize,
 		handshakeTimeout: config.handshakeTimeout,
+		enableDeflate:    config.perMessageDeflate,
 		clientPool: sync.Pool{
 			New: func() any {
 				return new(Client)
@@ -145,7 +197,20 @@ func NewWsServer(msgGatewayConfig *Config, opts ...Option) (*WsServer, error) {
 		Compressor:      NewGzipCompressor(),
 		Encoder:         NewGobEncoder(),
 		webhookClient:   webhook.NewWebhookClient(msgGatewayConfig.WebhooksConfig.URL),
-	}, nil
+	}
+	if resume := msgGatewayConfig.MsgGateway.Resume; resume.Enable && config.resumeCache != nil {
+		ws.resume = newResumer(config.resumeCache, time.Duration(resume.Window)*time.Second, resume.RingSize)
+	}
+	if config.connRouteCache != nil {
+		ws.registry = newConnRegistry(config.connRouteCache)
+		if ws.resume != nil {
+			ws.resume.release = ws.registry.unregisterConn
+		}
+	}
+	if msgGatewayConfig.MsgGateway.RateLimit.Enable {
+		ws.rateLimiter = newRateLimiter(&msgGatewayConfig.MsgGateway)
+	}
+	return ws, nil
 }
 
 func (ws *WsServer) Run(done chan error) error {
@@ -171,20 +236,27 @@ func (ws *WsServer) Run(done chan error) error {
 			}
 		}
 	}()
+	if ws.resume != nil {
+		go ws.resume.run(shutdownDone)
+	}
 	netDone := make(chan struct{}, 1)
 	go func() {
 		http.HandleFunc("/", ws.wsHandler)
+		http.HandleFunc(sseSendPath, ws.sseSendHandler)
 		err := server.ListenAndServe()
 		defer close(netDone)
 		if err != nil && err != http.ErrServerClosed {
 			netErr = errs.WrapMsg(err, "ws start err", server.Addr)
 		}
 	}()
-	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
-	defer cancel()
 	var err error
 	select {
 	case err = <-done:
+		if err == nil {
+			ws.terminate()
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
 		sErr := server.Shutdown(ctx)
 		if sErr != nil {
 			return errs.WrapMsg(sErr, "shutdown err")
@@ -282,8 +354,16 @@ func (ws *WsServer) registerClient(client *Client) {
 		ws.SetUserOnlineStatus(client.ctx, client, constant.Online)
 	}()
 
+	if ws.registry != nil {
+		ws.registry.register(client.ctx, client)
+	}
+
 	wg.Wait()
 
+	if ws.resume != nil {
+		go ws.resume.start(client)
+	}
+
 	log.ZInfo(
 		client.ctx,
 		"user online",
@@ -307,6 +387,7 @@ func getRemoteAdders(client []*Client) string {
 }
 
 func (ws *WsServer) KickUserConn(client *Client) error {
+	client.discardResume()
 	ws.clients.deleteClients(client.UserID, []*Client{client})
 	return client.KickOnlineMessage()
 }
@@ -341,16 +422,30 @@ func (ws *WsServer) multiTerminalLoginChecker(clientOK bool, oldClients []*Clien
 	}
 }
 
+// releaseConn suspends the resume session of a closed connection and removes its route. The route
+// of a suspended session is kept until the session is resumed or expires, so that its pushes still
+// reach this node and are buffered.
+func (ws *WsServer) releaseConn(client *Client) {
+	suspended := ws.resume != nil && ws.resume.suspend(client)
+	if ws.registry != nil && !suspended {
+		ws.registry.unregister(client.ctx, client)
+	}
+}
+
 func (ws *WsServer) unregisterClient(client *Client) {
 	defer ws.clientPool.Put(client)
 	isDeleteUser := ws.clients.delete(client.UserID, client.ctx.GetRemoteAddr())
 	if isDeleteUser {
 		ws.onlineUserNum.Add(-1)
 		prommetrics.OnlineUserGauge.Dec()
+		if ws.rateLimiter != nil {
+			ws.rateLimiter.removeUser(client.UserID)
+		}
 	}
 	ws.onlineUserConnNum.Add(-1)
+	ws.releaseConn(client)
 	ws.SetUserOnlineStatus(client.ctx, client, constant.Offline)
-	log.ZInfo(client.ctx, "user offline", "close reason", client.closedErr, "online user Num",
+	log.ZInfo(client.ctx, "user offline", "close reason", client.getClosedErr(), "online user Num",
 		ws.onlineUserNum.Load(), "online user conn Num",
 		ws.onlineUserConnNum.Load(),
 	)
@@ -373,6 +468,12 @@ func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 	// Create a new connection context
 	connContext := newContext(w, r)
 
+	// Refuse new connections while the node is draining
+	if ws.draining.Load() {
+		httpError(connContext, servererrs.ErrConnDraining.WrapMsg("node is draining"))
+		return
+	}
+
 	// Check if the current number of online user connections exceeds the maximum limit
 	if ws.onlineUserConnNum.Load() >= ws.wsMaxConnNum {
 		// If it exceeds the maximum connection number, return an error via HTTP and stop processing
@@ -396,7 +497,7 @@ func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 		shouldSendError := connContext.ShouldSendResp()
 		if shouldSendError {
 			// Create a WebSocket connection object and attempt to send the error message via WebSocket
-			wsLongConn := newGWebSocket(WebSocket, ws.handshakeTimeout, ws.writeBufferSize)
+			wsLongConn := newGWebSocket(WebSocket, ws.handshakeTimeout, ws.writeBufferSize, false)
 			if err := wsLongConn.RespondWithError(err, w, r); err == nil {
 				// If the error message is successfully sent via WebSocket, stop processing
 				return
@@ -415,29 +516,56 @@ func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Create a WebSocket long connection object
-	wsLongConn := newGWebSocket(WebSocket, ws.handshakeTimeout, ws.writeBufferSize)
-	if err := wsLongConn.GenerateLongConn(w, r); err != nil {
-		//If the creation of the long connection fails, the error is handled internally during the handshake process.
-		log.ZWarn(connContext, "long connection fails", err)
-		return
+	var longConn LongConn
+	if connContext.GetTransport() == SSETransport {
+		// Server-Sent Events downstream with HTTP POST upstream, for clients that can not use WebSocket
+		connID := connContext.GetConnID()
+		sseConn := newSSEConn(connID, connContext.GetToken(), func() { ws.sseConns.Delete(connID) })
+		if err := sseConn.GenerateLongConn(w, r); err != nil {
+			log.ZWarn(connContext, "sse connection fails", err)
+			httpError(connContext, err)
+			return
+		}
+		ws.sseConns.Store(connID, sseConn)
+		longConn = sseConn
 	} else {
-		// Check if a normal response should be sent via WebSocket
-		shouldSendSuccessResp := connContext.ShouldSendResp()
-		if shouldSendSuccessResp {
-			// Attempt to send a success message through WebSocket
-			if err := wsLongConn.RespondWithSuccess(); err != nil {
-				// If the success message is successfully sent, end further processing
-				return
+		// Create a WebSocket long connection object
+		wsLongConn := newGWebSocket(WebSocket, ws.handshakeTimeout, ws.writeBufferSize,
+			ws.negotiateCompression(connContext) == PerMessageDeflate)
+		if err := wsLongConn.GenerateLongConn(w, r); err != nil {
+			//If the creation of the long connection fails, the error is handled internally during the handshake process.
+			log.ZWarn(connContext, "long connection fails", err)
+			return
+		} else {
+			// Check if a normal response should be sent via WebSocket
+			shouldSendSuccessResp := connContext.ShouldSendResp()
+			if shouldSendSuccessResp {
+				// Attempt to send a success message through WebSocket
+				if err := wsLongConn.RespondWithSuccess(); err != nil {
+					// If the success message is successfully sent, end further processing
+					return
+				}
 			}
 		}
+		longConn = wsLongConn
 	}
 
 	// Retrieve a client object from the client pool, reset its state, and associate it with the current WebSocket long connection
 	client := ws.clientPool.Get().(*Client)
-	client.ResetClient(connContext, wsLongConn, ws)
+	client.ResetClient(connContext, longConn, ws)
+	client.resume.pending = ws.resume != nil && connContext.GetResumeToken() != ""
+	if ws.rateLimiter != nil {
+		client.limit = ws.rateLimiter.newConnLimit()
+	}
+	client.queue = newWriteQueue(ws.msgGatewayConfig.MsgGateway.WriteQueue.Size, ws.msgGatewayConfig.MsgGateway.WriteQueue.OverflowPolicy)
 
 	// Register the client with the server and start message processing
 	ws.registerChan <- client
+	go client.writeLoop(client.queue)
+	if _, ok := longConn.(*SSEConn); ok {
+		// The event stream lives as long as this request, so keep the handler running until the connection closes
+		client.readMessage()
+		return
+	}
 	go client.readMessage()
 }
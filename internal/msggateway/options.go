@@ -14,7 +14,11 @@
 
 package msggateway
 
-import "time"
+import (
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/cache"
+)
 
 type (
 	Option  func(opt *configs)
@@ -29,6 +33,12 @@ type (
 		messageMaxMsgLength int
 		// Websocket write buffer, default: 4096, 4kb.
 		writeBufferSize int
+		// Whether permessage-deflate may be negotiated with clients
+		perMessageDeflate bool
+		// Buffers pushes for session resume after a reconnect
+		resumeCache cache.ResumeCache
+		// Registers the connections of this node for push routing
+		connRouteCache cache.ConnRouteCache
 	}
 )
 
@@ -61,3 +71,21 @@ func WithWriteBufferSize(size int) Option {
 		opt.writeBufferSize = size
 	}
 }
+
+func WithPerMessageDeflate(enable bool) Option {
+	return func(opt *configs) {
+		opt.perMessageDeflate = enable
+	}
+}
+
+func WithResumeCache(resumeCache cache.ResumeCache) Option {
+	return func(opt *configs) {
+		opt.resumeCache = resumeCache
+	}
+}
+
+func WithConnRouteCache(connRouteCache cache.ConnRouteCache) Option {
+	return func(opt *configs) {
+		opt.connRouteCache = connRouteCache
+	}
+}
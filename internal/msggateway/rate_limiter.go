@@ -0,0 +1,161 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/config"
+	"github.com/Meikwei/aetim/pkg/common/prommetrics"
+	"github.com/Meikwei/aetim/pkg/common/servererrs"
+	"golang.org/x/time/rate"
+)
+
+const (
+	rateLimitActionReject = "reject"
+	rateLimitActionMute   = "mute"
+	rateLimitActionKick   = "kick"
+)
+
+// limitBuckets holds one token bucket per rate limited ReqIdentifier.
+type limitBuckets map[int32]*rate.Limiter
+
+func newLimitBuckets(limits *config.RateLimits) limitBuckets {
+	buckets := make(limitBuckets)
+	for reqIdentifier, limit := range map[int32]config.RateLimit{
+		WSSendMsg:          limits.SendMsg,
+		WSPullMsgBySeqList: limits.PullMsg,
+		WSGetNewestSeq:     limits.GetNewestSeq,
+	} {
+		if limit.Rate > 0 {
+			burst := limit.Burst
+			if burst <= 0 {
+				burst = 1
+			}
+			buckets[reqIdentifier] = rate.NewLimiter(rate.Limit(limit.Rate), burst)
+		}
+	}
+	return buckets
+}
+
+func (b limitBuckets) allow(reqIdentifier int32, now time.Time) bool {
+	bucket, ok := b[reqIdentifier]
+	return !ok || bucket.AllowN(now, 1)
+}
+
+// connLimit is the rate limit state of a connection. violations and mutes hold the
+// times of recent rejections and mutes, oldest first.
+type connLimit struct {
+	mu         sync.Mutex
+	buckets    limitBuckets
+	violations []time.Time
+	mutes      []time.Time
+	mutedUntil time.Time
+}
+
+// slideWindow drops the times older than window.
+func slideWindow(times []time.Time, now time.Time, window time.Duration) []time.Time {
+	i := 0
+	for i < len(times) && now.Sub(times[i]) > window {
+		i++
+	}
+	return times[i:]
+}
+
+// rateLimiter applies the per connection and per user limits of the gateway. Rejected
+// requests escalate from an error reply to a temporary mute of the connection, and
+// finally to a kick. Both counters are sliding windows, so a connection that stays
+// within its limits for long enough is back to a clean state.
+type rateLimiter struct {
+	conn            config.RateLimits
+	user            config.RateLimits
+	violationWindow time.Duration
+	muteThreshold   int
+	muteDuration    time.Duration
+	muteWindow      time.Duration
+	kickThreshold   int
+	users           sync.Map
+}
+
+func newRateLimiter(conf *config.MsgGateway) *rateLimiter {
+	limit := &conf.RateLimit
+	return &rateLimiter{
+		conn:            limit.Conn,
+		user:            limit.User,
+		violationWindow: time.Duration(limit.ViolationWindow) * time.Second,
+		muteThreshold:   limit.MuteThreshold,
+		muteDuration:    time.Duration(limit.MuteDuration) * time.Second,
+		muteWindow:      time.Duration(limit.MuteWindow) * time.Second,
+		kickThreshold:   limit.KickThreshold,
+	}
+}
+
+func (l *rateLimiter) newConnLimit() *connLimit {
+	return &connLimit{buckets: newLimitBuckets(&l.conn)}
+}
+
+func (l *rateLimiter) getUserBuckets(userID string) limitBuckets {
+	if buckets, ok := l.users.Load(userID); ok {
+		return buckets.(limitBuckets)
+	}
+	buckets, _ := l.users.LoadOrStore(userID, newLimitBuckets(&l.user))
+	return buckets.(limitBuckets)
+}
+
+// removeUser drops the user buckets once the user has no connection left on this node.
+func (l *rateLimiter) removeUser(userID string) {
+	l.users.Delete(userID)
+}
+
+// allow reports whether the request may be handled. When it may not, err is the error
+// replied to the client, and kick is set if the connection must be closed.
+func (l *rateLimiter) allow(client *Client, reqIdentifier int32) (kick bool, err error) {
+	limit := client.limit
+	if limit == nil {
+		return false, nil
+	}
+	now := time.Now()
+	limit.mu.Lock()
+	defer limit.mu.Unlock()
+	if _, ok := limit.buckets[reqIdentifier]; !ok {
+		if _, ok := l.getUserBuckets(client.UserID)[reqIdentifier]; !ok {
+			return false, nil
+		}
+	}
+	req := strconv.Itoa(int(reqIdentifier))
+	if now.Before(limit.mutedUntil) {
+		prommetrics.MsgGatewayRateLimitedCounter.WithLabelValues(req, rateLimitActionMute).Inc()
+		return false, servererrs.ErrConnMuted.WrapMsg("connection is muted", "until", limit.mutedUntil.UnixMilli())
+	}
+	if limit.buckets.allow(reqIdentifier, now) && l.getUserBuckets(client.UserID).allow(reqIdentifier, now) {
+		return false, nil
+	}
+	limit.violations = append(slideWindow(limit.violations, now, l.violationWindow), now)
+	if l.muteThreshold <= 0 || len(limit.violations) < l.muteThreshold {
+		prommetrics.MsgGatewayRateLimitedCounter.WithLabelValues(req, rateLimitActionReject).Inc()
+		return false, servererrs.ErrConnRateLimited.WrapMsg("too many requests", "reqIdentifier", reqIdentifier)
+	}
+	limit.violations = nil
+	limit.mutes = append(slideWindow(limit.mutes, now, l.muteWindow), now)
+	if l.kickThreshold > 0 && len(limit.mutes) >= l.kickThreshold {
+		prommetrics.MsgGatewayRateLimitedCounter.WithLabelValues(req, rateLimitActionKick).Inc()
+		return true, servererrs.ErrConnRateLimited.WrapMsg("too many requests, connection closed", "reqIdentifier", reqIdentifier)
+	}
+	limit.mutedUntil = now.Add(l.muteDuration)
+	prommetrics.MsgGatewayRateLimitedCounter.WithLabelValues(req, rateLimitActionMute).Inc()
+	return false, servererrs.ErrConnMuted.WrapMsg("too many requests, connection muted", "until", limit.mutedUntil.UnixMilli())
+}
@@ -0,0 +1,84 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/config"
+	"github.com/Meikwei/aetim/pkg/common/servererrs"
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func rateLimitCode(err error) int {
+	var codeErr errs.CodeError
+	if errors.As(err, &codeErr) {
+		return codeErr.Code()
+	}
+	return 0
+}
+
+func TestRateLimiterEscalation(t *testing.T) {
+	var conf config.MsgGateway
+	conf.RateLimit.Conn.SendMsg = config.RateLimit{Rate: 0.001, Burst: 2}
+	conf.RateLimit.ViolationWindow = 60
+	conf.RateLimit.MuteThreshold = 2
+	conf.RateLimit.MuteDuration = 60
+	conf.RateLimit.MuteWindow = 600
+	conf.RateLimit.KickThreshold = 2
+	l := newRateLimiter(&conf)
+	client := &Client{UserID: "u1", limit: l.newConnLimit()}
+
+	for i := 0; i < 2; i++ {
+		kick, err := l.allow(client, WSSendMsg)
+		assert.False(t, kick)
+		assert.NoError(t, err)
+	}
+	// requests without a limit are never rejected
+	_, err := l.allow(client, WSPullMsgBySeqList)
+	assert.NoError(t, err)
+
+	kick, err := l.allow(client, WSSendMsg)
+	assert.False(t, kick)
+	assert.Equal(t, servererrs.ConnRateLimited, rateLimitCode(err))
+
+	kick, err = l.allow(client, WSSendMsg)
+	assert.False(t, kick)
+	assert.Equal(t, servererrs.ConnMuted, rateLimitCode(err))
+
+	kick, err = l.allow(client, WSSendMsg)
+	assert.False(t, kick)
+	assert.Equal(t, servererrs.ConnMuted, rateLimitCode(err))
+
+	// a mute older than the mute window no longer counts toward the kick
+	client.limit.mutedUntil = time.Time{}
+	client.limit.mutes[0] = client.limit.mutes[0].Add(-l.muteWindow - time.Second)
+	for i := 0; i < 2; i++ {
+		kick, err = l.allow(client, WSSendMsg)
+	}
+	assert.False(t, kick)
+	assert.Equal(t, servererrs.ConnMuted, rateLimitCode(err))
+
+	// the second mute within the window kicks the connection
+	client.limit.mutedUntil = time.Time{}
+	for i := 0; i < 2; i++ {
+		kick, err = l.allow(client, WSSendMsg)
+	}
+	assert.True(t, kick)
+	assert.Equal(t, servererrs.ConnRateLimited, rateLimitCode(err))
+}
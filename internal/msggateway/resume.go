@@ -0,0 +1,338 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/cache"
+	"github.com/Meikwei/go-tools/log"
+	"github.com/Meikwei/go-tools/mcontext"
+	"github.com/Meikwei/go-tools/utils/idutil"
+)
+
+const (
+	defaultResumeWindow   = 120 * time.Second
+	defaultResumeRingSize = 256
+)
+
+// ResumeResp is the payload of the WSResume frame sent once a connection is registered.
+// Resumed reports whether the missed pushes were replayed; when false the client must
+// fall back to a full resync.
+type ResumeResp struct {
+	ResumeToken string `json:"resumeToken"`
+	Resumed     bool   `json:"resumed"`
+}
+
+// resumeState tracks the resume session owned by a connection.
+type resumeState struct {
+	mu sync.Mutex
+	// token of the session, empty until the session is created or resumed
+	token string
+	// pending is set while a requested resume has not completed, pushes are then
+	// delivered by the replay
+	pending bool
+	// pushes up to replayedTo were written by the replay
+	replayedTo int64
+	// discard is set when the client closed the connection on purpose
+	discard   bool
+	refreshed time.Time
+}
+
+type suspendedSession struct {
+	token      string
+	platformID int
+	connID     string
+	expire     time.Time
+}
+
+// resumer keeps the pushes of dropped connections in a Redis ring until the client
+// reconnects or the resume window expires.
+type resumer struct {
+	cache     cache.ResumeCache
+	window    time.Duration
+	ringSize  int
+	lock      sync.Mutex
+	suspended map[string][]*suspendedSession
+	// release is called for each suspended session removed, the route of its connection
+	// is kept while it is suspended so that pushes still reach this node
+	release func(ctx context.Context, userID string, platformID int, connID string)
+}
+
+func newResumer(resumeCache cache.ResumeCache, window time.Duration, ringSize int) *resumer {
+	if window <= 0 {
+		window = defaultResumeWindow
+	}
+	if ringSize <= 0 {
+		ringSize = defaultResumeRingSize
+	}
+	return &resumer{
+		cache:     resumeCache,
+		window:    window,
+		ringSize:  ringSize,
+		suspended: make(map[string][]*suspendedSession),
+	}
+}
+
+func newResumeToken() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// run removes expired suspended sessions until done is closed.
+func (r *resumer) run(done <-chan struct{}) {
+	ticker := time.NewTicker(r.window)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case now := <-ticker.C:
+			ctx := mcontext.SetOperationID(context.Background(), idutil.OperationIDGenerator())
+			r.lock.Lock()
+			for userID, sessions := range r.suspended {
+				r.setSuspended(ctx, userID, sessions, func(s *suspendedSession) bool { return now.Before(s.expire) })
+			}
+			r.lock.Unlock()
+		}
+	}
+}
+
+// setSuspended keeps the sessions of userID matching keep and releases the others, the caller must hold r.lock.
+func (r *resumer) setSuspended(ctx context.Context, userID string, sessions []*suspendedSession, keep func(s *suspendedSession) bool) {
+	kept := sessions[:0]
+	for _, s := range sessions {
+		if keep(s) {
+			kept = append(kept, s)
+		} else if r.release != nil {
+			r.release(ctx, userID, s.platformID, s.connID)
+		}
+	}
+	if len(kept) == 0 {
+		delete(r.suspended, userID)
+	} else {
+		r.suspended[userID] = kept
+	}
+}
+
+// start resumes the session requested by the client or creates a new one, then tells
+// the client which token to use for its next reconnect.
+func (r *resumer) start(client *Client) {
+	state := client.resume
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	var (
+		ctx     = client.ctx
+		connID  = client.ctx.GetConnID()
+		resumed bool
+	)
+	if state.pending {
+		token := client.ctx.GetResumeToken()
+		lastID := client.ctx.GetLastPushID()
+		pushes, complete, err := r.cache.TakeResumeSession(ctx, client.UserID, client.PlatformID, token, connID, lastID, r.window)
+		if err != nil {
+			log.ZWarn(ctx, "take resume session failed", err, "resumeToken", token)
+		} else if complete {
+			r.lock.Lock()
+			if sessions, ok := r.suspended[client.UserID]; ok {
+				r.setSuspended(ctx, client.UserID, sessions, func(s *suspendedSession) bool { return s.token != token })
+			}
+			r.lock.Unlock()
+			ids := make([]int64, 0, len(pushes))
+			for id := range pushes {
+				ids = append(ids, id)
+			}
+			sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+			state.replayedTo = lastID
+			for _, id := range ids {
+				if err := client.writePushMsg(ctx, pushes[id], id); err != nil {
+					log.ZWarn(ctx, "replay push failed", err, "pushID", id)
+				}
+				state.replayedTo = id
+			}
+			state.token = token
+			resumed = true
+			log.ZInfo(ctx, "session resumed", "resumeToken", token, "lastPushID", lastID, "replayed", len(ids))
+		}
+		state.pending = false
+	}
+	if !resumed {
+		token := newResumeToken()
+		if err := r.cache.CreateResumeSession(ctx, client.UserID, client.PlatformID, token, connID, r.window); err != nil {
+			log.ZWarn(ctx, "create resume session failed", err)
+		} else {
+			state.token = token
+			state.replayedTo = 0
+		}
+	}
+	state.refreshed = time.Now()
+	data, err := json.Marshal(ResumeResp{ResumeToken: state.token, Resumed: resumed})
+	if err != nil {
+		log.ZWarn(ctx, "marshal resume resp failed", err)
+		return
+	}
+	if err := client.writeBinaryMsg(Resp{ReqIdentifier: WSResume, OperationID: client.ctx.GetOperationID(), Data: data}); err != nil {
+		log.ZWarn(ctx, "write resume resp failed", err)
+	}
+}
+
+// buffer appends a push to the sessions of the given connections and to the suspended
+// sessions of userIDs, and returns the push ID assigned for each connection.
+func (r *resumer) buffer(ctx context.Context, userIDs []string, clients []*Client, data []byte) map[*Client]int64 {
+	var (
+		pushes    []*cache.ResumePush
+		owners    []*Client
+		suspended []*suspendedSession
+	)
+	for _, client := range clients {
+		client.resume.mu.Lock()
+		token := client.resume.token
+		client.resume.mu.Unlock()
+		if token == "" {
+			continue
+		}
+		pushes = append(pushes, &cache.ResumePush{Token: token, ConnID: client.ctx.GetConnID(), Data: data})
+		owners = append(owners, client)
+	}
+	now := time.Now()
+	r.lock.Lock()
+	for _, userID := range userIDs {
+		for _, s := range r.suspended[userID] {
+			if now.Before(s.expire) {
+				pushes = append(pushes, &cache.ResumePush{Token: s.token, ConnID: s.connID, Data: data})
+				suspended = append(suspended, s)
+			}
+		}
+	}
+	r.lock.Unlock()
+	if len(pushes) == 0 {
+		return nil
+	}
+	ids, err := r.cache.AppendResumePushes(ctx, pushes, r.ringSize, r.window)
+	if err != nil {
+		log.ZWarn(ctx, "append resume pushes failed", err, "count", len(pushes))
+		return nil
+	}
+	pushIDs := make(map[*Client]int64, len(owners))
+	for i, client := range owners {
+		if ids[i] > 0 {
+			pushIDs[client] = ids[i]
+		}
+	}
+	// 会话已过期或已被新连接接管，不再缓存
+	gone := make(map[*suspendedSession]struct{})
+	for i, s := range suspended {
+		if ids[len(owners)+i] < 0 {
+			gone[s] = struct{}{}
+		}
+	}
+	if len(gone) > 0 {
+		r.lock.Lock()
+		for _, userID := range userIDs {
+			if sessions, ok := r.suspended[userID]; ok {
+				r.setSuspended(ctx, userID, sessions, func(s *suspendedSession) bool {
+					_, ok := gone[s]
+					return !ok
+				})
+			}
+		}
+		r.lock.Unlock()
+	}
+	return pushIDs
+}
+
+// suspend keeps the session of a dropped connection for the resume window. It reports whether
+// the session is kept, the route of the connection is then released with the session.
+func (r *resumer) suspend(client *Client) bool {
+	state := client.resume
+	state.mu.Lock()
+	token, discard := state.token, state.discard
+	state.mu.Unlock()
+	if token == "" || discard {
+		return false
+	}
+	var (
+		ctx    = client.ctx
+		userID = client.UserID
+		connID = client.ctx.GetConnID()
+	)
+	r.lock.Lock()
+	r.suspended[userID] = append(r.suspended[userID], &suspendedSession{
+		token:      token,
+		platformID: client.PlatformID,
+		connID:     connID,
+		expire:     time.Now().Add(r.window),
+	})
+	r.lock.Unlock()
+	go func() {
+		if err := r.cache.ExpireResumeSession(ctx, token, r.window); err != nil {
+			log.ZWarn(ctx, "expire resume session failed", err, "resumeToken", token)
+		}
+	}()
+	return true
+}
+
+// refresh extends the session of a live connection, at most every half window.
+func (r *resumer) refresh(client *Client) {
+	state := client.resume
+	now := time.Now()
+	state.mu.Lock()
+	if state.token == "" || now.Sub(state.refreshed) < r.window/2 {
+		state.mu.Unlock()
+		return
+	}
+	state.refreshed = now
+	token := state.token
+	state.mu.Unlock()
+	ctx := client.ctx
+	go func() {
+		if err := r.cache.ExpireResumeSession(ctx, token, r.window); err != nil {
+			log.ZWarn(ctx, "refresh resume session failed", err, "resumeToken", token)
+		}
+	}()
+}
+
+// drop deletes the sessions of the given connections and the suspended ones, their clients
+// fall back to a full resync when they reconnect.
+func (r *resumer) drop(ctx context.Context, clients []*Client) {
+	var tokens []string
+	r.lock.Lock()
+	for userID, sessions := range r.suspended {
+		for _, s := range sessions {
+			tokens = append(tokens, s.token)
+		}
+		r.setSuspended(ctx, userID, sessions, func(*suspendedSession) bool { return false })
+	}
+	r.lock.Unlock()
+	for _, client := range clients {
+		state := client.resume
+		state.mu.Lock()
+		state.discard = true
+		if state.token != "" {
+			tokens = append(tokens, state.token)
+		}
+		state.mu.Unlock()
+	}
+	if err := r.cache.DelResumeSessions(ctx, tokens); err != nil {
+		log.ZWarn(ctx, "delete resume sessions failed", err, "count", len(tokens))
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/cache"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockResumeCache owns one session per token, appends for other connections are rejected.
+type mockResumeCache struct {
+	cache.ResumeCache
+	owners map[string]string
+	rings  map[string][][]byte
+}
+
+func (m *mockResumeCache) AppendResumePushes(_ context.Context, pushes []*cache.ResumePush, _ int, _ time.Duration) ([]int64, error) {
+	ids := make([]int64, len(pushes))
+	for i, push := range pushes {
+		if m.owners[push.Token] != push.ConnID {
+			ids[i] = -1
+			continue
+		}
+		m.rings[push.Token] = append(m.rings[push.Token], push.Data)
+		ids[i] = int64(len(m.rings[push.Token]))
+	}
+	return ids, nil
+}
+
+func (m *mockResumeCache) ExpireResumeSession(context.Context, string, time.Duration) error {
+	return nil
+}
+
+// mockConnRouteCache records the connections whose route was deleted.
+type mockConnRouteCache struct {
+	cache.ConnRouteCache
+	lock    sync.Mutex
+	deleted []string
+}
+
+func (m *mockConnRouteCache) DelConnRoute(_ context.Context, _ string, _ string, connID string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.deleted = append(m.deleted, connID)
+	return nil
+}
+
+func (m *mockConnRouteCache) getDeleted() []string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return append([]string(nil), m.deleted...)
+}
+
+func TestResumerBufferSuspended(t *testing.T) {
+	mock := &mockResumeCache{
+		owners: map[string]string{"t1": "c1", "t2": "c3"},
+		rings:  make(map[string][][]byte),
+	}
+	r := newResumer(mock, time.Minute, 0)
+	r.suspended["u1"] = []*suspendedSession{
+		{token: "t1", connID: "c1", expire: time.Now().Add(time.Minute)},
+		{token: "t2", connID: "c2", expire: time.Now().Add(time.Minute)},
+		{token: "t3", connID: "c4", expire: time.Now().Add(-time.Second)},
+	}
+
+	r.buffer(context.Background(), []string{"u1", "u2"}, nil, []byte("push"))
+
+	assert.Len(t, mock.rings["t1"], 1)
+	assert.Empty(t, mock.rings["t2"])
+	// t2 was taken over by another connection and is no longer buffered
+	if assert.Len(t, r.suspended["u1"], 2) {
+		assert.Equal(t, "t1", r.suspended["u1"][0].token)
+		assert.Equal(t, "t3", r.suspended["u1"][1].token)
+	}
+}
+
+func TestSuspendedSessionKeepsRoute(t *testing.T) {
+	resumeCache := &mockResumeCache{
+		owners: map[string]string{"t1": "c1"},
+		rings:  make(map[string][][]byte),
+	}
+	routeCache := &mockConnRouteCache{}
+	conf := &Config{}
+	conf.MsgGateway.Resume.Enable = true
+	conf.MsgGateway.Resume.Window = 60
+	ws, err := NewWsServer(conf, WithResumeCache(resumeCache), WithConnRouteCache(routeCache))
+	assert.NoError(t, err)
+	ws.registry.node = "n1"
+
+	client := &Client{
+		UserID:     "u1",
+		PlatformID: 1,
+		ctx:        &UserConnContext{ConnID: "c1"},
+		resume:     &resumeState{token: "t1"},
+	}
+	ws.releaseConn(client)
+
+	// the route stays while the session is suspended, so the pushes of u1 still reach this node
+	ws.bufferPush(context.Background(), []string{"u1"}, nil, []byte("push"))
+	assert.Len(t, resumeCache.rings["t1"], 1)
+	time.Sleep(50 * time.Millisecond)
+	assert.Empty(t, routeCache.getDeleted())
+
+	// once the session is taken over by another connection, the route is removed
+	resumeCache.owners["t1"] = "c2"
+	ws.bufferPush(context.Background(), []string{"u1"}, nil, []byte("push"))
+	assert.Eventually(t, func() bool {
+		deleted := routeCache.getDeleted()
+		return len(deleted) == 1 && deleted[0] == "c1"
+	}, time.Second, 10*time.Millisecond)
+
+	// a connection without a session to resume loses its route at once
+	ws.releaseConn(&Client{UserID: "u2", ctx: &UserConnContext{ConnID: "c3"}, resume: new(resumeState)})
+	assert.Eventually(t, func() bool { return len(routeCache.getDeleted()) == 2 }, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,332 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Meikwei/go-tools/errs"
+)
+
+const (
+	// sseSendPath is where SSE clients POST their upstream frames.
+	sseSendPath = "/sse/send"
+	// Maximum number of upstream frames waiting to be read by the client.
+	sseInboundSize = 64
+)
+
+var ErrSSEConnClosed = errs.New("sse conn has closed")
+
+type sseFrame struct {
+	messageType int
+	data        []byte
+}
+
+// SSEConn is a LongConn for clients that can not upgrade to WebSocket. Frames to the client
+// are Server-Sent Events on the response of the connecting GET request, binary frames are
+// base64 encoded. Frames from the client are POSTed to sseSendPath with the connID received
+// in the first "open" event.
+type SSEConn struct {
+	connID       string
+	token        string
+	w            sync.Mutex
+	writer       http.ResponseWriter
+	controller   *http.ResponseController
+	reqCtx       context.Context
+	writeTimeout time.Duration
+	bytesOut     atomic.Int64
+	r            sync.Mutex
+	readDeadline time.Time
+	readLimit    int64
+	inbound      chan sseFrame
+	done         chan struct{}
+	closed       bool
+	pingHandler  PingPongHandler
+	pongHandler  PingPongHandler
+	onClose      func()
+}
+
+func newSSEConn(connID string, token string, onClose func()) *SSEConn {
+	return &SSEConn{
+		connID:  connID,
+		token:   token,
+		inbound: make(chan sseFrame, sseInboundSize),
+		done:    make(chan struct{}),
+		onClose: onClose,
+	}
+}
+
+func (d *SSEConn) Close() error {
+	d.w.Lock()
+	if d.closed {
+		d.w.Unlock()
+		return nil
+	}
+	d.closed = true
+	close(d.done)
+	d.w.Unlock()
+	if d.onClose != nil {
+		d.onClose()
+	}
+	return nil
+}
+
+func (d *SSEConn) GenerateLongConn(w http.ResponseWriter, r *http.Request) error {
+	if _, ok := w.(http.Flusher); !ok {
+		return errs.New("GenerateLongConn: streaming is not supported by the response writer")
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// Disable response buffering of nginx
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	d.writer = w
+	d.controller = http.NewResponseController(w)
+	d.reqCtx = r.Context()
+	data, err := json.Marshal(map[string]string{ConnID: d.connID})
+	if err != nil {
+		return errs.WrapMsg(err, "json marshal failed")
+	}
+	return d.writeEvent("open", string(data))
+}
+
+// writeEvent writes one event and flushes it, the caller must not hold d.w.
+func (d *SSEConn) writeEvent(event string, data string) error {
+	d.w.Lock()
+	defer d.w.Unlock()
+	if d.closed || d.writer == nil {
+		return ErrSSEConnClosed
+	}
+	if d.writeTimeout > 0 {
+		_ = d.controller.SetWriteDeadline(time.Now().Add(d.writeTimeout))
+	}
+	var b strings.Builder
+	b.WriteString("event: ")
+	b.WriteString(event)
+	b.WriteByte('\n')
+	for _, line := range strings.Split(data, "\n") {
+		b.WriteString("data: ")
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+	b.WriteByte('\n')
+	n, err := io.WriteString(d.writer, b.String())
+	d.bytesOut.Add(int64(n))
+	if err != nil {
+		return errs.WrapMsg(err, "SSEConn write failed")
+	}
+	return errs.Wrap(d.controller.Flush())
+}
+
+func (d *SSEConn) BytesOut() int64 {
+	return d.bytesOut.Load()
+}
+
+func (d *SSEConn) WriteMessage(messageType int, message []byte) error {
+	switch messageType {
+	case MessageText:
+		return d.writeEvent("text", string(message))
+	case MessageBinary:
+		return d.writeEvent("binary", base64.StdEncoding.EncodeToString(message))
+	case PongMessage:
+		return d.writeEvent("pong", "")
+	case PingMessage:
+		return d.writeEvent("ping", "")
+	case CloseMessage:
+		return d.Close()
+	default:
+		return errs.New("SSEConn unsupported message type", "messageType", messageType)
+	}
+}
+
+// deliver hands a frame POSTed by the client to ReadMessage.
+func (d *SSEConn) deliver(messageType int, data []byte) error {
+	select {
+	case <-d.done:
+		return ErrSSEConnClosed
+	default:
+	}
+	select {
+	case d.inbound <- sseFrame{messageType: messageType, data: data}:
+		return nil
+	default:
+		return errs.New("too many pending frames")
+	}
+}
+
+func (d *SSEConn) ReadMessage() (int, []byte, error) {
+	for {
+		frame, err := d.readFrame()
+		if err != nil {
+			return 0, nil, err
+		}
+		// ping and pong frames are handled here, the same way gorilla/websocket does
+		switch {
+		case frame.messageType == PingMessage && d.pingHandler != nil:
+			if err := d.pingHandler(string(frame.data)); err != nil {
+				return 0, nil, err
+			}
+			continue
+		case frame.messageType == PongMessage && d.pongHandler != nil:
+			if err := d.pongHandler(string(frame.data)); err != nil {
+				return 0, nil, err
+			}
+			continue
+		}
+		return frame.messageType, frame.data, nil
+	}
+}
+
+// readFrame waits for the next upstream frame until the read deadline, which the ping
+// and pong handlers may have moved since the previous frame.
+func (d *SSEConn) readFrame() (sseFrame, error) {
+	var reqDone <-chan struct{}
+	if d.reqCtx != nil {
+		reqDone = d.reqCtx.Done()
+	}
+	var timeout <-chan time.Time
+	if deadline := d.getReadDeadline(); !deadline.IsZero() {
+		timer := time.NewTimer(time.Until(deadline))
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	select {
+	case <-d.done:
+		return sseFrame{}, ErrSSEConnClosed
+	case <-reqDone:
+		return sseFrame{}, errs.WrapMsg(d.reqCtx.Err(), "SSE request closed")
+	case <-timeout:
+		return sseFrame{}, errs.New("SSEConn read timeout")
+	case frame := <-d.inbound:
+		return frame, nil
+	}
+}
+
+func (d *SSEConn) getReadDeadline() time.Time {
+	d.r.Lock()
+	defer d.r.Unlock()
+	return d.readDeadline
+}
+
+func (d *SSEConn) getReadLimit() int64 {
+	d.r.Lock()
+	defer d.r.Unlock()
+	return d.readLimit
+}
+
+func (d *SSEConn) SetReadDeadline(timeout time.Duration) error {
+	d.r.Lock()
+	defer d.r.Unlock()
+	d.readDeadline = time.Now().Add(timeout)
+	return nil
+}
+
+func (d *SSEConn) SetWriteDeadline(timeout time.Duration) error {
+	if timeout <= 0 {
+		return errs.New("timeout must be greater than 0")
+	}
+	d.w.Lock()
+	defer d.w.Unlock()
+	d.writeTimeout = timeout
+	return nil
+}
+
+func (d *SSEConn) Dial(urlStr string, requestHeader http.Header) (*http.Response, error) {
+	return nil, errs.New("SSEConn does not support dial", "url", urlStr)
+}
+
+func (d *SSEConn) IsNil() bool {
+	return d.writer == nil
+}
+
+func (d *SSEConn) SetConnNil() {
+	d.w.Lock()
+	defer d.w.Unlock()
+	d.writer = nil
+}
+
+func (d *SSEConn) SetReadLimit(limit int64) {
+	d.r.Lock()
+	defer d.r.Unlock()
+	d.readLimit = limit
+}
+
+func (d *SSEConn) SetPongHandler(handler PingPongHandler) {
+	d.pongHandler = handler
+}
+
+func (d *SSEConn) SetPingHandler(handler PingPongHandler) {
+	d.pingHandler = handler
+}
+
+// sseSendHandler receives the upstream frames of SSE connections. The request carries the
+// connID and token of the connection, the body is one frame: text for JSON encoded frames,
+// binary otherwise. A request with type=ping is a heartbeat.
+func (ws *WsServer) sseSendHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	connContext := newContext(w, r)
+	connID, _ := connContext.Query(ConnID)
+	token, _ := connContext.Query(Token)
+	if token == "" {
+		token, _ = connContext.GetHeader(Token)
+	}
+	value, ok := ws.sseConns.Load(connID)
+	if !ok || subtle.ConstantTimeCompare([]byte(value.(*SSEConn).token), []byte(token)) != 1 {
+		http.Error(w, "sse connection not found", http.StatusGone)
+		return
+	}
+	conn := value.(*SSEConn)
+	if t, _ := connContext.Query("type"); t == "ping" {
+		if err := conn.deliver(PingMessage, nil); err != nil {
+			http.Error(w, err.Error(), http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	limit := conn.getReadLimit()
+	if limit <= 0 {
+		limit = maxMessageSize
+	}
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, limit))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("read frame failed: %v", err), http.StatusRequestEntityTooLarge)
+		return
+	}
+	messageType := MessageBinary
+	if contentType := r.Header.Get("Content-Type"); strings.HasPrefix(contentType, "text/") ||
+		strings.HasPrefix(contentType, "application/json") {
+		messageType = MessageText
+	}
+	if err := conn.deliver(messageType, data); err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,67 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSEConn(t *testing.T) {
+	ws := &WsServer{}
+	conn := newSSEConn("c1", "t1", func() { ws.sseConns.Delete("c1") })
+	ws.sseConns.Store("c1", conn)
+	recorder := httptest.NewRecorder()
+	assert.NoError(t, conn.GenerateLongConn(recorder, httptest.NewRequest(http.MethodGet, "/?transport=sse", nil)))
+	assert.Equal(t, "text/event-stream", recorder.Header().Get("Content-Type"))
+	assert.NoError(t, conn.WriteMessage(MessageBinary, []byte("hi")))
+	assert.NoError(t, conn.WriteMessage(MessageText, []byte("a\nb")))
+	assert.Equal(t, "event: open\ndata: {\"connID\":\"c1\"}\n\n"+
+		"event: binary\ndata: aGk=\n\n"+
+		"event: text\ndata: a\ndata: b\n\n", recorder.Body.String())
+	assert.NoError(t, conn.WriteMessage(PongMessage, nil))
+	assert.Equal(t, int64(recorder.Body.Len()), conn.BytesOut())
+
+	var pings int
+	conn.SetPingHandler(func(string) error { pings++; return nil })
+	_ = conn.SetReadDeadline(time.Second)
+	post := func(query string, contentType string, body string) int {
+		req := httptest.NewRequest(http.MethodPost, sseSendPath+"?"+query, strings.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+		rec := httptest.NewRecorder()
+		ws.sseSendHandler(rec, req)
+		return rec.Code
+	}
+	assert.Equal(t, http.StatusGone, post("connID=c1&token=bad", "application/json", "{}"))
+	assert.Equal(t, http.StatusNoContent, post("connID=c1&token=t1&type=ping", "", ""))
+	assert.Equal(t, http.StatusNoContent, post("connID=c1&token=t1", "application/octet-stream", "frame"))
+	messageType, data, err := conn.ReadMessage()
+	assert.NoError(t, err)
+	assert.Equal(t, MessageBinary, messageType)
+	assert.True(t, bytes.Equal([]byte("frame"), data))
+	assert.Equal(t, 1, pings)
+
+	assert.NoError(t, conn.Close())
+	_, _, err = conn.ReadMessage()
+	assert.ErrorIs(t, err, ErrSSEConnClosed)
+	assert.ErrorIs(t, conn.WriteMessage(MessageText, []byte("x")), ErrSSEConnClosed)
+	assert.Equal(t, http.StatusGone, post("connID=c1&token=t1", "application/json", "{}"))
+}
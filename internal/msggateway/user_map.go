@@ -130,6 +130,13 @@ func (u *UserMap) deleteClients(key string, clients []*Client) (isDeleteUser boo
 	return false
 }
 
+// Range calls f for each user and its clients until f returns false.
+func (u *UserMap) Range(f func(userID string, clients []*Client) bool) {
+	u.m.Range(func(key, value any) bool {
+		return f(key.(string), value.([]*Client))
+	})
+}
+
 func (u *UserMap) DeleteAll(key string) {
 	u.m.Delete(key)
 }
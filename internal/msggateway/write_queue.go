@@ -0,0 +1,125 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"sync"
+
+	"github.com/Meikwei/aetim/pkg/common/prommetrics"
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/Meikwei/go-tools/log"
+)
+
+// Overflow policies of the write queue.
+const (
+	WriteQueueDrop       = "drop"
+	WriteQueueResync     = "resync"
+	WriteQueueDisconnect = "disconnect"
+)
+
+const defaultWriteQueueSize = 256
+
+var ErrWriteQueueFull = errs.New("write queue is full")
+
+// writeQueue buffers the pushes of a connection, so that a slow client never blocks the
+// push RPC. It is drained by the writeLoop of the client.
+type writeQueue struct {
+	mu     sync.Mutex
+	frames chan *Resp
+	done   chan struct{}
+	closed bool
+	policy string
+}
+
+func newWriteQueue(size int, policy string) *writeQueue {
+	if size <= 0 {
+		size = defaultWriteQueueSize
+	}
+	switch policy {
+	case WriteQueueDrop, WriteQueueResync, WriteQueueDisconnect:
+	default:
+		policy = WriteQueueResync
+	}
+	return &writeQueue{
+		frames: make(chan *Resp, size),
+		done:   make(chan struct{}),
+		policy: policy,
+	}
+}
+
+func (q *writeQueue) close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if !q.closed {
+		q.closed = true
+		close(q.done)
+	}
+}
+
+// enqueue adds a frame to the write queue, applying the overflow policy when it is full.
+func (c *Client) enqueue(resp *Resp) error {
+	q := c.queue
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return nil
+	}
+	select {
+	case q.frames <- resp:
+		prommetrics.MsgGatewayWriteQueueGauge.Inc()
+		return nil
+	default:
+	}
+	prommetrics.MsgGatewayWriteQueueOverflowCounter.WithLabelValues(q.policy).Inc()
+	log.ZWarn(c.ctx, "write queue is full", nil, "policy", q.policy, "size", cap(q.frames))
+	switch q.policy {
+	case WriteQueueResync:
+		// Replace the queued pushes with a single frame telling the client to resync
+	drain:
+		for {
+			select {
+			case <-q.frames:
+				prommetrics.MsgGatewayWriteQueueGauge.Dec()
+			default:
+				break drain
+			}
+		}
+		q.frames <- &Resp{ReqIdentifier: WSResyncMsg}
+		prommetrics.MsgGatewayWriteQueueGauge.Inc()
+	case WriteQueueDisconnect:
+		prommetrics.MsgGatewaySlowConsumerEvictedCounter.Inc()
+		c.setClosedErr(ErrWriteQueueFull)
+		go c.close()
+	}
+	return ErrWriteQueueFull
+}
+
+// writeLoop writes the queued frames until the connection is closed.
+func (c *Client) writeLoop(q *writeQueue) {
+	defer func() {
+		prommetrics.MsgGatewayWriteQueueGauge.Sub(float64(len(q.frames)))
+	}()
+	for {
+		select {
+		case <-q.done:
+			return
+		case resp := <-q.frames:
+			prommetrics.MsgGatewayWriteQueueGauge.Dec()
+			if err := c.writeBinaryMsg(*resp); err != nil {
+				log.ZWarn(c.ctx, "write queued frame failed", err, "reqIdentifier", resp.ReqIdentifier)
+			}
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteQueueOverflow(t *testing.T) {
+	client := &Client{queue: newWriteQueue(2, WriteQueueDrop)}
+	assert.NoError(t, client.enqueue(&Resp{ReqIdentifier: WSPushMsg, MsgIncr: "1"}))
+	assert.NoError(t, client.enqueue(&Resp{ReqIdentifier: WSPushMsg, MsgIncr: "2"}))
+	assert.ErrorIs(t, client.enqueue(&Resp{ReqIdentifier: WSPushMsg, MsgIncr: "3"}), ErrWriteQueueFull)
+	assert.Len(t, client.queue.frames, 2)
+	assert.Equal(t, "1", (<-client.queue.frames).MsgIncr)
+
+	client = &Client{queue: newWriteQueue(2, WriteQueueResync)}
+	assert.NoError(t, client.enqueue(&Resp{ReqIdentifier: WSPushMsg, MsgIncr: "1"}))
+	assert.NoError(t, client.enqueue(&Resp{ReqIdentifier: WSPushMsg, MsgIncr: "2"}))
+	assert.ErrorIs(t, client.enqueue(&Resp{ReqIdentifier: WSPushMsg, MsgIncr: "3"}), ErrWriteQueueFull)
+	// the queued pushes are coalesced into a resync frame, later pushes follow it
+	assert.NoError(t, client.enqueue(&Resp{ReqIdentifier: WSPushMsg, MsgIncr: "4"}))
+	assert.Len(t, client.queue.frames, 2)
+	assert.Equal(t, int32(WSResyncMsg), (<-client.queue.frames).ReqIdentifier)
+	assert.Equal(t, "4", (<-client.queue.frames).MsgIncr)
+
+	client.queue.close()
+	assert.NoError(t, client.enqueue(&Resp{ReqIdentifier: WSPushMsg}))
+	assert.Empty(t, client.queue.frames)
+}
+
+func TestClientClosedErrFirstWins(t *testing.T) {
+	client := &Client{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		client.setClosedErr(ErrWriteQueueFull)
+	}()
+	<-done
+	client.setClosedErr(ErrConnClosed)
+	assert.ErrorIs(t, client.getClosedErr(), ErrWriteQueueFull)
+}
@@ -83,9 +83,13 @@ func Start(ctx context.Context, index int, config *Config) error {
 	}
 	client.AddOption(mw.GrpcClient(), grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"LoadBalancingPolicy": "%s"}`, "round_robin")))
+	seqDB, err := mgo.NewSeqMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
 	//todo MsgCacheTimeout
 	msgModel := cache.NewMsgCache(rdb, config.RedisConfig.EnablePipeline)
-	seqModel := cache.NewSeqCache(rdb)
+	seqModel := cache.NewSeqCache(rdb, seqDB, config.RedisConfig.SeqReplicas)
 	msgDocModel, err := mgo.NewMsgMongo(mgocli.GetDB())
 	if err != nil {
 		return err
@@ -94,22 +98,35 @@ func Start(ctx context.Context, index int, config *Config) error {
 	if err != nil {
 		return err
 	}
+	msgSearchModel, err := mgo.NewMsgSearchIndexMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	msgSearchDatabase := controller.NewMsgSearchDatabase(msgSearchModel)
+	msgDestructModel, err := mgo.NewMsgDestructMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	msgDestructDatabase := controller.NewMsgDestructDatabase(msgDestructModel)
 	conversationRpcClient := rpcclient.NewConversationRpcClient(client, config.Share.RpcRegisterName.Conversation)
 	groupRpcClient := rpcclient.NewGroupRpcClient(client, config.Share.RpcRegisterName.Group)
-	msgTransfer, err := NewMsgTransfer(&config.KafkaConfig, msgDatabase, &conversationRpcClient, &groupRpcClient)
+	unreadCountDatabase := controller.NewUnreadCountDatabase(cache.NewUnreadCountCache(rdb))
+	msgTransfer, err := NewMsgTransfer(&config.KafkaConfig, msgDatabase, msgSearchDatabase, msgDestructDatabase, unreadCountDatabase,
+		cache.NewSendDedupCache(rdb), &conversationRpcClient, &groupRpcClient)
 	if err != nil {
 		return err
 	}
 	return msgTransfer.Start(index, config)
 }
 
-func NewMsgTransfer(kafkaConf *config.Kafka, msgDatabase controller.CommonMsgDatabase,
+func NewMsgTransfer(kafkaConf *config.Kafka, msgDatabase controller.CommonMsgDatabase, msgSearchDatabase controller.MsgSearchDatabase,
+	msgDestructDatabase controller.MsgDestructDatabase, unreadCountDatabase controller.UnreadCountDatabase, sendDedup cache.SendDedupCache,
 	conversationRpcClient *rpcclient.ConversationRpcClient, groupRpcClient *rpcclient.GroupRpcClient) (*MsgTransfer, error) {
-	historyCH, err := NewOnlineHistoryRedisConsumerHandler(kafkaConf, msgDatabase, conversationRpcClient, groupRpcClient)
+	historyCH, err := NewOnlineHistoryRedisConsumerHandler(kafkaConf, msgDatabase, unreadCountDatabase, conversationRpcClient, groupRpcClient)
 	if err != nil {
 		return nil, err
 	}
-	historyMongoCH, err := NewOnlineHistoryMongoConsumerHandler(kafkaConf, msgDatabase)
+	historyMongoCH, err := NewOnlineHistoryMongoConsumerHandler(kafkaConf, msgDatabase, msgSearchDatabase, msgDestructDatabase, sendDedup)
 	if err != nil {
 		return nil, err
 	}
@@ -44,6 +44,10 @@ const (
 	SourceMessages = 4
 	MongoMessages  = 5
 	ChannelNum     = 100
+
+	// unreadWorkerNum goroutines add group messages to the unread counts of the members, off the push path.
+	unreadWorkerNum   = 10
+	unreadChannelSize = 1000
 )
 
 type MsgChannelValue struct {
@@ -66,10 +70,19 @@ type ContextMsg struct {
 	ctx     context.Context
 }
 
+// unreadMsgs are stored messages to add to the unread counts of userIDs.
+type unreadMsgs struct {
+	ctx            context.Context
+	conversationID string
+	userIDs        []string
+	msgs           []*sdkws.MsgData
+}
+
 type OnlineHistoryRedisConsumerHandler struct {
 	historyConsumerGroup *kafka.MConsumerGroup
 	chArrays             [ChannelNum]chan Cmd2Value
 	msgDistributionCh    chan Cmd2Value
+	unreadCh             chan unreadMsgs
 
 	// singleMsgSuccessCount      uint64
 	// singleMsgFailedCount       uint64
@@ -77,11 +90,12 @@ type OnlineHistoryRedisConsumerHandler struct {
 	// singleMsgFailedCountMutex  sync.Mutex
 
 	msgDatabase           controller.CommonMsgDatabase
+	unreadCountDatabase   controller.UnreadCountDatabase
 	conversationRpcClient *rpcclient.ConversationRpcClient
 	groupRpcClient        *rpcclient.GroupRpcClient
 }
 
-func NewOnlineHistoryRedisConsumerHandler(kafkaConf *config.Kafka, database controller.CommonMsgDatabase,
+func NewOnlineHistoryRedisConsumerHandler(kafkaConf *config.Kafka, database controller.CommonMsgDatabase, unreadCountDatabase controller.UnreadCountDatabase,
 	conversationRpcClient *rpcclient.ConversationRpcClient, groupRpcClient *rpcclient.GroupRpcClient) (*OnlineHistoryRedisConsumerHandler, error) {
 	historyConsumerGroup, err := kafka.NewMConsumerGroup(kafkaConf.Build(), kafkaConf.ToRedisGroupID, []string{kafkaConf.ToRedisTopic},true)
 	if err != nil {
@@ -89,12 +103,17 @@ func NewOnlineHistoryRedisConsumerHandler(kafkaConf *config.Kafka, database cont
 	}
 	var och OnlineHistoryRedisConsumerHandler
 	och.msgDatabase = database
+	och.unreadCountDatabase = unreadCountDatabase
 	och.msgDistributionCh = make(chan Cmd2Value) // no buffer channel
 	go och.MessagesDistributionHandle()
 	for i := 0; i < ChannelNum; i++ {
 		och.chArrays[i] = make(chan Cmd2Value, 50)
 		go och.Run(i)
 	}
+	och.unreadCh = make(chan unreadMsgs, unreadChannelSize)
+	for i := 0; i < unreadWorkerNum; i++ {
+		go och.runUnread()
+	}
 	och.conversationRpcClient = conversationRpcClient
 	och.groupRpcClient = groupRpcClient
 	och.historyConsumerGroup = historyConsumerGroup
@@ -255,18 +274,22 @@ func (och *OnlineHistoryRedisConsumerHandler) handleMsg(ctx context.Context, key
 			log.ZError(ctx, "batch data insert to redis err", err, "storageMsgList", storageList)
 			return
 		}
+		// the members are fetched once per batch, for the new conversation and the unread counts
+		var memberIDs []string
+		if storageList[0].SessionType == constant.ReadGroupChatType {
+			memberIDs, err = och.groupRpcClient.GetGroupMemberIDs(ctx, storageList[0].GroupID)
+			if err != nil {
+				log.ZWarn(ctx, "get group member ids error", err, "conversationID", conversationID)
+			}
+		}
 		if isNewConversation {
 			switch storageList[0].SessionType {
 			case constant.ReadGroupChatType:
 				log.ZInfo(ctx, "group chat first create conversation", "conversationID",
 					conversationID)
-				userIDs, err := och.groupRpcClient.GetGroupMemberIDs(ctx, storageList[0].GroupID)
-				if err != nil {
-					log.ZWarn(ctx, "get group member ids error", err, "conversationID",
-						conversationID)
-				} else {
+				if memberIDs != nil {
 					if err := och.conversationRpcClient.GroupChatFirstCreateConversation(ctx,
-						storageList[0].GroupID, userIDs); err != nil {
+						storageList[0].GroupID, memberIDs); err != nil {
 						log.ZWarn(ctx, "single chat first create conversation error", err,
 							"conversationID", conversationID)
 					}
@@ -288,10 +311,39 @@ func (och *OnlineHistoryRedisConsumerHandler) handleMsg(ctx context.Context, key
 		if err != nil {
 			log.ZError(ctx, "MsgToMongoMQ error", err)
 		}
+		och.addUnreadMsgs(ctx, conversationID, memberIDs, storageList)
 		och.toPushTopic(ctx, key, conversationID, storageList)
 	}
 }
 
+// addUnreadMsgs adds the stored messages to the unread counts of the conversation members. The two users of a
+// single chat are updated before the push, so that offline pushes carry the badge including these messages.
+// Group members are updated by the unread workers, a large group would otherwise delay the push; their offline
+// pushes may carry a badge without the latest messages.
+func (och *OnlineHistoryRedisConsumerHandler) addUnreadMsgs(ctx context.Context, conversationID string, memberIDs []string, storageList []*sdkws.MsgData) {
+	switch storageList[0].SessionType {
+	case constant.ReadGroupChatType:
+		if len(memberIDs) == 0 {
+			return
+		}
+		// blocks only when the workers fall behind, the counts are not dropped
+		och.unreadCh <- unreadMsgs{ctx: ctx, conversationID: conversationID, userIDs: memberIDs, msgs: storageList}
+	case constant.SingleChatType, constant.NotificationChatType:
+		userIDs := []string{storageList[0].SendID, storageList[0].RecvID}
+		if err := och.unreadCountDatabase.AddUnreadMsgs(ctx, conversationID, userIDs, storageList); err != nil {
+			log.ZWarn(ctx, "add unread msgs error", err, "conversationID", conversationID)
+		}
+	}
+}
+
+func (och *OnlineHistoryRedisConsumerHandler) runUnread() {
+	for unread := range och.unreadCh {
+		if err := och.unreadCountDatabase.AddUnreadMsgs(unread.ctx, unread.conversationID, unread.userIDs, unread.msgs); err != nil {
+			log.ZWarn(unread.ctx, "add unread msgs error", err, "conversationID", unread.conversationID)
+		}
+	}
+}
+
 func (och *OnlineHistoryRedisConsumerHandler) MessagesDistributionHandle() {
 	for {
 		aggregationMsgs := make(map[string][]*ContextMsg, ChannelNum)
@@ -19,6 +19,7 @@ import (
 
 	"github.com/IBM/sarama"
 	"github.com/Meikwei/aetim/pkg/common/config"
+	"github.com/Meikwei/aetim/pkg/common/db/cache"
 	"github.com/Meikwei/aetim/pkg/common/db/controller"
 	"github.com/Meikwei/aetim/pkg/common/prommetrics"
 	"github.com/Meikwei/go-tools/log"
@@ -30,9 +31,13 @@ import (
 type OnlineHistoryMongoConsumerHandler struct {
 	historyConsumerGroup *kafka.MConsumerGroup
 	msgDatabase          controller.CommonMsgDatabase
+	msgSearchDatabase    controller.MsgSearchDatabase
+	msgDestructDatabase  controller.MsgDestructDatabase
+	sendDedup            cache.SendDedupCache
 }
 
-func NewOnlineHistoryMongoConsumerHandler(kafkaConf *config.Kafka, database controller.CommonMsgDatabase) (*OnlineHistoryMongoConsumerHandler, error) {
+func NewOnlineHistoryMongoConsumerHandler(kafkaConf *config.Kafka, database controller.CommonMsgDatabase, searchDatabase controller.MsgSearchDatabase,
+	destructDatabase controller.MsgDestructDatabase, sendDedup cache.SendDedupCache) (*OnlineHistoryMongoConsumerHandler, error) {
 	historyConsumerGroup, err := kafka.NewMConsumerGroup(kafkaConf.Build(), kafkaConf.ToMongoGroupID, []string{kafkaConf.ToMongoTopic},true)
 	if err != nil {
 		return nil, err
@@ -41,6 +46,9 @@ func NewOnlineHistoryMongoConsumerHandler(kafkaConf *config.Kafka, database cont
 	mc := &OnlineHistoryMongoConsumerHandler{
 		historyConsumerGroup: historyConsumerGroup,
 		msgDatabase:          database,
+		msgSearchDatabase:    searchDatabase,
+		msgDestructDatabase:  destructDatabase,
+		sendDedup:            sendDedup,
 	}
 	return mc, nil
 }
@@ -72,6 +80,18 @@ func (mc *OnlineHistoryMongoConsumerHandler) handleChatWs2Mongo(ctx context.Cont
 		prommetrics.MsgInsertMongoFailedCounter.Inc()
 	} else {
 		prommetrics.MsgInsertMongoSuccessCounter.Inc()
+		// index writes are upserts, so a redelivered batch is indexed again harmlessly
+		if err := mc.msgSearchDatabase.IndexMsgs(ctx, msgFromMQ.ConversationID, msgFromMQ.MsgData); err != nil {
+			log.ZError(ctx, "index msgs for search err", err, "conversationID", msgFromMQ.ConversationID)
+		}
+		// self-destruct timers start once the messages are in mongo, so they never expire before being stored
+		if err := mc.msgDestructDatabase.CreateMsgDestructs(ctx, msgFromMQ.ConversationID, msgFromMQ.MsgData); err != nil {
+			log.ZError(ctx, "create msg destructs err", err, "conversationID", msgFromMQ.ConversationID)
+		}
+		// duplicate sends of these messages answer with their seqs from now on
+		if err := mc.sendDedup.SetSentMsgSeqs(ctx, msgFromMQ.MsgData); err != nil {
+			log.ZWarn(ctx, "set sent msg seqs err", err, "conversationID", msgFromMQ.ConversationID)
+		}
 	}
 	var seqs []int64
 	for _, msg := range msgFromMQ.MsgData {
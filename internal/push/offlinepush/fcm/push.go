@@ -93,7 +93,9 @@ func (f *Fcm) Push(ctx context.Context, userIDs []string, title, content string,
 			}
 			messages = messages[0:0]
 		}
-		if opts.IOSBadgeCount {
+		if badge, ok := opts.Badges[userID]; ok {
+			apns.Payload.Aps.Badge = &badge
+		} else if opts.IOSBadgeCount {
 			unreadCountSum, err := f.cache.IncrUserBadgeUnreadCountSum(ctx, userID)
 			if err == nil {
 				apns.Payload.Aps.Badge = &unreadCountSum
@@ -16,6 +16,7 @@ package getui
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/Meikwei/aetim/pkg/common/config"
 )
@@ -149,6 +150,12 @@ func newBatchPushReq(userIDs []string, taskID string) PushReq {
 	return PushReq{Audience: &Audience{Alias: userIDs}, IsAsync: &IsAsync, TaskID: &taskID}
 }
 
+// setBadge sets the absolute iOS badge, setPushChannel must be called first.
+func (pushReq *PushReq) setBadge(badge int) {
+	autoBadge := strconv.Itoa(badge)
+	pushReq.PushChannel.Ios.AutoBadge = &autoBadge
+}
+
 func (pushReq *PushReq) setPushChannel(title string, body string) {
 	pushReq.PushChannel = &PushChannel{}
 	// autoBadge := "+1"
@@ -102,6 +102,10 @@ func (g *Client) Push(ctx context.Context, userIDs []string, title, content stri
 			err = g.batchPush(ctx, token, userIDs, pushReq)
 		}
 	} else if len(userIDs) == 1 {
+		// a batch shares one payload, only a single push can carry the badge of its user
+		if badge, ok := opts.Badges[userIDs[0]]; ok {
+			pushReq.setBadge(badge)
+		}
 		err = g.singlePush(ctx, token, userIDs[0], pushReq)
 	} else {
 		return ErrUserIDEmpty
@@ -15,6 +15,8 @@
 package body
 
 import (
+	"strconv"
+
 	"github.com/Meikwei/aetim/pkg/common/config"
 )
 
@@ -51,6 +53,11 @@ func (n *Notification) SetAlert(alert string) {
 	n.IOS.Badge = "+1"
 }
 
+// SetBadge sets the absolute iOS badge instead of incrementing it, must be called after SetAlert.
+func (n *Notification) SetBadge(badge int) {
+	n.IOS.Badge = strconv.Itoa(badge)
+}
+
 func (n *Notification) SetExtras(extras Extras) {
 	n.IOS.Extras = extras
 	n.Android.Extras = extras
@@ -65,6 +65,12 @@ func (j *JPush) Push(ctx context.Context, userIDs []string, title, content strin
 	no.IOSEnableMutableContent()
 	no.SetExtras(extras)
 	no.SetAlert(title)
+	if len(userIDs) == 1 {
+		// all the aliases share one notification, only a push to a single user can carry its badge
+		if badge, ok := opts.Badges[userIDs[0]]; ok {
+			no.SetBadge(badge)
+		}
+	}
 	no.SetAndroidIntent(j.pushConf)
 
 	var msg body.Message
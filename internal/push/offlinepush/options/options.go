@@ -6,6 +6,8 @@ type Opts struct {
 	IOSPushSound  string
 	IOSBadgeCount bool
 	Ex            string
+	// Badges is the unread total of each user kept by the msg service, set as the absolute iOS badge
+	Badges map[string]int
 }
 
 // Signal message id.
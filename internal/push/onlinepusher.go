@@ -4,6 +4,7 @@ import (
 	"context"
 	"sync"
 
+	"github.com/Meikwei/aetim/pkg/common/db/cache"
 	"github.com/Meikwei/go-tools/discovery"
 	"github.com/Meikwei/go-tools/log"
 	"github.com/Meikwei/go-tools/utils/datautil"
@@ -18,6 +19,11 @@ const (
 	ZOOKEEPER  = "zookeeper"
 )
 
+const (
+	GatewayRoutingRegistry = "registry"
+	GatewayRoutingAll      = "all"
+)
+
 type OnlinePusher interface {
 	GetConnsAndOnlinePush(ctx context.Context, msg *sdkws.MsgData,
 		pushToUserIDs []string) (wsResults []*msggateway.SingleMsgToUserResults, err error)
@@ -42,12 +48,15 @@ func (u emptyOnlinePUsher) GetOnlinePushFailedUserIDs(ctx context.Context, msg *
 	return nil
 }
 
-func NewOnlinePusher(disCov discovery.SvcDiscoveryRegistry, config *Config) OnlinePusher {
+func NewOnlinePusher(disCov discovery.SvcDiscoveryRegistry, config *Config, connRouteCache cache.ConnRouteCache) OnlinePusher {
 	switch config.Share.Env {
 	case KUBERNETES:
 		return NewK8sStaticConsistentHash(disCov, config)
 	case ZOOKEEPER:
-		return NewDefaultAllNode(disCov, config)
+		if config.RpcConfig.GatewayRouting == GatewayRoutingAll {
+			return NewDefaultAllNode(disCov, config)
+		}
+		return NewRegistryOnlinePusher(disCov, config, connRouteCache)
 	default:
 		return newEmptyOnlinePUsher()
 	}
@@ -129,6 +138,116 @@ func (d *DefaultAllNode) GetOnlinePushFailedUserIDs(_ context.Context, msg *sdkw
 	return datautil.SliceSub(*pushToUserIDs, onlineSuccessUserIDs)
 }
 
+// RegistryOnlinePusher sends a push only to the gateway nodes holding connections of the
+// recipients, as found in the connection route registry. It broadcasts to all nodes when
+// the registry is unavailable.
+type RegistryOnlinePusher struct {
+	*DefaultAllNode
+	connRouteCache cache.ConnRouteCache
+}
+
+func NewRegistryOnlinePusher(disCov discovery.SvcDiscoveryRegistry, config *Config, connRouteCache cache.ConnRouteCache) *RegistryOnlinePusher {
+	return &RegistryOnlinePusher{DefaultAllNode: NewDefaultAllNode(disCov, config), connRouteCache: connRouteCache}
+}
+
+func (r *RegistryOnlinePusher) GetConnsAndOnlinePush(ctx context.Context, msg *sdkws.MsgData,
+	pushToUserIDs []string) (wsResults []*msggateway.SingleMsgToUserResults, err error) {
+	nodeUsers, err := r.getNodeUsers(ctx, pushToUserIDs)
+	if err != nil {
+		log.ZWarn(ctx, "get conn routes failed, push to all nodes", err)
+		return r.DefaultAllNode.GetConnsAndOnlinePush(ctx, msg, pushToUserIDs)
+	}
+	if len(nodeUsers) == 0 {
+		log.ZDebug(ctx, "push users not online", "userIDs", pushToUserIDs)
+		return nil, nil
+	}
+	conns, err := r.disCov.GetConns(ctx, r.config.Share.RpcRegisterName.MessageGateway)
+	if err != nil {
+		return nil, err
+	}
+	var usersConns = make(map[*grpc.ClientConn][]string)
+	for _, conn := range conns {
+		if userIDs, ok := nodeUsers[conn.Target()]; ok {
+			usersConns[conn] = userIDs
+		}
+	}
+	log.ZDebug(ctx, "push to routed nodes", "nodeUsers", nodeUsers, "conn length", len(usersConns))
+
+	var (
+		mu         sync.Mutex
+		wg         = errgroup.Group{}
+		maxWorkers = r.config.RpcConfig.MaxConcurrentWorkers
+	)
+	if maxWorkers < 3 {
+		maxWorkers = 3
+	}
+	wg.SetLimit(maxWorkers)
+	for conn, userIDs := range usersConns {
+		conn, userIDs := conn, userIDs
+		wg.Go(func() error {
+			input := &msggateway.OnlineBatchPushOneMsgReq{MsgData: msg, PushToUserIDs: userIDs}
+			msgClient := msggateway.NewMsgGatewayClient(conn)
+			reply, err := msgClient.SuperGroupOnlineBatchPushOneMsg(ctx, input)
+			if err != nil {
+				log.ZWarn(ctx, "push to node failed", err, "node", conn.Target())
+				return nil
+			}
+			log.ZDebug(ctx, "push result", "reply", reply)
+			if reply != nil && reply.SinglePushResult != nil {
+				mu.Lock()
+				wsResults = append(wsResults, reply.SinglePushResult...)
+				mu.Unlock()
+			}
+			return nil
+		})
+	}
+	_ = wg.Wait()
+	return wsResults, nil
+}
+
+// getNodeUsers groups the users by the gateway nodes holding their connections. Routes of
+// crashed nodes are removed on the way.
+func (r *RegistryOnlinePusher) getNodeUsers(ctx context.Context, userIDs []string) (map[string][]string, error) {
+	routes, err := r.connRouteCache.GetUsersConnRoutes(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	var nodes []string
+	for _, userRoutes := range routes {
+		for _, route := range userRoutes {
+			nodes = append(nodes, route.Node)
+		}
+	}
+	alive, err := r.connRouteCache.GetAliveNodes(ctx, datautil.Distinct(nodes))
+	if err != nil {
+		return nil, err
+	}
+	var (
+		nodeUsers = make(map[string][]string)
+		stale     = make(map[string][]string)
+	)
+	for userID, userRoutes := range routes {
+		userNodes := make(map[string]struct{})
+		for _, route := range userRoutes {
+			if !alive[route.Node] {
+				stale[userID] = append(stale[userID], route.ConnID)
+				continue
+			}
+			if _, ok := userNodes[route.Node]; !ok {
+				userNodes[route.Node] = struct{}{}
+				nodeUsers[route.Node] = append(nodeUsers[route.Node], userID)
+			}
+		}
+	}
+	if len(stale) > 0 {
+		log.ZInfo(ctx, "remove stale conn routes", "routes", stale)
+		if err := r.connRouteCache.DelConnRoutes(ctx, stale); err != nil {
+			log.ZWarn(ctx, "remove stale conn routes failed", err)
+		}
+	}
+	return nodeUsers, nil
+}
+
 type K8sStaticConsistentHash struct {
 	disCov discovery.SvcDiscoveryRegistry
 	config *Config
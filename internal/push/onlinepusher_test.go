@@ -0,0 +1,74 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/Meikwei/aetim/pkg/common/db/cache"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockConnRouteCache struct {
+	cache.ConnRouteCache
+	routes  map[string][]*cache.ConnRoute
+	alive   map[string]bool
+	deleted map[string][]string
+}
+
+func (m *mockConnRouteCache) GetUsersConnRoutes(_ context.Context, userIDs []string) (map[string][]*cache.ConnRoute, error) {
+	routes := make(map[string][]*cache.ConnRoute)
+	for _, userID := range userIDs {
+		if userRoutes, ok := m.routes[userID]; ok {
+			routes[userID] = userRoutes
+		}
+	}
+	return routes, nil
+}
+
+func (m *mockConnRouteCache) GetAliveNodes(_ context.Context, nodes []string) (map[string]bool, error) {
+	alive := make(map[string]bool)
+	for _, node := range nodes {
+		alive[node] = m.alive[node]
+	}
+	return alive, nil
+}
+
+func (m *mockConnRouteCache) DelConnRoutes(_ context.Context, userConnIDs map[string][]string) error {
+	m.deleted = userConnIDs
+	return nil
+}
+
+func TestRegistryOnlinePusherGetNodeUsers(t *testing.T) {
+	mock := &mockConnRouteCache{
+		routes: map[string][]*cache.ConnRoute{
+			"u1": {{Node: "n1", PlatformID: 1, ConnID: "c1"}, {Node: "n1", PlatformID: 5, ConnID: "c2"}},
+			"u2": {{Node: "n2", PlatformID: 1, ConnID: "c3"}, {Node: "n3", PlatformID: 2, ConnID: "c4"}},
+		},
+		alive: map[string]bool{"n1": true, "n2": true},
+	}
+	r := NewRegistryOnlinePusher(nil, &Config{}, mock)
+
+	nodeUsers, err := r.getNodeUsers(context.Background(), []string{"u1", "u2", "u3"})
+	assert.NoError(t, err)
+	for _, userIDs := range nodeUsers {
+		sort.Strings(userIDs)
+	}
+	assert.Equal(t, map[string][]string{"n1": {"u1"}, "n2": {"u2"}}, nodeUsers)
+	// n3 crashed, its route is removed
+	assert.Equal(t, map[string][]string{"u2": {"c4"}}, mock.deleted)
+}
@@ -20,9 +20,11 @@ import (
 
 	"github.com/Meikwei/aetim/internal/push/offlinepush"
 	"github.com/Meikwei/aetim/internal/push/offlinepush/options"
+	"github.com/Meikwei/aetim/pkg/common/db/cache"
 	"github.com/Meikwei/aetim/pkg/common/prommetrics"
 	"github.com/Meikwei/aetim/pkg/common/webhook"
 	"github.com/Meikwei/aetim/pkg/msgprocessor"
+	"github.com/Meikwei/aetim/pkg/protocol/msgext"
 	"github.com/Meikwei/aetim/pkg/rpccache"
 	"github.com/Meikwei/aetim/pkg/rpcclient"
 	"github.com/Meikwei/aetim/pkg/util/conversationutil"
@@ -66,7 +68,7 @@ func NewConsumerHandler(config *Config, offlinePusher offlinepush.OfflinePusher,
 		return nil, err
 	}
 	consumerHandler.offlinePusher = offlinePusher
-	consumerHandler.onlinePusher = NewOnlinePusher(client, config)
+	consumerHandler.onlinePusher = NewOnlinePusher(client, config, cache.NewConnRouteCache(rdb))
 	consumerHandler.groupRpcClient = rpcclient.NewGroupRpcClient(client, config.Share.RpcRegisterName.Group)
 	consumerHandler.groupLocalCache = rpccache.NewGroupLocalCache(consumerHandler.groupRpcClient, &config.LocalCacheConfig, rdb)
 	consumerHandler.msgRpcClient = rpcclient.NewMessageRpcClient(client, config.Share.RpcRegisterName.Msg)
@@ -285,6 +287,9 @@ func (c *ConsumerHandler) offlinePushMsg(ctx context.Context, msg *sdkws.MsgData
 	if err != nil {
 		return err
 	}
+	if c.config.RpcConfig.IOSPush.BadgeCount {
+		opts.Badges = c.getBadges(ctx, offlinePushUserIDs)
+	}
 	err = c.offlinePusher.Push(ctx, offlinePushUserIDs, title, content, opts)
 	if err != nil {
 		prommetrics.MsgOfflinePushFailedCounter.Inc()
@@ -293,6 +298,21 @@ func (c *ConsumerHandler) offlinePushMsg(ctx context.Context, msg *sdkws.MsgData
 	return nil
 }
 
+// getBadges returns the unread totals of the users kept by the msg service. For users left out, whose counter
+// isn't built yet, and when the totals can't be read, the pushers fall back to their own badge handling.
+func (c *ConsumerHandler) getBadges(ctx context.Context, userIDs []string) map[string]int {
+	resp, err := c.msgRpcClient.ExtClient.GetUsersUnreadCount(ctx, &msgext.GetUsersUnreadCountReq{UserIDs: userIDs})
+	if err != nil {
+		log.ZWarn(ctx, "get users unread count failed", err, "userIDs", userIDs)
+		return nil
+	}
+	badges := make(map[string]int, len(resp.Totals))
+	for userID, total := range resp.Totals {
+		badges[userID] = int(total)
+	}
+	return badges
+}
+
 func (c *ConsumerHandler) filterGroupMessageOfflinePush(ctx context.Context, groupID string, msg *sdkws.MsgData,
 	offlinePushUserIDs []string) (userIDs []string, err error) {
 
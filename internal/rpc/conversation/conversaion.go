@@ -44,6 +44,7 @@ type conversationServer struct {
 	user                           *rpcclient.UserRpcClient
 	groupRpcClient                 *rpcclient.GroupRpcClient
 	conversationDatabase           controller.ConversationDatabase
+	unreadCountDatabase            controller.UnreadCountDatabase
 	conversationNotificationSender *ConversationNotificationSender
 	config                         *Config
 }
@@ -86,6 +87,7 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 		conversationNotificationSender: NewConversationNotificationSender(&config.NotificationConfig, &msgRpcClient),
 		groupRpcClient:                 &groupRpcClient,
 		conversationDatabase:           controller.NewConversationDatabase(conversationDB, cache.NewConversationRedis(rdb, &config.LocalCacheConfig, cache.GetDefaultOpt(), conversationDB), mgocli.GetTx()),
+		unreadCountDatabase:            controller.NewUnreadCountDatabase(cache.NewUnreadCountCache(rdb)),
 	})
 	return nil
 }
@@ -206,6 +208,7 @@ func (c *conversationServer) SetConversation(ctx context.Context, req *pbconvers
 	if err != nil {
 		return nil, err
 	}
+	c.delUnreadCounts(ctx, []string{req.Conversation.OwnerUserID})
 	c.conversationNotificationSender.ConversationChangeNotification(ctx, req.Conversation.OwnerUserID, []string{req.Conversation.ConversationID})
 	resp := &pbconversation.SetConversationResp{}
 	return resp, nil
@@ -313,6 +316,9 @@ func (c *conversationServer) SetConversations(ctx context.Context, req *pbconver
 	if err := c.conversationDatabase.SetUsersConversationFieldTx(ctx, req.UserIDs, &conversation, m); err != nil {
 		return nil, err
 	}
+	if req.Conversation.RecvMsgOpt != nil {
+		c.delUnreadCounts(ctx, req.UserIDs)
+	}
 
 	if unequal > 0 {
 		for _, v := range req.UserIDs {
@@ -323,6 +329,14 @@ func (c *conversationServer) SetConversations(ctx context.Context, req *pbconver
 	return &pbconversation.SetConversationsResp{}, nil
 }
 
+// delUnreadCounts drops the unread counters of the users after the receive option of a conversation may have changed,
+// the msg service rebuilds them with the conversations counted in the total.
+func (c *conversationServer) delUnreadCounts(ctx context.Context, userIDs []string) {
+	if err := c.unreadCountDatabase.DelUnreadCounts(ctx, userIDs); err != nil {
+		log.ZWarn(ctx, "del unread counts failed", err, "userIDs", userIDs)
+	}
+}
+
 // Get user IDs with "Do Not Disturb" enabled in super large groups.
 func (c *conversationServer) GetRecvMsgNotNotifyUserIDs(ctx context.Context, req *pbconversation.GetRecvMsgNotNotifyUserIDsReq) (*pbconversation.GetRecvMsgNotNotifyUserIDsResp, error) {
 	return nil, errs.New("deprecated")
@@ -80,9 +80,15 @@ func (m *msgServer) SetConversationHasReadSeq(ctx context.Context, req *msg.SetC
 	if req.HasReadSeq > maxSeq {
 		return nil, errs.ErrArgs.WrapMsg("hasReadSeq must not be bigger than maxSeq")
 	}
+	currentHasReadSeq, err := m.MsgDatabase.GetHasReadSeq(ctx, req.UserID, req.ConversationID)
+	if err != nil && errs.Unwrap(err) != redis.Nil {
+		return nil, err
+	}
 	if err := m.MsgDatabase.SetHasReadSeq(ctx, req.UserID, req.ConversationID, req.HasReadSeq); err != nil {
 		return nil, err
 	}
+	m.startReadDestruct(ctx, req.ConversationID, req.UserID, currentHasReadSeq, req.HasReadSeq, nil)
+	m.updateUnreadCount(ctx, req.UserID, req.ConversationID, req.HasReadSeq)
 	m.sendMarkAsReadNotification(ctx, req.ConversationID, constant.SingleChatType, req.UserID, req.UserID, nil, req.HasReadSeq)
 	return &msg.SetConversationHasReadSeqResp{}, nil
 }
@@ -103,8 +109,11 @@ func (m *msgServer) MarkMsgsAsRead(ctx context.Context, req *msg.MarkMsgsAsReadR
 	if err != nil {
 		return nil, err
 	}
-	if err := m.MsgDatabase.MarkSingleChatMsgsAsRead(ctx, req.UserID, req.ConversationID, req.Seqs); err != nil {
-		return nil, err
+	isGroup := conversation.ConversationType == constant.ReadGroupChatType
+	if !isGroup {
+		if err := m.MsgDatabase.MarkSingleChatMsgsAsRead(ctx, req.UserID, req.ConversationID, req.Seqs); err != nil {
+			return nil, err
+		}
 	}
 	currentHasReadSeq, err := m.MsgDatabase.GetHasReadSeq(ctx, req.UserID, req.ConversationID)
 	if err != nil && errs.Unwrap(err) != redis.Nil {
@@ -115,15 +124,22 @@ func (m *msgServer) MarkMsgsAsRead(ctx context.Context, req *msg.MarkMsgsAsReadR
 		if err != nil {
 			return nil, err
 		}
+		m.updateUnreadCount(ctx, req.UserID, req.ConversationID, hasReadSeq)
 	}
-
-	reqCallback := &cbapi.CallbackSingleMsgReadReq{
-		ConversationID: conversation.ConversationID,
-		UserID:         req.UserID,
-		Seqs:           req.Seqs,
-		ContentType:    conversation.ConversationType,
+	m.startReadDestruct(ctx, req.ConversationID, req.UserID, currentHasReadSeq, hasReadSeq, req.Seqs)
+	if isGroup {
+		if err := m.markGroupMsgsAsRead(ctx, conversation, req.UserID, hasReadSeq); err != nil {
+			return nil, err
+		}
+	} else {
+		reqCallback := &cbapi.CallbackSingleMsgReadReq{
+			ConversationID: conversation.ConversationID,
+			UserID:         req.UserID,
+			Seqs:           req.Seqs,
+			ContentType:    conversation.ConversationType,
+		}
+		m.webhookAfterSingleMsgRead(ctx, &m.config.WebhooksConfig.AfterSingleMsgRead, reqCallback)
 	}
-	m.webhookAfterSingleMsgRead(ctx, &m.config.WebhooksConfig.AfterSingleMsgRead, reqCallback)
 	m.sendMarkAsReadNotification(ctx, req.ConversationID, conversation.ConversationType, req.UserID,
 		m.conversationAndGetRecvID(conversation, req.UserID), req.Seqs, hasReadSeq)
 	return &msg.MarkMsgsAsReadResp{}, nil
@@ -138,6 +154,7 @@ func (m *msgServer) MarkConversationAsRead(ctx context.Context, req *msg.MarkCon
 	if err != nil && errs.Unwrap(err) != redis.Nil {
 		return nil, err
 	}
+	prevHasReadSeq := hasReadSeq
 	var seqs []int64
 
 	log.ZDebug(ctx, "MarkConversationAsRead", "hasReadSeq", hasReadSeq, "req.HasReadSeq", req.HasReadSeq)
@@ -178,7 +195,20 @@ func (m *msgServer) MarkConversationAsRead(ctx context.Context, req *msg.MarkCon
 		m.sendMarkAsReadNotification(ctx, req.ConversationID, constant.SingleChatType, req.UserID,
 			req.UserID, seqs, hasReadSeq)
 	}
+	m.startReadDestruct(ctx, req.ConversationID, req.UserID, prevHasReadSeq, req.HasReadSeq, req.Seqs)
+	if hasReadSeq > prevHasReadSeq {
+		m.updateUnreadCount(ctx, req.UserID, req.ConversationID, hasReadSeq)
+	}
 
+	if conversation.ConversationType == constant.ReadGroupChatType {
+		// 群会话的回调在已读位置前进时携带已读人数触发
+		if hasReadSeq > 0 {
+			if err := m.markGroupMsgsAsRead(ctx, conversation, req.UserID, hasReadSeq); err != nil {
+				return nil, err
+			}
+		}
+		return &msg.MarkConversationAsReadResp{}, nil
+	}
 	reqCall := &cbapi.CallbackGroupMsgReadReq{
 		SendID:       conversation.OwnerUserID,
 		ReceiveID:    req.UserID,
@@ -0,0 +1,392 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/authverify"
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/aetim/pkg/protocol/msgext"
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/Meikwei/go-tools/log"
+	"github.com/Meikwei/go-tools/mcontext"
+	"github.com/Meikwei/go-tools/utils/datautil"
+	"github.com/Meikwei/go-tools/utils/encrypt"
+	"github.com/Meikwei/go-tools/utils/idutil"
+	"github.com/Meikwei/protocol/constant"
+	pbmsg "github.com/Meikwei/protocol/msg"
+	"github.com/Meikwei/protocol/sdkws"
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// defaultBroadcastMaxRunning is used when msg.broadcast.maxRunning is not configured.
+	defaultBroadcastMaxRunning = 2
+	// defaultBroadcastInterval is used when msg.broadcast.interval is not configured, in seconds.
+	defaultBroadcastInterval = 5
+	// defaultBroadcastLease is used when msg.broadcast.lease is not configured, in seconds.
+	defaultBroadcastLease = 60
+	// defaultBroadcastBatchSize is used when msg.broadcast.batchSize is not configured.
+	defaultBroadcastBatchSize = 500
+	// defaultBroadcastRate is used when msg.broadcast.defaultRate is not configured, in messages per second.
+	defaultBroadcastRate = 200
+	// defaultBroadcastMaxRate is used when msg.broadcast.maxRate is not configured, in messages per second.
+	defaultBroadcastMaxRate = 2000
+	// defaultBroadcastMaxUserIDs is used when msg.broadcast.maxUserIDs is not configured.
+	defaultBroadcastMaxUserIDs = 100000
+)
+
+// broadcastConfig returns the value of a msg.broadcast setting, or def when it is not configured.
+func broadcastConfig(value int, def int) int {
+	if value <= 0 {
+		return def
+	}
+	return value
+}
+
+func (m *msgServer) broadcastLease() time.Duration {
+	return time.Duration(broadcastConfig(m.config.RpcConfig.Broadcast.Lease, defaultBroadcastLease)) * time.Second
+}
+
+func (m *msgServer) CreateBroadcastJob(ctx context.Context, req *msgext.CreateBroadcastJobReq) (*msgext.CreateBroadcastJobResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	msgData := req.MsgData
+	if !datautil.Contain(msgData.SessionType, constant.SingleChatType, constant.NotificationChatType) {
+		return nil, errs.ErrArgs.WrapMsg("sessionType not support broadcast", "sessionType", msgData.SessionType)
+	}
+	conf := &m.config.RpcConfig.Broadcast
+	msgRate := int(req.Rate)
+	if msgRate == 0 {
+		msgRate = broadcastConfig(conf.DefaultRate, defaultBroadcastRate)
+	}
+	if maxRate := broadcastConfig(conf.MaxRate, defaultBroadcastMaxRate); msgRate > maxRate {
+		return nil, errs.ErrArgs.WrapMsg("rate is too high", "rate", msgRate, "maxRate", maxRate)
+	}
+	if _, err := m.UserLocalCache.GetUserInfo(ctx, msgData.SendID); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	opUserID := mcontext.GetOpUserID(ctx)
+	job := &relation.BroadcastJobModel{
+		JobID:           GetMsgID(opUserID),
+		OpUserID:        opUserID,
+		SendID:          msgData.SendID,
+		TargetType:      req.Target.Type,
+		GroupIDs:        datautil.Distinct(req.Target.GroupIDs),
+		AppMangerLevels: req.Target.AppMangerLevels,
+		Rate:            int32(msgRate),
+		Status:          relation.BroadcastPending,
+		CreateTime:      now,
+		UpdateTime:      now,
+	}
+	if req.Target.Type == msgext.BroadcastTargetUsers {
+		// 接收者按用户ID升序发送，进度记录为已处理的最后一个用户ID
+		job.UserIDs = datautil.Distinct(req.Target.UserIDs)
+		sort.Strings(job.UserIDs)
+		if maxUserIDs := broadcastConfig(conf.MaxUserIDs, defaultBroadcastMaxUserIDs); len(job.UserIDs) > maxUserIDs {
+			return nil, errs.ErrArgs.WrapMsg("too many userIDs", "count", len(job.UserIDs), "maxUserIDs", maxUserIDs)
+		}
+	}
+	if req.Target.CreateTimeBegin > 0 {
+		job.CreateTimeBegin = time.UnixMilli(req.Target.CreateTimeBegin)
+	}
+	if req.Target.CreateTimeEnd > 0 {
+		job.CreateTimeEnd = time.UnixMilli(req.Target.CreateTimeEnd)
+	}
+	// 接收者、服务端ID和发送时间在发送给每个接收者时生成
+	msgData.RecvID = ""
+	msgData.GroupID = ""
+	msgData.ClientMsgID = ""
+	msgData.ServerMsgID = ""
+	msgData.SendTime = 0
+	data, err := proto.Marshal(msgData)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "marshal msgData failed")
+	}
+	job.MsgData = data
+	if job.TotalCount, err = m.BroadcastDatabase.CountBroadcastRecipients(ctx, job); err != nil {
+		return nil, err
+	}
+	if err := m.BroadcastDatabase.CreateBroadcastJob(ctx, job); err != nil {
+		return nil, err
+	}
+	return &msgext.CreateBroadcastJobResp{JobID: job.JobID}, nil
+}
+
+func (m *msgServer) GetBroadcastJob(ctx context.Context, req *msgext.GetBroadcastJobReq) (*msgext.GetBroadcastJobResp, error) {
+	job, err := m.takeBroadcastJob(ctx, req.JobID)
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.GetBroadcastJobResp{Job: convertBroadcastJob(job)}, nil
+}
+
+func (m *msgServer) GetBroadcastJobs(ctx context.Context, req *msgext.GetBroadcastJobsReq) (*msgext.GetBroadcastJobsResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	total, jobs, err := m.BroadcastDatabase.FindBroadcastJobs(ctx, req.Status, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msgext.GetBroadcastJobsResp{Total: total, Jobs: make([]*msgext.BroadcastJob, 0, len(jobs))}
+	for _, job := range jobs {
+		resp.Jobs = append(resp.Jobs, convertBroadcastJob(job))
+	}
+	return resp, nil
+}
+
+func (m *msgServer) PauseBroadcastJob(ctx context.Context, req *msgext.PauseBroadcastJobReq) (*msgext.PauseBroadcastJobResp, error) {
+	if _, err := m.takeBroadcastJob(ctx, req.JobID); err != nil {
+		return nil, err
+	}
+	if err := m.BroadcastDatabase.PauseBroadcastJob(ctx, req.JobID); err != nil {
+		if IsNotFound(err) {
+			return nil, errs.ErrArgs.WrapMsg("broadcast job is not pending or running", "jobID", req.JobID)
+		}
+		return nil, err
+	}
+	return &msgext.PauseBroadcastJobResp{}, nil
+}
+
+func (m *msgServer) ResumeBroadcastJob(ctx context.Context, req *msgext.ResumeBroadcastJobReq) (*msgext.ResumeBroadcastJobResp, error) {
+	if _, err := m.takeBroadcastJob(ctx, req.JobID); err != nil {
+		return nil, err
+	}
+	if err := m.BroadcastDatabase.ResumeBroadcastJob(ctx, req.JobID); err != nil {
+		if IsNotFound(err) {
+			return nil, errs.ErrArgs.WrapMsg("broadcast job is not paused", "jobID", req.JobID)
+		}
+		return nil, err
+	}
+	return &msgext.ResumeBroadcastJobResp{}, nil
+}
+
+func (m *msgServer) CancelBroadcastJob(ctx context.Context, req *msgext.CancelBroadcastJobReq) (*msgext.CancelBroadcastJobResp, error) {
+	if _, err := m.takeBroadcastJob(ctx, req.JobID); err != nil {
+		return nil, err
+	}
+	if err := m.BroadcastDatabase.CancelBroadcastJob(ctx, req.JobID); err != nil {
+		if IsNotFound(err) {
+			return nil, errs.ErrArgs.WrapMsg("broadcast job is already finished", "jobID", req.JobID)
+		}
+		return nil, err
+	}
+	return &msgext.CancelBroadcastJobResp{}, nil
+}
+
+func (m *msgServer) GetBroadcastFailures(ctx context.Context, req *msgext.GetBroadcastFailuresReq) (*msgext.GetBroadcastFailuresResp, error) {
+	if _, err := m.takeBroadcastJob(ctx, req.JobID); err != nil {
+		return nil, err
+	}
+	total, failures, err := m.BroadcastDatabase.FindBroadcastFailures(ctx, req.JobID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msgext.GetBroadcastFailuresResp{Total: total, Failures: make([]*msgext.BroadcastFailure, 0, len(failures))}
+	for _, failure := range failures {
+		resp.Failures = append(resp.Failures, &msgext.BroadcastFailure{
+			UserID: failure.UserID,
+			Error:  failure.Error,
+			Time:   failure.CreateTime.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
+
+// takeBroadcastJob loads a broadcast job after checking that the op user is an admin.
+func (m *msgServer) takeBroadcastJob(ctx context.Context, jobID string) (*relation.BroadcastJobModel, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	job, err := m.BroadcastDatabase.TakeBroadcastJob(ctx, jobID)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, errs.ErrRecordNotFound.WrapMsg("broadcast job not found", "jobID", jobID)
+		}
+		return nil, err
+	}
+	return job, nil
+}
+
+func convertBroadcastJob(job *relation.BroadcastJobModel) *msgext.BroadcastJob {
+	res := &msgext.BroadcastJob{
+		JobID:    job.JobID,
+		OpUserID: job.OpUserID,
+		SendID:   job.SendID,
+		Target: &msgext.BroadcastTarget{
+			Type:            job.TargetType,
+			UserIDs:         job.UserIDs,
+			GroupIDs:        job.GroupIDs,
+			AppMangerLevels: job.AppMangerLevels,
+		},
+		Rate:        job.Rate,
+		Status:      job.Status,
+		TotalCount:  job.TotalCount,
+		SentCount:   job.SentCount,
+		FailedCount: job.FailedCount,
+		Cursor:      job.Cursor,
+		Error:       job.Error,
+		CreateTime:  job.CreateTime.UnixMilli(),
+		UpdateTime:  job.UpdateTime.UnixMilli(),
+	}
+	if !job.CreateTimeBegin.IsZero() {
+		res.Target.CreateTimeBegin = job.CreateTimeBegin.UnixMilli()
+	}
+	if !job.CreateTimeEnd.IsZero() {
+		res.Target.CreateTimeEnd = job.CreateTimeEnd.UnixMilli()
+	}
+	if !job.FinishTime.IsZero() {
+		res.FinishTime = job.FinishTime.UnixMilli()
+	}
+	return res
+}
+
+// broadcastLoop claims pending broadcast jobs, and jobs whose instance stopped, until the context is done.
+func (m *msgServer) broadcastLoop(ctx context.Context) {
+	m.broadcastLimiter = make(chan struct{}, broadcastConfig(m.config.RpcConfig.Broadcast.MaxRunning, defaultBroadcastMaxRunning))
+	ticker := time.NewTicker(time.Duration(broadcastConfig(m.config.RpcConfig.Broadcast.Interval, defaultBroadcastInterval)) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.claimBroadcastJobs(mcontext.SetOperationID(context.Background(), idutil.OperationIDGenerator()))
+		}
+	}
+}
+
+// claimBroadcastJobs starts claimed jobs until the instance runs maxRunning jobs or no job is left.
+func (m *msgServer) claimBroadcastJobs(ctx context.Context) {
+	for {
+		select {
+		case m.broadcastLimiter <- struct{}{}:
+		default:
+			return
+		}
+		// 每次领取使用不同的owner，任务暂停后又继续时，同一实例中旧的发送协程在记录进度时即退出
+		owner := idutil.OperationIDGenerator()
+		job, err := m.BroadcastDatabase.ClaimBroadcastJob(ctx, time.Now(), owner, m.broadcastLease())
+		if err != nil {
+			<-m.broadcastLimiter
+			if !IsNotFound(err) {
+				log.ZError(ctx, "claim broadcast job failed", err)
+			}
+			return
+		}
+		jobCtx := mcontext.WithOpUserIDContext(mcontext.SetOperationID(context.Background(), mcontext.GetOperationID(ctx)+"_"+job.JobID), job.OpUserID)
+		go func() {
+			defer func() { <-m.broadcastLimiter }()
+			m.runBroadcastJob(jobCtx, job, owner)
+		}()
+	}
+}
+
+// runBroadcastJob sends the job's message to its recipients after the cursor, batch by batch. Progress is saved
+// after each batch; when it can't be saved because the job was paused or canceled the job stops. If the instance
+// stops, another instance continues from the saved cursor once the lease expires. Each recipient is marked in the
+// job before its message is sent, so the recipients of the unsaved batch that were already handled are skipped.
+func (m *msgServer) runBroadcastJob(ctx context.Context, job *relation.BroadcastJobModel, owner string) {
+	log.ZInfo(ctx, "broadcast job start", "jobID", job.JobID, "cursor", job.Cursor, "rate", job.Rate)
+	template := &sdkws.MsgData{}
+	if err := proto.Unmarshal(job.MsgData, template); err != nil {
+		m.finishBroadcastJob(ctx, job, owner, relation.BroadcastFailed, "unmarshal msgData failed: "+err.Error())
+		return
+	}
+	lease := m.broadcastLease()
+	// 每批的发送时间不超过领取有效期的一半
+	batchSize := min(broadcastConfig(m.config.RpcConfig.Broadcast.BatchSize, defaultBroadcastBatchSize), max(int(job.Rate)*int(lease/time.Second)/2, 1))
+	limiter := rate.NewLimiter(rate.Limit(job.Rate), 1)
+	cursor := job.Cursor
+	for {
+		userIDs, err := m.BroadcastDatabase.NextBroadcastRecipients(ctx, job, cursor, int64(batchSize))
+		if err != nil {
+			log.ZError(ctx, "next broadcast recipients failed", err, "jobID", job.JobID, "cursor", cursor)
+			m.finishBroadcastJob(ctx, job, owner, relation.BroadcastFailed, err.Error())
+			return
+		}
+		if len(userIDs) == 0 {
+			m.finishBroadcastJob(ctx, job, owner, relation.BroadcastSucceeded, "")
+			return
+		}
+		var (
+			sent     int64
+			failures []*relation.BroadcastFailureModel
+		)
+		for _, userID := range userIDs {
+			if err := limiter.Wait(ctx); err != nil {
+				log.ZError(ctx, "broadcast rate limiter failed", err, "jobID", job.JobID)
+				return
+			}
+			marked, err := m.BroadcastDatabase.MarkBroadcastSent(ctx, job.JobID, userID)
+			if err == nil && !marked {
+				// handled before the job was interrupted, its progress wasn't saved
+				sent++
+				continue
+			}
+			if err == nil {
+				err = m.sendBroadcastMsg(ctx, job, template, userID)
+			}
+			if err != nil {
+				log.ZWarn(ctx, "broadcast msg failed", err, "jobID", job.JobID, "userID", userID)
+				failures = append(failures, &relation.BroadcastFailureModel{
+					JobID:      job.JobID,
+					UserID:     userID,
+					Error:      err.Error(),
+					CreateTime: time.Now(),
+				})
+				continue
+			}
+			sent++
+		}
+		if err := m.BroadcastDatabase.AddBroadcastFailures(ctx, failures); err != nil {
+			log.ZError(ctx, "add broadcast failures failed", err, "jobID", job.JobID)
+		}
+		cursor = userIDs[len(userIDs)-1]
+		if err := m.BroadcastDatabase.ProgressBroadcastJob(ctx, job.JobID, owner, cursor, sent, int64(len(failures)), lease); err != nil {
+			if IsNotFound(err) {
+				log.ZInfo(ctx, "broadcast job paused or canceled", "jobID", job.JobID, "cursor", cursor)
+			} else {
+				// 领取过期后任务从上次记录的位置继续
+				log.ZError(ctx, "progress broadcast job failed", err, "jobID", job.JobID, "cursor", cursor)
+			}
+			return
+		}
+	}
+}
+
+// sendBroadcastMsg sends a copy of the template to userID.
+func (m *msgServer) sendBroadcastMsg(ctx context.Context, job *relation.BroadcastJobModel, template *sdkws.MsgData, userID string) error {
+	msgData := proto.Clone(template).(*sdkws.MsgData)
+	msgData.RecvID = userID
+	msgData.ClientMsgID = encrypt.Md5(job.JobID + ":" + userID)
+	_, err := m.SendMsg(ctx, &pbmsg.SendMsgReq{MsgData: msgData})
+	return err
+}
+
+func (m *msgServer) finishBroadcastJob(ctx context.Context, job *relation.BroadcastJobModel, owner string, status int32, errMsg string) {
+	if err := m.BroadcastDatabase.FinishBroadcastJob(ctx, job.JobID, owner, status, errMsg); err != nil && !IsNotFound(err) {
+		log.ZError(ctx, "finish broadcast job failed", err, "jobID", job.JobID)
+		return
+	}
+	log.ZInfo(ctx, "broadcast job finished", "jobID", job.JobID, "status", status, "error", errMsg)
+}
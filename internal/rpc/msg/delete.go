@@ -74,6 +74,7 @@ func (m *msgServer) DeleteMsgs(ctx context.Context, req *msg.DeleteMsgsReq) (*ms
 		if err := m.MsgDatabase.DeleteMsgsPhysicalBySeqs(ctx, req.ConversationID, req.Seqs); err != nil {
 			return nil, err
 		}
+		m.deleteSearchIndex(ctx, req.ConversationID, req.Seqs)
 		conversations, err := m.Conversation.GetConversationsByConversationID(ctx, []string{req.ConversationID})
 		if err != nil {
 			return nil, err
@@ -104,6 +105,7 @@ func (m *msgServer) DeleteMsgPhysicalBySeq(ctx context.Context, req *msg.DeleteM
 	if err != nil {
 		return nil, err
 	}
+	m.deleteSearchIndex(ctx, req.ConversationID, req.Seqs)
 	return &msg.DeleteMsgPhysicalBySeqResp{}, nil
 }
 
@@ -115,7 +117,14 @@ func (m *msgServer) DeleteMsgPhysical(ctx context.Context, req *msg.DeleteMsgPhy
 	for _, conversationID := range req.ConversationIDs {
 		if err := m.MsgDatabase.DeleteConversationMsgsAndSetMinSeq(ctx, conversationID, remainTime); err != nil {
 			log.ZWarn(ctx, "DeleteConversationMsgsAndSetMinSeq error", err, "conversationID", conversationID, "err", err)
+			continue
 		}
+		minSeq, err := m.MsgDatabase.GetMinSeq(ctx, conversationID)
+		if err != nil {
+			log.ZWarn(ctx, "GetMinSeq error", err, "conversationID", conversationID)
+			continue
+		}
+		m.deleteSearchIndexBefore(ctx, conversationID, minSeq)
 	}
 	return &msg.DeleteMsgPhysicalResp{}, nil
 }
@@ -147,9 +156,13 @@ func (m *msgServer) clearConversation(ctx context.Context, conversationIDs []str
 			m.notificationSender.NotificationWithSessionType(ctx, userID, userID, constant.ClearConversationNotification, constant.SingleChatType, tips)
 		}
 	} else {
-		if err := m.MsgDatabase.SetMinSeqs(ctx, m.getMinSeqs(maxSeqs)); err != nil {
+		minSeqs := m.getMinSeqs(maxSeqs)
+		if err := m.MsgDatabase.SetMinSeqs(ctx, minSeqs); err != nil {
 			return err
 		}
+		for conversationID, minSeq := range minSeqs {
+			m.deleteSearchIndexBefore(ctx, conversationID, minSeq)
+		}
 		for _, conversation := range existConversations {
 			tips := &sdkws.ClearConversationTips{UserID: userID, ConversationIDs: []string{conversation.ConversationID}}
 			m.notificationSender.NotificationWithSessionType(ctx, userID, m.conversationAndGetRecvID(conversation, userID), constant.ClearConversationNotification, conversation.ConversationType, tips)
@@ -160,3 +173,20 @@ func (m *msgServer) clearConversation(ctx context.Context, conversationIDs []str
 	}
 	return nil
 }
+
+// deleteSearchIndex removes deleted msgs from the search index. Search results are loaded from the msg
+// storage again, so a failure here only leaves stale index entries behind.
+func (m *msgServer) deleteSearchIndex(ctx context.Context, conversationID string, seqs []int64) {
+	if err := m.MsgSearchDatabase.DeleteMsgs(ctx, conversationID, seqs); err != nil {
+		log.ZWarn(ctx, "delete msgs from search index failed", err, "conversationID", conversationID, "seqs", seqs)
+	}
+}
+
+func (m *msgServer) deleteSearchIndexBefore(ctx context.Context, conversationID string, minSeq int64) {
+	if minSeq <= 0 {
+		return
+	}
+	if err := m.MsgSearchDatabase.DeleteMsgsBefore(ctx, conversationID, minSeq); err != nil {
+		log.ZWarn(ctx, "delete msgs from search index failed", err, "conversationID", conversationID, "minSeq", minSeq)
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/apistruct"
+	"github.com/Meikwei/aetim/pkg/authverify"
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/aetim/pkg/common/servererrs"
+	"github.com/Meikwei/aetim/pkg/protocol/msgext"
+	"github.com/Meikwei/aetim/pkg/util/sensitive"
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/Meikwei/go-tools/log"
+	"github.com/Meikwei/go-tools/mcontext"
+	"github.com/Meikwei/go-tools/utils/datautil"
+	"github.com/Meikwei/protocol/constant"
+	"github.com/Meikwei/protocol/sdkws"
+	"github.com/go-playground/validator/v10"
+	"github.com/mitchellh/mapstructure"
+	"google.golang.org/protobuf/proto"
+)
+
+// editableContentTypes are the message types whose content can be edited after sending.
+var editableContentTypes = []int32{constant.Text, constant.AtText, constant.Quote, constant.AdvancedText, constant.Custom}
+
+var contentValidate = validator.New()
+
+func (m *msgServer) EditMsg(ctx context.Context, req *msgext.EditMsgReq) (*msgext.EditMsgResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	user, err := m.UserLocalCache.GetUserInfo(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, req.UserID, req.ConversationID, []int64{req.Seq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || msgs[0] == nil {
+		return nil, errs.ErrRecordNotFound.WrapMsg("msg not found")
+	}
+	msgData := msgs[0]
+	if msgData.ContentType == constant.MsgRevokeNotification {
+		return nil, servererrs.ErrMsgAlreadyRevoke.WrapMsg("msg already revoke")
+	}
+	if !datautil.Contain(msgData.ContentType, editableContentTypes...) {
+		return nil, servererrs.ErrMsgNotEditable.WrapMsg("msg content type not editable", "contentType", msgData.ContentType)
+	}
+	if _, err := m.checkMsgOperator(ctx, user, msgData); err != nil {
+		return nil, err
+	}
+	now := time.Now().UnixMilli()
+	isAdminEdit := authverify.IsAppManagerUid(ctx, m.config.Share.IMAdminUserID)
+	if timeLimit := m.config.RpcConfig.Edit.TimeLimit; timeLimit > 0 && !isAdminEdit {
+		if now-msgData.SendTime > int64(timeLimit)*1000 {
+			return nil, servererrs.ErrMsgEditTimeout.WrapMsg("msg can no longer be edited", "sendTime", msgData.SendTime)
+		}
+	}
+	if err := checkEditContent(msgData.ContentType, req.Content); err != nil {
+		return nil, err
+	}
+	edited := proto.Clone(msgData).(*sdkws.MsgData)
+	edited.Content = []byte(req.Content)
+	policy, err := m.checkSensitive(ctx, edited)
+	if err != nil {
+		return nil, err
+	}
+	// 编辑后的内容已经投递给所有人，不支持只投递给发送者
+	if policy == sensitive.PolicySenderOnly {
+		return nil, servererrs.ErrMsgSensitiveWord.WrapMsg("msg contains sensitive words")
+	}
+	edit := &relation.EditModel{
+		UserID:      req.UserID,
+		PrevContent: string(msgData.Content),
+		Content:     string(edited.Content),
+		Time:        now,
+	}
+	if err := m.MsgDatabase.EditMsg(ctx, req.ConversationID, req.Seq, edit, edited); err != nil {
+		return nil, err
+	}
+	if err := m.MsgSearchDatabase.ReindexMsg(ctx, req.ConversationID, edited); err != nil {
+		log.ZWarn(ctx, "reindex edited msg failed", err, "conversationID", req.ConversationID, "seq", req.Seq)
+	}
+	tips := msgext.MsgEditTips{
+		EditorUserID:   mcontext.GetOpUserID(ctx),
+		ConversationID: req.ConversationID,
+		Seq:            req.Seq,
+		ClientMsgID:    msgData.ClientMsgID,
+		SessionType:    msgData.SessionType,
+		ContentType:    msgData.ContentType,
+		Content:        string(edited.Content),
+		EditTime:       now,
+		IsAdminEdit:    isAdminEdit,
+	}
+	var recvID string
+	if msgData.SessionType == constant.ReadGroupChatType {
+		recvID = msgData.GroupID
+	} else {
+		recvID = msgData.RecvID
+	}
+	m.notificationSender.NotificationWithSessionType(ctx, req.UserID, recvID, msgext.MsgEditNotification, msgData.SessionType, &tips)
+	return &msgext.EditMsgResp{EditTime: now}, nil
+}
+
+// checkEditContent applies the content checks of a sent message to the edited content,
+// so that an edit can not replace a valid message with a malformed one.
+func checkEditContent(contentType int32, content string) error {
+	var elem any
+	switch contentType {
+	case constant.Text:
+		elem = &apistruct.TextElem{}
+	case constant.AtText:
+		elem = &apistruct.AtElem{}
+	case constant.Quote:
+		elem = &apistruct.QuoteElem{}
+	case constant.AdvancedText:
+		elem = &apistruct.AdvancedTextElem{}
+	case constant.Custom:
+		elem = &apistruct.CustomElem{}
+	default:
+		return servererrs.ErrMsgNotEditable.WrapMsg("msg content type not editable", "contentType", contentType)
+	}
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(content), &fields); err != nil {
+		return errs.ErrArgs.WrapMsg("content is not a json object", "contentType", contentType)
+	}
+	if err := mapstructure.WeakDecode(fields, elem); err != nil {
+		return errs.ErrArgs.WrapMsg("failed to decode message content", "contentType", contentType, "err", err.Error())
+	}
+	if err := contentValidate.Struct(elem); err != nil {
+		return errs.ErrArgs.WrapMsg("validation error", "contentType", contentType, "err", err.Error())
+	}
+	return nil
+}
+
+func (m *msgServer) GetMsgEditHistory(ctx context.Context, req *msgext.GetMsgEditHistoryReq) (*msgext.GetMsgEditHistoryResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if err := m.checkConversationIDMember(ctx, req.UserID, req.ConversationID); err != nil {
+		return nil, err
+	}
+	edits, err := m.MsgDatabase.GetMsgEdits(ctx, req.UserID, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msgext.GetMsgEditHistoryResp{Records: make([]*msgext.MsgEditRecord, 0, len(edits))}
+	for _, edit := range edits {
+		resp.Records = append(resp.Records, &msgext.MsgEditRecord{
+			EditorUserID: edit.UserID,
+			PrevContent:  edit.PrevContent,
+			Content:      edit.Content,
+			EditTime:     edit.Time,
+		})
+	}
+	return resp, nil
+}
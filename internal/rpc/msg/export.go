@@ -0,0 +1,327 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/authverify"
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/aetim/pkg/msgprocessor"
+	"github.com/Meikwei/aetim/pkg/protocol/msgext"
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/Meikwei/go-tools/log"
+	"github.com/Meikwei/go-tools/mcontext"
+	"github.com/Meikwei/protocol/constant"
+	"github.com/Meikwei/protocol/sdkws"
+	"github.com/Meikwei/protocol/third"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// defaultExportMaxRunning is used when msg.export.maxRunning is not configured.
+	defaultExportMaxRunning = 2
+	// defaultExportStaleTimeout is used when msg.export.staleTimeout is not configured, in seconds.
+	defaultExportStaleTimeout = 600
+	// exportPageSize is the number of seqs read from a conversation at a time.
+	exportPageSize = 100
+	// exportObjectGroup is the object group of the uploaded export files.
+	exportObjectGroup = "msg_export"
+)
+
+// initMsgExport sets up the export job limiter and fails the jobs left unfinished by a previous run.
+func (m *msgServer) initMsgExport(ctx context.Context) {
+	maxRunning := m.config.RpcConfig.Export.MaxRunning
+	if maxRunning <= 0 {
+		maxRunning = defaultExportMaxRunning
+	}
+	m.exportLimiter = make(chan struct{}, maxRunning)
+	staleTimeout := m.config.RpcConfig.Export.StaleTimeout
+	if staleTimeout <= 0 {
+		staleTimeout = defaultExportStaleTimeout
+	}
+	count, err := m.MsgExportDatabase.FailStaleExportJobs(ctx, time.Duration(staleTimeout)*time.Second, "export interrupted")
+	if err != nil {
+		log.ZWarn(ctx, "FailStaleExportJobs failed", err)
+		return
+	}
+	if count > 0 {
+		log.ZInfo(ctx, "failed stale msg export jobs", "count", count)
+	}
+}
+
+func (m *msgServer) CreateMsgExportJob(ctx context.Context, req *msgext.CreateMsgExportJobReq) (*msgext.CreateMsgExportJobResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if req.ConversationID != "" {
+		// 只能导出用户自己的会话
+		if _, err := m.Conversation.GetConversation(ctx, req.UserID, req.ConversationID); err != nil {
+			return nil, err
+		}
+	}
+	now := time.Now()
+	endTime := req.EndTime
+	if endTime == 0 {
+		endTime = now.UnixMilli()
+	}
+	opUserID := mcontext.GetOpUserID(ctx)
+	job := &relation.MsgExportJobModel{
+		JobID:          GetMsgID(opUserID),
+		OpUserID:       opUserID,
+		UserID:         req.UserID,
+		ConversationID: req.ConversationID,
+		StartTime:      req.StartTime,
+		EndTime:        endTime,
+		Status:         relation.MsgExportPending,
+		CreateTime:     now,
+		UpdateTime:     now,
+	}
+	if err := m.MsgExportDatabase.CreateExportJob(ctx, job); err != nil {
+		return nil, err
+	}
+	jobCtx := mcontext.WithOpUserIDContext(mcontext.SetOperationID(context.Background(), mcontext.GetOperationID(ctx)+"_"+job.JobID), opUserID)
+	go m.runMsgExportJob(jobCtx, job)
+	return &msgext.CreateMsgExportJobResp{JobID: job.JobID}, nil
+}
+
+func (m *msgServer) GetMsgExportJob(ctx context.Context, req *msgext.GetMsgExportJobReq) (*msgext.GetMsgExportJobResp, error) {
+	job, err := m.MsgExportDatabase.TakeExportJob(ctx, req.JobID)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, errs.ErrRecordNotFound.WrapMsg("export job not found", "jobID", req.JobID)
+		}
+		return nil, err
+	}
+	if err := authverify.CheckAccessV3(ctx, job.OpUserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	pbJob := &msgext.MsgExportJob{
+		JobID:              job.JobID,
+		UserID:             job.UserID,
+		ConversationID:     job.ConversationID,
+		StartTime:          job.StartTime,
+		EndTime:            job.EndTime,
+		Status:             job.Status,
+		TotalConversations: job.TotalConversations,
+		DoneConversations:  job.DoneConversations,
+		MsgCount:           job.MsgCount,
+		Error:              job.Error,
+		CreateTime:         job.CreateTime.UnixMilli(),
+		UpdateTime:         job.UpdateTime.UnixMilli(),
+	}
+	if job.Status == relation.MsgExportSucceeded {
+		// 每次查询重新签名，链接过期后再次查询即可
+		jsonl, err := m.Third.Client.AccessURL(ctx, &third.AccessURLReq{Name: job.JSONLName})
+		if err != nil {
+			return nil, err
+		}
+		html, err := m.Third.Client.AccessURL(ctx, &third.AccessURLReq{Name: job.HTMLName})
+		if err != nil {
+			return nil, err
+		}
+		pbJob.JsonlURL = jsonl.Url
+		pbJob.HtmlURL = html.Url
+		pbJob.UrlExpireTime = min(jsonl.ExpireTime, html.ExpireTime)
+	}
+	return &msgext.GetMsgExportJobResp{Job: pbJob}, nil
+}
+
+func (m *msgServer) runMsgExportJob(ctx context.Context, job *relation.MsgExportJobModel) {
+	m.exportLimiter <- struct{}{}
+	defer func() { <-m.exportLimiter }()
+	log.ZInfo(ctx, "msg export job start", "jobID", job.JobID, "userID", job.UserID, "conversationID", job.ConversationID)
+	if err := m.exportMsgs(ctx, job); err != nil {
+		log.ZError(ctx, "msg export job failed", err, "jobID", job.JobID)
+		args := map[string]any{"status": relation.MsgExportFailed, "error": err.Error()}
+		if err := m.MsgExportDatabase.UpdateExportJob(ctx, job.JobID, args); err != nil {
+			log.ZError(ctx, "UpdateExportJob failed", err, "jobID", job.JobID)
+		}
+		return
+	}
+	log.ZInfo(ctx, "msg export job finished", "jobID", job.JobID, "msgCount", job.MsgCount)
+}
+
+// exportMsgs writes the messages of the job to temporary files and uploads them to the object storage.
+func (m *msgServer) exportMsgs(ctx context.Context, job *relation.MsgExportJobModel) error {
+	conversationIDs := []string{job.ConversationID}
+	if job.ConversationID == "" {
+		var err error
+		conversationIDs, err = m.Conversation.GetConversationIDs(ctx, job.UserID)
+		if err != nil {
+			return err
+		}
+		sort.Strings(conversationIDs)
+	}
+	job.Status = relation.MsgExportRunning
+	job.TotalConversations = int64(len(conversationIDs))
+	if err := m.MsgExportDatabase.UpdateExportJob(ctx, job.JobID, map[string]any{"status": job.Status, "total_conversations": job.TotalConversations}); err != nil {
+		return err
+	}
+	minSeqs, err := m.getUserMinSeqs(ctx, job.UserID, conversationIDs)
+	if err != nil {
+		return err
+	}
+	w, err := newMsgExportWriter(job)
+	if err != nil {
+		return err
+	}
+	defer w.Remove()
+	e := newMsgExporter(m)
+	for i, conversationID := range conversationIDs {
+		if err := m.exportConversationMsgs(ctx, job, w, e, conversationID, minSeqs[conversationID]); err != nil {
+			return err
+		}
+		job.DoneConversations = int64(i + 1)
+		if err := m.MsgExportDatabase.UpdateExportJob(ctx, job.JobID, map[string]any{"done_conversations": job.DoneConversations, "msg_count": job.MsgCount}); err != nil {
+			return err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	dir := path.Join(job.OpUserID, exportObjectGroup, job.JobID)
+	job.JSONLName = path.Join(dir, "messages.jsonl")
+	job.HTMLName = path.Join(dir, "messages.html")
+	if err := m.uploadExportFile(ctx, job.JSONLName, "application/x-ndjson", w.jsonl); err != nil {
+		return err
+	}
+	if err := m.uploadExportFile(ctx, job.HTMLName, "text/html; charset=utf-8", w.html); err != nil {
+		return err
+	}
+	job.Status = relation.MsgExportSucceeded
+	return m.MsgExportDatabase.UpdateExportJob(ctx, job.JobID, map[string]any{
+		"status":     job.Status,
+		"msg_count":  job.MsgCount,
+		"jsonl_name": job.JSONLName,
+		"html_name":  job.HTMLName,
+	})
+}
+
+// exportConversationMsgs pages through a conversation from the user's point of view and writes the messages sent in the job's time range.
+func (m *msgServer) exportConversationMsgs(ctx context.Context, job *relation.MsgExportJobModel, w *msgExportWriter, e *msgExporter, conversationID string, minSeq int64) error {
+	maxSeq, err := m.MsgDatabase.GetMaxSeq(ctx, conversationID)
+	if err != nil {
+		if errs.Unwrap(err) == redis.Nil {
+			return nil
+		}
+		return err
+	}
+	if err := w.BeginConversation(conversationID); err != nil {
+		return err
+	}
+	for begin := max(minSeq, 1); begin <= maxSeq; begin += exportPageSize {
+		end := min(begin+exportPageSize-1, maxSeq)
+		_, _, msgs, err := m.MsgDatabase.GetMsgBySeqsRange(ctx, job.UserID, conversationID, begin, end, exportPageSize, 0)
+		if err != nil {
+			return err
+		}
+		sort.Sort(msgprocessor.MsgBySeq(msgs))
+		var over bool
+		for _, msgData := range msgs {
+			if msgData == nil || msgData.Status == constant.MsgDeleted || msgData.ContentType == constant.MsgRevokeNotification {
+				continue
+			}
+			if msgData.SendTime < job.StartTime {
+				continue
+			}
+			if msgData.SendTime > job.EndTime {
+				over = true
+				break
+			}
+			if err := w.Write(e.exportedMsg(ctx, conversationID, msgData)); err != nil {
+				return err
+			}
+			job.MsgCount++
+		}
+		if over {
+			break
+		}
+		if err := m.MsgExportDatabase.UpdateExportJob(ctx, job.JobID, map[string]any{"msg_count": job.MsgCount}); err != nil {
+			return err
+		}
+	}
+	return w.EndConversation()
+}
+
+// msgExporter resolves sender names and media URLs of the exported messages, caching them for the job.
+type msgExporter struct {
+	m     *msgServer
+	names map[string]string
+	urls  map[string]string
+}
+
+func newMsgExporter(m *msgServer) *msgExporter {
+	return &msgExporter{m: m, names: make(map[string]string), urls: make(map[string]string)}
+}
+
+func (e *msgExporter) exportedMsg(ctx context.Context, conversationID string, msgData *sdkws.MsgData) *exportedMsg {
+	msg := &exportedMsg{
+		ConversationID: conversationID,
+		Seq:            msgData.Seq,
+		ClientMsgID:    msgData.ClientMsgID,
+		ServerMsgID:    msgData.ServerMsgID,
+		SendID:         msgData.SendID,
+		SenderName:     e.senderName(ctx, msgData),
+		RecvID:         msgData.RecvID,
+		GroupID:        msgData.GroupID,
+		SessionType:    msgData.SessionType,
+		ContentType:    msgData.ContentType,
+		SendTime:       msgData.SendTime,
+		Content:        string(msgData.Content),
+		Text:           msgprocessor.SearchableText(msgData.ContentType, msgData.Content),
+	}
+	for _, rawURL := range msgprocessor.MediaURLs(msgData.ContentType, msgData.Content) {
+		msg.Media = append(msg.Media, &exportedMedia{URL: rawURL, AccessURL: e.accessURL(ctx, rawURL)})
+	}
+	return msg
+}
+
+// senderName returns the current nickname of the sender, or the nickname carried by the message if the user cannot be found.
+func (e *msgExporter) senderName(ctx context.Context, msgData *sdkws.MsgData) string {
+	if name, ok := e.names[msgData.SendID]; ok {
+		return name
+	}
+	name := msgData.SenderNickname
+	user, err := e.m.UserLocalCache.GetUserInfo(ctx, msgData.SendID)
+	if err != nil {
+		log.ZWarn(ctx, "export GetUserInfo failed", err, "userID", msgData.SendID)
+	} else if user.Nickname != "" {
+		name = user.Nickname
+	}
+	e.names[msgData.SendID] = name
+	return name
+}
+
+// accessURL signs the URLs pointing at the object storage, other URLs are returned as is.
+func (e *msgExporter) accessURL(ctx context.Context, rawURL string) string {
+	if u, ok := e.urls[rawURL]; ok {
+		return u
+	}
+	u := rawURL
+	if name, ok := msgprocessor.ObjectNameFromURL(rawURL); ok {
+		resp, err := e.m.Third.Client.AccessURL(ctx, &third.AccessURLReq{Name: name})
+		if err != nil {
+			log.ZWarn(ctx, "export AccessURL failed", err, "name", name)
+		} else {
+			u = resp.Url
+		}
+	}
+	e.urls[rawURL] = u
+	return u
+}
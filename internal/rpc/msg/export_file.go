@@ -0,0 +1,259 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/Meikwei/go-tools/log"
+	"github.com/Meikwei/protocol/third"
+)
+
+// exportedMsg is one line of the JSON Lines export.
+type exportedMsg struct {
+	ConversationID string           `json:"conversationID"`
+	Seq            int64            `json:"seq"`
+	ClientMsgID    string           `json:"clientMsgID"`
+	ServerMsgID    string           `json:"serverMsgID"`
+	SendID         string           `json:"sendID"`
+	SenderName     string           `json:"senderName"`
+	RecvID         string           `json:"recvID,omitempty"`
+	GroupID        string           `json:"groupID,omitempty"`
+	SessionType    int32            `json:"sessionType"`
+	ContentType    int32            `json:"contentType"`
+	SendTime       int64            `json:"sendTime"`
+	Content        string           `json:"content"`
+	Text           string           `json:"text,omitempty"`
+	Media          []*exportedMedia `json:"media,omitempty"`
+}
+
+// exportedMedia is a URL referenced by a media message; AccessURL is a signed link when the URL points at the object storage.
+type exportedMedia struct {
+	URL       string `json:"url"`
+	AccessURL string `json:"accessURL"`
+}
+
+const exportHTMLHead = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Message export %s</title>
+<style>
+body{font-family:sans-serif;margin:24px;color:#222}
+table{border-collapse:collapse;width:100%%;margin-bottom:32px}
+td,th{border-bottom:1px solid #ddd;padding:6px 8px;text-align:left;vertical-align:top}
+td.time{white-space:nowrap;color:#666}
+td.content{white-space:pre-wrap;word-break:break-all}
+</style>
+</head>
+<body>
+<h1>Message export</h1>
+<p>User: %s<br>Time range: %s - %s (UTC)<br>Job: %s</p>
+`
+
+const exportHTMLTail = `</body>
+</html>
+`
+
+// msgExportWriter writes the JSON Lines file and the readable HTML page of an export job into temporary files.
+type msgExportWriter struct {
+	jsonl   *os.File
+	html    *os.File
+	jsonlW  *bufio.Writer
+	htmlW   *bufio.Writer
+	encoder *json.Encoder
+}
+
+func newMsgExportWriter(job *relation.MsgExportJobModel) (*msgExportWriter, error) {
+	jsonl, err := os.CreateTemp("", "msg_export_*.jsonl")
+	if err != nil {
+		return nil, errs.WrapMsg(err, "create export file failed")
+	}
+	htmlFile, err := os.CreateTemp("", "msg_export_*.html")
+	if err != nil {
+		_ = jsonl.Close()
+		_ = os.Remove(jsonl.Name())
+		return nil, errs.WrapMsg(err, "create export file failed")
+	}
+	w := &msgExportWriter{
+		jsonl:  jsonl,
+		html:   htmlFile,
+		jsonlW: bufio.NewWriter(jsonl),
+		htmlW:  bufio.NewWriter(htmlFile),
+	}
+	w.encoder = json.NewEncoder(w.jsonlW)
+	w.encoder.SetEscapeHTML(false)
+	_, err = fmt.Fprintf(w.htmlW, exportHTMLHead, html.EscapeString(job.JobID), html.EscapeString(job.UserID),
+		formatExportTime(job.StartTime), formatExportTime(job.EndTime), html.EscapeString(job.JobID))
+	if err != nil {
+		w.Remove()
+		return nil, errs.WrapMsg(err, "write export file failed")
+	}
+	return w, nil
+}
+
+func (w *msgExportWriter) BeginConversation(conversationID string) error {
+	_, err := fmt.Fprintf(w.htmlW, "<h2>%s</h2>\n<table>\n<tr><th>Time</th><th>Sender</th><th>Message</th></tr>\n", html.EscapeString(conversationID))
+	return errs.Wrap(err)
+}
+
+func (w *msgExportWriter) EndConversation() error {
+	_, err := io.WriteString(w.htmlW, "</table>\n")
+	return errs.Wrap(err)
+}
+
+func (w *msgExportWriter) Write(msg *exportedMsg) error {
+	if err := w.encoder.Encode(msg); err != nil {
+		return errs.WrapMsg(err, "write export file failed")
+	}
+	var content bytes.Buffer
+	switch {
+	case msg.Text != "":
+		content.WriteString(html.EscapeString(msg.Text))
+	case len(msg.Media) > 0:
+		for i, media := range msg.Media {
+			if i > 0 {
+				content.WriteString("<br>")
+			}
+			fmt.Fprintf(&content, `<a href="%s">%s</a>`, html.EscapeString(media.AccessURL), html.EscapeString(path.Base(media.URL)))
+		}
+	default:
+		fmt.Fprintf(&content, "[%d] %s", msg.ContentType, html.EscapeString(msg.Content))
+	}
+	_, err := fmt.Fprintf(w.htmlW, "<tr><td class=\"time\">%s</td><td>%s<br><small>%s</small></td><td class=\"content\">%s</td></tr>\n",
+		formatExportTime(msg.SendTime), html.EscapeString(msg.SenderName), html.EscapeString(msg.SendID), content.String())
+	return errs.Wrap(err)
+}
+
+// Close flushes both files and rewinds them for uploading.
+func (w *msgExportWriter) Close() error {
+	if _, err := io.WriteString(w.htmlW, exportHTMLTail); err != nil {
+		return errs.Wrap(err)
+	}
+	for _, f := range []struct {
+		w    *bufio.Writer
+		file *os.File
+	}{{w.jsonlW, w.jsonl}, {w.htmlW, w.html}} {
+		if err := f.w.Flush(); err != nil {
+			return errs.WrapMsg(err, "flush export file failed")
+		}
+		if _, err := f.file.Seek(0, io.SeekStart); err != nil {
+			return errs.WrapMsg(err, "seek export file failed")
+		}
+	}
+	return nil
+}
+
+// Remove closes and deletes the temporary files.
+func (w *msgExportWriter) Remove() {
+	for _, file := range []*os.File{w.jsonl, w.html} {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+	}
+}
+
+func formatExportTime(millis int64) string {
+	if millis <= 0 {
+		return "-"
+	}
+	return time.UnixMilli(millis).UTC().Format("2006-01-02 15:04:05")
+}
+
+// uploadExportFile uploads a file through the third service's form data upload.
+func (m *msgServer) uploadExportFile(ctx context.Context, name string, contentType string, file *os.File) error {
+	info, err := file.Stat()
+	if err != nil {
+		return errs.WrapMsg(err, "stat export file failed")
+	}
+	resp, err := m.Third.Client.InitiateFormData(ctx, &third.InitiateFormDataReq{
+		Name:        name,
+		Size:        info.Size(),
+		ContentType: contentType,
+		Group:       exportObjectGroup,
+	})
+	if err != nil {
+		return err
+	}
+	// The object storage needs the content length, so the multipart body is assembled around the file
+	// instead of being buffered: head (form fields and the file part header), file, tail (closing boundary).
+	var head, tail bytes.Buffer
+	mw := multipart.NewWriter(&head)
+	for key, value := range resp.FormData {
+		if err := mw.WriteField(key, value); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	if _, err := mw.CreateFormFile(resp.File, path.Base(name)); err != nil {
+		return errs.Wrap(err)
+	}
+	tw := multipart.NewWriter(&tail)
+	if err := tw.SetBoundary(mw.Boundary()); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := tw.Close(); err != nil {
+		return errs.Wrap(err)
+	}
+	body := io.MultiReader(&head, file, &tail)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, resp.Url, body)
+	if err != nil {
+		return errs.WrapMsg(err, "new upload request failed")
+	}
+	req.ContentLength = int64(head.Len()) + info.Size() + int64(tail.Len())
+	for _, kv := range resp.Header {
+		for _, value := range kv.Values {
+			req.Header.Add(kv.Key, value)
+		}
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	httpResp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errs.WrapMsg(err, "upload export file failed", "name", name)
+	}
+	defer httpResp.Body.Close()
+	if !uploadSucceeded(httpResp.StatusCode, resp.SuccessCodes) {
+		data, _ := io.ReadAll(io.LimitReader(httpResp.Body, 1024))
+		return errs.New("upload export file failed", "name", name, "status", httpResp.StatusCode, "body", string(data)).Wrap()
+	}
+	if _, err := m.Third.Client.CompleteFormData(ctx, &third.CompleteFormDataReq{Id: resp.Id}); err != nil {
+		return err
+	}
+	log.ZDebug(ctx, "export file uploaded", "name", name, "size", info.Size())
+	return nil
+}
+
+func uploadSucceeded(statusCode int, successCodes []int32) bool {
+	if len(successCodes) == 0 {
+		return statusCode/100 == 2
+	}
+	for _, code := range successCodes {
+		if int(code) == statusCode {
+			return true
+		}
+	}
+	return false
+}
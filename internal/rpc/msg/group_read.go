@@ -0,0 +1,141 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	cbapi "github.com/Meikwei/aetim/pkg/callbackstruct"
+	"github.com/Meikwei/aetim/pkg/protocol/msgext"
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/Meikwei/go-tools/log"
+	"github.com/Meikwei/go-tools/utils/datautil"
+	"github.com/Meikwei/protocol/constant"
+	"github.com/Meikwei/protocol/conversation"
+)
+
+// defaultReadReceiptListCount is used when msg.readReceipt.maxListCount is not configured.
+const defaultReadReceiptListCount = 100
+
+func (m *msgServer) GetGroupMsgReaders(ctx context.Context, req *msgext.GetGroupMsgReadersReq) (*msgext.GetGroupMsgReadersResp, error) {
+	msgData, err := m.getMemberMsg(ctx, req.UserID, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	if msgData.SessionType != constant.ReadGroupChatType {
+		return nil, errs.ErrArgs.WrapMsg("read receipts are only for group msgs")
+	}
+	memberIDs, err := m.GroupLocalCache.GetGroupMemberIDs(ctx, msgData.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	// 已退群的成员和发送者不计入
+	userIDs := datautil.Filter(memberIDs, func(userID string) (string, bool) { return userID, userID != msgData.SendID })
+	limit := m.config.RpcConfig.ReadReceipt.MaxListCount
+	if limit <= 0 {
+		limit = defaultReadReceiptListCount
+	}
+	readCount, err := m.ReadReceiptDatabase.CountGroupMsgReaders(ctx, req.ConversationID, req.Seq, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	readers, err := m.ReadReceiptDatabase.GetGroupMsgReaders(ctx, req.ConversationID, req.Seq, userIDs, limit)
+	if err != nil {
+		return nil, err
+	}
+	unreadUserIDs, err := m.ReadReceiptDatabase.GetGroupMsgUnreadUserIDs(ctx, req.ConversationID, req.Seq, userIDs, limit)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msgext.GetGroupMsgReadersResp{
+		ReadCount:     readCount,
+		UnreadCount:   int64(len(userIDs)) - readCount,
+		Readers:       make([]*msgext.GroupMsgReader, 0, len(readers)),
+		UnreadUserIDs: unreadUserIDs,
+	}
+	for _, reader := range readers {
+		resp.Readers = append(resp.Readers, &msgext.GroupMsgReader{UserID: reader.UserID, ReadTime: reader.ReadTime.UnixMilli()})
+	}
+	return resp, nil
+}
+
+// markGroupMsgsAsRead records the read position of a group member. When the position moves forward,
+// the group is told incrementally and the AfterGroupMsgRead webhook receives the aggregated read count.
+func (m *msgServer) markGroupMsgsAsRead(ctx context.Context, conversation *conversation.Conversation, userID string, hasReadSeq int64) error {
+	prevHasReadSeq, err := m.ReadReceiptDatabase.MarkGroupMsgsAsRead(ctx, conversation.ConversationID, userID, hasReadSeq)
+	if err != nil {
+		return err
+	}
+	if hasReadSeq <= prevHasReadSeq {
+		return nil
+	}
+	memberIDs, err := m.GroupLocalCache.GetGroupMemberIDs(ctx, conversation.GroupID)
+	if err != nil {
+		log.ZWarn(ctx, "get group member ids failed", err, "groupID", conversation.GroupID)
+		return nil
+	}
+	now := time.Now()
+	if limit := m.config.RpcConfig.ReadReceipt.NotifyMemberLimit; limit <= 0 || len(memberIDs) <= limit {
+		tips := msgext.GroupMsgReadTips{
+			ConversationID: conversation.ConversationID,
+			GroupID:        conversation.GroupID,
+			UserID:         userID,
+			PrevHasReadSeq: prevHasReadSeq,
+			HasReadSeq:     hasReadSeq,
+			ReadTime:       now.UnixMilli(),
+		}
+		m.notificationSender.NotificationWithSessionType(ctx, userID, conversation.GroupID, msgext.GroupMsgReadNotification, constant.ReadGroupChatType, &tips)
+	}
+	if !m.config.WebhooksConfig.AfterGroupMsgRead.Enable {
+		return nil
+	}
+	callbackReq := &cbapi.CallbackGroupMsgReadReq{
+		SendID:         conversation.OwnerUserID,
+		ReceiveID:      userID,
+		ContentType:    int64(conversation.ConversationType),
+		ConversationID: conversation.ConversationID,
+		GroupID:        conversation.GroupID,
+		PrevHasReadSeq: prevHasReadSeq,
+		HasReadSeq:     hasReadSeq,
+		MemberCount:    int64(len(memberIDs)),
+	}
+	if maxSeq, err := m.MsgDatabase.GetMaxSeq(ctx, conversation.ConversationID); err != nil {
+		log.ZWarn(ctx, "get max seq failed", err, "conversationID", conversation.ConversationID)
+	} else if maxSeq > hasReadSeq {
+		callbackReq.UnreadMsgNum = maxSeq - hasReadSeq
+	}
+	if readCount, err := m.countGroupMsgReaders(ctx, conversation.ConversationID, hasReadSeq, memberIDs); err != nil {
+		log.ZWarn(ctx, "count group msg readers failed", err, "conversationID", conversation.ConversationID, "seq", hasReadSeq)
+	} else {
+		callbackReq.ReadCount = readCount
+	}
+	m.webhookAfterGroupMsgRead(ctx, &m.config.WebhooksConfig.AfterGroupMsgRead, callbackReq)
+	return nil
+}
+
+// countGroupMsgReaders counts the current members other than the sender who have read the msg seq.
+func (m *msgServer) countGroupMsgReaders(ctx context.Context, conversationID string, seq int64, memberIDs []string) (int64, error) {
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, "", conversationID, []int64{seq})
+	if err != nil {
+		return 0, err
+	}
+	var sendID string
+	if len(msgs) > 0 && msgs[0] != nil {
+		sendID = msgs[0].SendID
+	}
+	userIDs := datautil.Filter(memberIDs, func(userID string) (string, bool) { return userID, userID != sendID })
+	return m.ReadReceiptDatabase.CountGroupMsgReaders(ctx, conversationID, seq, userIDs)
+}
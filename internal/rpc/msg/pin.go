@@ -0,0 +1,185 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/authverify"
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/aetim/pkg/common/servererrs"
+	"github.com/Meikwei/aetim/pkg/protocol/msgext"
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/Meikwei/go-tools/log"
+	"github.com/Meikwei/protocol/constant"
+	"github.com/Meikwei/protocol/sdkws"
+)
+
+// defaultMaxPinned is used when msg.pin.maxPinned is not configured.
+const defaultMaxPinned = 20
+
+func (m *msgServer) PinMsg(ctx context.Context, req *msgext.PinMsgReq) (*msgext.PinMsgResp, error) {
+	msgData, err := m.getMemberMsg(ctx, req.UserID, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.checkPinOperator(ctx, req.UserID, msgData); err != nil {
+		return nil, err
+	}
+	limit := m.config.RpcConfig.Pin.MaxPinned
+	if limit <= 0 {
+		limit = defaultMaxPinned
+	}
+	pin := &relation.PinnedMsgModel{
+		Seq:         msgData.Seq,
+		ClientMsgID: msgData.ClientMsgID,
+		PinUserID:   req.UserID,
+		PinTime:     time.Now(),
+	}
+	changed, err := m.PinnedMsgDatabase.PinMsg(ctx, req.ConversationID, pin, limit)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, servererrs.ErrMsgPinLimit.WrapMsg("too many pinned msgs", "limit", limit)
+		}
+		return nil, err
+	}
+	if changed {
+		m.sendPinNotification(ctx, req.UserID, req.ConversationID, msgData, msgext.MsgPin)
+	}
+	return &msgext.PinMsgResp{}, nil
+}
+
+func (m *msgServer) UnpinMsg(ctx context.Context, req *msgext.UnpinMsgReq) (*msgext.UnpinMsgResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, req.UserID, req.ConversationID, []int64{req.Seq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || msgs[0] == nil {
+		return nil, errs.ErrRecordNotFound.WrapMsg("msg not found")
+	}
+	// 已撤回的消息仍然可以取消置顶
+	msgData := msgs[0]
+	if err := m.checkConversationMember(ctx, req.UserID, msgData.SessionType, msgData.SendID, msgData.RecvID, msgData.GroupID); err != nil {
+		return nil, err
+	}
+	if err := m.checkPinOperator(ctx, req.UserID, msgData); err != nil {
+		return nil, err
+	}
+	if err := m.PinnedMsgDatabase.UnpinMsg(ctx, req.ConversationID, req.Seq); err != nil {
+		if IsNotFound(err) {
+			return nil, errs.ErrRecordNotFound.WrapMsg("msg not pinned")
+		}
+		return nil, err
+	}
+	m.sendPinNotification(ctx, req.UserID, req.ConversationID, msgData, msgext.MsgUnpin)
+	return &msgext.UnpinMsgResp{}, nil
+}
+
+func (m *msgServer) GetPinnedMsgs(ctx context.Context, req *msgext.GetPinnedMsgsReq) (*msgext.GetPinnedMsgsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if err := m.checkConversationIDMember(ctx, req.UserID, req.ConversationID); err != nil {
+		return nil, err
+	}
+	pins, err := m.PinnedMsgDatabase.FindPinnedMsgs(ctx, req.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	if len(pins) == 0 {
+		return &msgext.GetPinnedMsgsResp{}, nil
+	}
+	seqs := make([]int64, 0, len(pins))
+	for _, pin := range pins {
+		seqs = append(seqs, pin.Seq)
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, req.UserID, req.ConversationID, seqs)
+	if err != nil {
+		return nil, err
+	}
+	msgMap := make(map[int64]*sdkws.MsgData, len(msgs))
+	for _, msgData := range msgs {
+		if msgData != nil && msgData.Seq > 0 {
+			msgMap[msgData.Seq] = msgData
+		}
+	}
+	resp := &msgext.GetPinnedMsgsResp{PinnedMsgs: make([]*msgext.PinnedMsg, 0, len(pins))}
+	for _, pin := range pins {
+		resp.PinnedMsgs = append(resp.PinnedMsgs, &msgext.PinnedMsg{
+			Seq:         pin.Seq,
+			ClientMsgID: pin.ClientMsgID,
+			PinUserID:   pin.PinUserID,
+			PinTime:     pin.PinTime.UnixMilli(),
+			MsgData:     msgMap[pin.Seq],
+		})
+	}
+	return resp, nil
+}
+
+// checkPinOperator checks that the user may change the pins of the conversation: any party of a single chat,
+// or the owner or an admin of the group.
+func (m *msgServer) checkPinOperator(ctx context.Context, userID string, msgData *sdkws.MsgData) error {
+	if authverify.IsAppManagerUid(ctx, m.config.Share.IMAdminUserID) {
+		return nil
+	}
+	if msgData.SessionType != constant.ReadGroupChatType {
+		return nil
+	}
+	member, err := m.GroupLocalCache.GetGroupMember(ctx, msgData.GroupID, userID)
+	if err != nil {
+		return err
+	}
+	switch member.RoleLevel {
+	case constant.GroupOwner, constant.GroupAdmin:
+		return nil
+	default:
+		return errs.ErrNoPermission.WrapMsg("only the group owner or admins can pin msgs")
+	}
+}
+
+// sendPinNotification tells the conversation members about a pin change together with the current pins,
+// so that clients can rebuild their pin banner from a single notification.
+func (m *msgServer) sendPinNotification(ctx context.Context, opUserID, conversationID string, msgData *sdkws.MsgData, operation int32) {
+	tips := msgext.MsgPinTips{
+		OpUserID:       opUserID,
+		ConversationID: conversationID,
+		SessionType:    msgData.SessionType,
+		Seq:            msgData.Seq,
+		ClientMsgID:    msgData.ClientMsgID,
+		Operation:      operation,
+		OperateTime:    time.Now().UnixMilli(),
+	}
+	pins, err := m.PinnedMsgDatabase.FindPinnedMsgs(ctx, conversationID)
+	if err != nil {
+		log.ZWarn(ctx, "find pinned msgs failed", err, "conversationID", conversationID)
+	}
+	for _, pin := range pins {
+		tips.PinnedSeqs = append(tips.PinnedSeqs, pin.Seq)
+	}
+	var recvID string
+	switch {
+	case msgData.SessionType == constant.ReadGroupChatType:
+		recvID = msgData.GroupID
+	case opUserID == msgData.SendID:
+		recvID = msgData.RecvID
+	default:
+		recvID = msgData.SendID
+	}
+	m.notificationSender.NotificationWithSessionType(ctx, opUserID, recvID, msgext.MsgPinNotification, msgData.SessionType, &tips)
+}
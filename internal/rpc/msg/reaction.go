@@ -0,0 +1,187 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/authverify"
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/aetim/pkg/common/servererrs"
+	"github.com/Meikwei/aetim/pkg/protocol/msgext"
+	"github.com/Meikwei/aetim/pkg/util/conversationutil"
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/Meikwei/protocol/constant"
+	"github.com/Meikwei/protocol/sdkws"
+)
+
+func (m *msgServer) AddMsgReaction(ctx context.Context, req *msgext.AddMsgReactionReq) (*msgext.AddMsgReactionResp, error) {
+	msgData, err := m.getMemberMsg(ctx, req.UserID, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	reaction := &relation.ReactionModel{UserID: req.UserID, Emoji: req.Emoji}
+	changed, reactions, err := m.MsgDatabase.AddMsgReaction(ctx, req.ConversationID, msgData, reaction)
+	if err != nil {
+		return nil, err
+	}
+	if changed {
+		m.sendReactionNotification(ctx, req.ConversationID, msgData, reaction, msgext.ReactionAdd, reactions)
+	}
+	return &msgext.AddMsgReactionResp{Reactions: convertMsgReactions(reactions)}, nil
+}
+
+func (m *msgServer) RemoveMsgReaction(ctx context.Context, req *msgext.RemoveMsgReactionReq) (*msgext.RemoveMsgReactionResp, error) {
+	msgData, err := m.getMemberMsg(ctx, req.UserID, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	reaction := &relation.ReactionModel{UserID: req.UserID, Emoji: req.Emoji}
+	changed, reactions, err := m.MsgDatabase.RemoveMsgReaction(ctx, req.ConversationID, msgData, reaction)
+	if err != nil {
+		return nil, err
+	}
+	if changed {
+		m.sendReactionNotification(ctx, req.ConversationID, msgData, reaction, msgext.ReactionRemove, reactions)
+	}
+	return &msgext.RemoveMsgReactionResp{Reactions: convertMsgReactions(reactions)}, nil
+}
+
+func (m *msgServer) GetMsgReactions(ctx context.Context, req *msgext.GetMsgReactionsReq) (*msgext.GetMsgReactionsResp, error) {
+	if _, err := m.getMemberMsg(ctx, req.UserID, req.ConversationID, req.Seq); err != nil {
+		return nil, err
+	}
+	reactions, err := m.MsgDatabase.GetMsgReactions(ctx, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.GetMsgReactionsResp{Reactions: convertMsgReactions(reactions)}, nil
+}
+
+// getMemberMsg loads the message after checking that the user is a member of its conversation.
+func (m *msgServer) getMemberMsg(ctx context.Context, userID, conversationID string, seq int64) (*sdkws.MsgData, error) {
+	if err := authverify.CheckAccessV3(ctx, userID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, userID, conversationID, []int64{seq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || msgs[0] == nil {
+		return nil, errs.ErrRecordNotFound.WrapMsg("msg not found")
+	}
+	msgData := msgs[0]
+	if msgData.ContentType == constant.MsgRevokeNotification {
+		return nil, servererrs.ErrMsgAlreadyRevoke.WrapMsg("msg already revoke")
+	}
+	if err := m.checkConversationMember(ctx, userID, msgData.SessionType, msgData.SendID, msgData.RecvID, msgData.GroupID); err != nil {
+		return nil, err
+	}
+	return msgData, nil
+}
+
+// checkConversationMember checks that the user is a party of the single chat between sendID and recvID,
+// or a member of the group.
+func (m *msgServer) checkConversationMember(ctx context.Context, userID string, sessionType int32, sendID, recvID, groupID string) error {
+	switch sessionType {
+	case constant.SingleChatType:
+		if userID != sendID && userID != recvID {
+			return errs.ErrNoPermission.WrapMsg("not a member of the conversation")
+		}
+	case constant.ReadGroupChatType:
+		memberIDs, err := m.GroupLocalCache.GetGroupMemberIDMap(ctx, groupID)
+		if err != nil {
+			return err
+		}
+		if _, ok := memberIDs[userID]; !ok {
+			return errs.ErrNoPermission.WrapMsg("not a member of the group")
+		}
+	default:
+		return errs.ErrArgs.WrapMsg("msg sessionType not supported")
+	}
+	return nil
+}
+
+// checkConversationIDMember checks that the user is a party of the single chat or a member of the group
+// the conversation ID refers to, for requests that may not load any message of the conversation.
+func (m *msgServer) checkConversationIDMember(ctx context.Context, userID string, conversationID string) error {
+	if groupID, ok := conversationutil.GetGroupIDByConversationID(conversationID); ok {
+		return m.checkConversationMember(ctx, userID, constant.ReadGroupChatType, "", "", groupID)
+	}
+	if ids, ok := strings.CutPrefix(conversationID, "si_"); ok {
+		var peerID string
+		if id, ok := strings.CutPrefix(ids, userID+"_"); ok {
+			peerID = id
+		} else if id, ok := strings.CutSuffix(ids, "_"+userID); ok {
+			peerID = id
+		}
+		if peerID == "" || conversationutil.GenConversationIDForSingle(userID, peerID) != conversationID {
+			return errs.ErrNoPermission.WrapMsg("not a member of the conversation")
+		}
+		return nil
+	}
+	return errs.ErrArgs.WrapMsg("conversation type not supported", "conversationID", conversationID)
+}
+
+// sendReactionNotification tells the conversation members about one added or removed reaction.
+func (m *msgServer) sendReactionNotification(ctx context.Context, conversationID string, msgData *sdkws.MsgData,
+	reaction *relation.ReactionModel, operation int32, reactions []*relation.ReactionModel) {
+	var count int64
+	for _, r := range reactions {
+		if r.Emoji == reaction.Emoji {
+			count++
+		}
+	}
+	tips := msgext.MsgReactionTips{
+		OpUserID:       reaction.UserID,
+		ConversationID: conversationID,
+		Seq:            msgData.Seq,
+		ClientMsgID:    msgData.ClientMsgID,
+		SessionType:    msgData.SessionType,
+		Emoji:          reaction.Emoji,
+		Operation:      operation,
+		Count:          count,
+		OperateTime:    time.Now().UnixMilli(),
+	}
+	var recvID string
+	switch {
+	case msgData.SessionType == constant.ReadGroupChatType:
+		recvID = msgData.GroupID
+	case reaction.UserID == msgData.SendID:
+		recvID = msgData.RecvID
+	default:
+		recvID = msgData.SendID
+	}
+	m.notificationSender.NotificationWithSessionType(ctx, reaction.UserID, recvID, msgext.MsgReactionNotification, msgData.SessionType, &tips)
+}
+
+// convertMsgReactions groups reactions by emoji in the order each emoji was first used.
+func convertMsgReactions(reactions []*relation.ReactionModel) []*msgext.MsgReaction {
+	res := make([]*msgext.MsgReaction, 0)
+	index := make(map[string]int)
+	for _, reaction := range reactions {
+		i, ok := index[reaction.Emoji]
+		if !ok {
+			i = len(res)
+			index[reaction.Emoji] = i
+			res = append(res, &msgext.MsgReaction{Emoji: reaction.Emoji})
+		}
+		res[i].Count++
+		res[i].UserIDs = append(res[i].UserIDs, reaction.UserID)
+	}
+	return res
+}
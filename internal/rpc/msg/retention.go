@@ -0,0 +1,99 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/authverify"
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/aetim/pkg/common/servererrs"
+	"github.com/Meikwei/aetim/pkg/protocol/msgext"
+	"github.com/Meikwei/aetim/pkg/util/conversationutil"
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/Meikwei/go-tools/log"
+	"github.com/Meikwei/go-tools/mcontext"
+)
+
+func (m *msgServer) SetRetentionPolicy(ctx context.Context, req *msgext.SetRetentionPolicyReq) (*msgext.SetRetentionPolicyResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	var retention *relation.RetentionModel
+	if req.Policy.Mode != msgext.RetentionInherit {
+		retention = &relation.RetentionModel{
+			Mode:           req.Policy.Mode,
+			Days:           req.Policy.Days,
+			Count:          req.Policy.Count,
+			OperatorUserID: mcontext.GetOpUserID(ctx),
+			UpdateTime:     time.Now(),
+		}
+	}
+	if req.GroupID != "" {
+		if err := m.RetentionDatabase.SetGroupRetention(ctx, req.GroupID, retention); err != nil {
+			if IsNotFound(err) {
+				return nil, servererrs.ErrGroupIDNotFound.WrapMsg("group not found", "groupID", req.GroupID)
+			}
+			return nil, err
+		}
+	} else {
+		if err := m.RetentionDatabase.SetConversationRetention(ctx, req.ConversationID, retention); err != nil {
+			if IsNotFound(err) {
+				return nil, errs.ErrRecordNotFound.WrapMsg("conversation not found", "conversationID", req.ConversationID)
+			}
+			return nil, err
+		}
+	}
+	log.ZInfo(ctx, "SetRetentionPolicy", "conversationID", req.ConversationID, "groupID", req.GroupID,
+		"mode", req.Policy.Mode, "days", req.Policy.Days, "count", req.Policy.Count)
+	return &msgext.SetRetentionPolicyResp{}, nil
+}
+
+func (m *msgServer) GetRetentionPolicy(ctx context.Context, req *msgext.GetRetentionPolicyReq) (*msgext.GetRetentionPolicyResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	conversationID := req.ConversationID
+	if req.GroupID != "" {
+		conversationID = conversationutil.GenGroupConversationID(req.GroupID)
+	}
+	conversation, group, effective, err := m.RetentionDatabase.GetRetention(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.GetRetentionPolicyResp{
+		Conversation: convertRetention(conversation),
+		Group:        convertRetention(group),
+		Effective:    convertRetention(effective.Retention),
+		Source:       effective.Source,
+	}, nil
+}
+
+func convertRetention(retention *relation.RetentionModel) *msgext.RetentionPolicy {
+	if retention == nil {
+		return nil
+	}
+	policy := &msgext.RetentionPolicy{
+		Mode:           retention.Mode,
+		Days:           retention.Days,
+		Count:          retention.Count,
+		OperatorUserID: retention.OperatorUserID,
+	}
+	if !retention.UpdateTime.IsZero() {
+		policy.UpdateTime = retention.UpdateTime.UnixMilli()
+	}
+	return policy
+}
@@ -61,36 +61,9 @@ func (m *msgServer) RevokeMsg(ctx context.Context, req *msg.RevokeMsgReq) (*msg.
 
 	data, _ := json.Marshal(msgs[0])
 	log.ZDebug(ctx, "GetMsgBySeqs", "conversationID", req.ConversationID, "seq", req.Seq, "msg", string(data))
-	var role int32
-	if !authverify.IsAppManagerUid(ctx, m.config.Share.IMAdminUserID) {
-		switch msgs[0].SessionType {
-		case constant.SingleChatType:
-			if err := authverify.CheckAccessV3(ctx, msgs[0].SendID, m.config.Share.IMAdminUserID); err != nil {
-				return nil, err
-			}
-			role = user.AppMangerLevel
-		case constant.ReadGroupChatType:
-			members, err := m.GroupLocalCache.GetGroupMemberInfoMap(ctx, msgs[0].GroupID, datautil.Distinct([]string{req.UserID, msgs[0].SendID}))
-			if err != nil {
-				return nil, err
-			}
-			if req.UserID != msgs[0].SendID {
-				switch members[req.UserID].RoleLevel {
-				case constant.GroupOwner:
-				case constant.GroupAdmin:
-					if members[msgs[0].SendID].RoleLevel != constant.GroupOrdinaryUsers {
-						return nil, errs.ErrNoPermission.WrapMsg("no permission")
-					}
-				default:
-					return nil, errs.ErrNoPermission.WrapMsg("no permission")
-				}
-			}
-			if member := members[req.UserID]; member != nil {
-				role = member.RoleLevel
-			}
-		default:
-			return nil, errs.ErrInternalServer.WrapMsg("msg sessionType not supported")
-		}
+	role, err := m.checkMsgOperator(ctx, user, msgs[0])
+	if err != nil {
+		return nil, err
 	}
 	now := time.Now().UnixMilli()
 	err = m.MsgDatabase.RevokeMsg(ctx, req.ConversationID, req.Seq, &relation.RevokeModel{
@@ -102,6 +75,9 @@ func (m *msgServer) RevokeMsg(ctx context.Context, req *msg.RevokeMsgReq) (*msg.
 	if err != nil {
 		return nil, err
 	}
+	if err := m.MsgSearchDatabase.DeleteMsgs(ctx, req.ConversationID, []int64{req.Seq}); err != nil {
+		log.ZWarn(ctx, "delete revoked msg from search index failed", err, "conversationID", req.ConversationID, "seq", req.Seq)
+	}
 	revokerUserID := mcontext.GetOpUserID(ctx)
 	var flag bool
 
@@ -127,3 +103,40 @@ func (m *msgServer) RevokeMsg(ctx context.Context, req *msg.RevokeMsgReq) (*msg.
 	m.webhookAfterRevokeMsg(ctx, &m.config.WebhooksConfig.AfterRevokeMsg, req)
 	return &msg.RevokeMsgResp{}, nil
 }
+
+// checkMsgOperator checks that the user may revoke or edit the message: the sender, the owner of the
+// group, or an admin of the group for messages of ordinary members. It returns the role of the user.
+func (m *msgServer) checkMsgOperator(ctx context.Context, user *sdkws.UserInfo, msgData *sdkws.MsgData) (int32, error) {
+	if authverify.IsAppManagerUid(ctx, m.config.Share.IMAdminUserID) {
+		return 0, nil
+	}
+	switch msgData.SessionType {
+	case constant.SingleChatType:
+		if err := authverify.CheckAccessV3(ctx, msgData.SendID, m.config.Share.IMAdminUserID); err != nil {
+			return 0, err
+		}
+		return user.AppMangerLevel, nil
+	case constant.ReadGroupChatType:
+		members, err := m.GroupLocalCache.GetGroupMemberInfoMap(ctx, msgData.GroupID, datautil.Distinct([]string{user.UserID, msgData.SendID}))
+		if err != nil {
+			return 0, err
+		}
+		if user.UserID != msgData.SendID {
+			switch members[user.UserID].RoleLevel {
+			case constant.GroupOwner:
+			case constant.GroupAdmin:
+				if members[msgData.SendID].RoleLevel != constant.GroupOrdinaryUsers {
+					return 0, errs.ErrNoPermission.WrapMsg("no permission")
+				}
+			default:
+				return 0, errs.ErrNoPermission.WrapMsg("no permission")
+			}
+		}
+		if member := members[user.UserID]; member != nil {
+			return member.RoleLevel, nil
+		}
+		return 0, nil
+	default:
+		return 0, errs.ErrInternalServer.WrapMsg("msg sessionType not supported")
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/authverify"
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/aetim/pkg/protocol/msgext"
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/Meikwei/go-tools/utils/datautil"
+	"github.com/Meikwei/protocol/constant"
+	pbmsg "github.com/Meikwei/protocol/msg"
+	"github.com/Meikwei/protocol/sdkws"
+	"google.golang.org/protobuf/proto"
+)
+
+// ScheduleMsg stores a message to be sent later by the crontask dispatcher.
+// The message is verified now so obvious failures surface to the caller, and
+// verified again by SendMsg when it is actually dispatched.
+func (m *msgServer) ScheduleMsg(ctx context.Context, req *msgext.ScheduleMsgReq) (*msgext.ScheduleMsgResp, error) {
+	msgData := req.MsgData
+	if err := authverify.CheckAccessV3(ctx, msgData.SendID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if !datautil.Contain(msgData.SessionType, constant.SingleChatType, constant.ReadGroupChatType) {
+		return nil, errs.ErrArgs.WrapMsg("sessionType not support schedule", "sessionType", msgData.SessionType)
+	}
+	now := time.Now()
+	if req.SendTime <= now.UnixMilli() {
+		return nil, errs.ErrArgs.WrapMsg("sendTime must be in the future", "sendTime", req.SendTime)
+	}
+	if err := m.messageVerification(ctx, &pbmsg.SendMsgReq{MsgData: msgData}); err != nil {
+		return nil, err
+	}
+	// 发送时间和服务端ID在真正发送时由SendMsg生成
+	msgData.SendTime = 0
+	msgData.ServerMsgID = ""
+	if msgData.ClientMsgID == "" {
+		msgData.ClientMsgID = GetMsgID(msgData.SendID)
+	}
+	data, err := proto.Marshal(msgData)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "marshal msgData failed")
+	}
+	model := &relation.ScheduledMsgModel{
+		ScheduleID:  GetMsgID(msgData.SendID),
+		SendID:      msgData.SendID,
+		RecvID:      msgData.RecvID,
+		GroupID:     msgData.GroupID,
+		SessionType: msgData.SessionType,
+		ClientMsgID: msgData.ClientMsgID,
+		MsgData:     data,
+		SendTime:    time.UnixMilli(req.SendTime),
+		Status:      relation.ScheduledMsgPending,
+		CreateTime:  now,
+	}
+	if err := m.ScheduledMsgDatabase.CreateScheduledMsg(ctx, model); err != nil {
+		return nil, err
+	}
+	return &msgext.ScheduleMsgResp{ScheduleID: model.ScheduleID}, nil
+}
+
+func (m *msgServer) GetScheduledMsgs(ctx context.Context, req *msgext.GetScheduledMsgsReq) (*msgext.GetScheduledMsgsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	total, models, err := m.ScheduledMsgDatabase.FindScheduledMsgs(ctx, req.UserID, req.Status, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msgext.GetScheduledMsgsResp{Total: total, Msgs: make([]*msgext.ScheduledMsg, 0, len(models))}
+	for _, model := range models {
+		msgData := &sdkws.MsgData{}
+		if err := proto.Unmarshal(model.MsgData, msgData); err != nil {
+			return nil, errs.WrapMsg(err, "unmarshal msgData failed", "scheduleID", model.ScheduleID)
+		}
+		resp.Msgs = append(resp.Msgs, convertScheduledMsg(model, msgData))
+	}
+	return resp, nil
+}
+
+func (m *msgServer) CancelScheduledMsg(ctx context.Context, req *msgext.CancelScheduledMsgReq) (*msgext.CancelScheduledMsgResp, error) {
+	if _, err := m.takeScheduledMsg(ctx, req.ScheduleID); err != nil {
+		return nil, err
+	}
+	if err := m.ScheduledMsgDatabase.CancelScheduledMsg(ctx, req.ScheduleID); err != nil {
+		if IsNotFound(err) {
+			return nil, errs.ErrArgs.WrapMsg("scheduled msg is not pending", "scheduleID", req.ScheduleID)
+		}
+		return nil, err
+	}
+	return &msgext.CancelScheduledMsgResp{}, nil
+}
+
+func (m *msgServer) RescheduleMsg(ctx context.Context, req *msgext.RescheduleMsgReq) (*msgext.RescheduleMsgResp, error) {
+	if req.SendTime <= time.Now().UnixMilli() {
+		return nil, errs.ErrArgs.WrapMsg("sendTime must be in the future", "sendTime", req.SendTime)
+	}
+	if _, err := m.takeScheduledMsg(ctx, req.ScheduleID); err != nil {
+		return nil, err
+	}
+	if err := m.ScheduledMsgDatabase.RescheduleMsg(ctx, req.ScheduleID, time.UnixMilli(req.SendTime)); err != nil {
+		if IsNotFound(err) {
+			return nil, errs.ErrArgs.WrapMsg("scheduled msg is not pending", "scheduleID", req.ScheduleID)
+		}
+		return nil, err
+	}
+	return &msgext.RescheduleMsgResp{}, nil
+}
+
+// takeScheduledMsg loads a scheduled message after checking that the op user is its sender or an admin.
+func (m *msgServer) takeScheduledMsg(ctx context.Context, scheduleID string) (*relation.ScheduledMsgModel, error) {
+	model, err := m.ScheduledMsgDatabase.TakeScheduledMsg(ctx, scheduleID)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, errs.ErrRecordNotFound.WrapMsg("scheduled msg not found", "scheduleID", scheduleID)
+		}
+		return nil, err
+	}
+	if err := authverify.CheckAccessV3(ctx, model.SendID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+func convertScheduledMsg(model *relation.ScheduledMsgModel, msgData *sdkws.MsgData) *msgext.ScheduledMsg {
+	res := &msgext.ScheduledMsg{
+		ScheduleID: model.ScheduleID,
+		MsgData:    msgData,
+		SendTime:   model.SendTime.UnixMilli(),
+		Status:     model.Status,
+		Error:      model.Error,
+		CreateTime: model.CreateTime.UnixMilli(),
+	}
+	if !model.DispatchTime.IsZero() {
+		res.DispatchTime = model.DispatchTime.UnixMilli()
+	}
+	return res
+}
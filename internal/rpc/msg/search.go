@@ -0,0 +1,115 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+
+	"github.com/Meikwei/aetim/pkg/authverify"
+	"github.com/Meikwei/aetim/pkg/msgprocessor"
+	"github.com/Meikwei/aetim/pkg/protocol/msgext"
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/Meikwei/go-tools/log"
+	"github.com/Meikwei/go-tools/utils/datautil"
+	"github.com/Meikwei/protocol/constant"
+	"github.com/Meikwei/protocol/sdkws"
+	"github.com/redis/go-redis/v9"
+)
+
+func (m *msgServer) SearchConversationMsgs(ctx context.Context, req *msgext.SearchConversationMsgsReq) (*msgext.SearchConversationMsgsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if len(msgprocessor.QueryTokens(req.Keyword)) == 0 {
+		return nil, errs.ErrArgs.WrapMsg("keyword has no searchable words")
+	}
+	conversationIDs, err := m.ConversationLocalCache.GetConversationIDs(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.ConversationIDs) > 0 {
+		owned := datautil.SliceSet(conversationIDs)
+		conversationIDs = datautil.Filter(datautil.Distinct(req.ConversationIDs), func(conversationID string) (string, bool) {
+			_, ok := owned[conversationID]
+			return conversationID, ok
+		})
+	}
+	if len(conversationIDs) == 0 {
+		return &msgext.SearchConversationMsgsResp{}, nil
+	}
+	minSeqs, err := m.getUserMinSeqs(ctx, req.UserID, conversationIDs)
+	if err != nil {
+		return nil, err
+	}
+	total, indexes, err := m.MsgSearchDatabase.SearchMsgs(ctx, minSeqs, req.Keyword, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	conversationSeqs := make(map[string][]int64)
+	for _, index := range indexes {
+		conversationSeqs[index.ConversationID] = append(conversationSeqs[index.ConversationID], index.Seq)
+	}
+	msgMap := make(map[string]map[int64]*sdkws.MsgData, len(conversationSeqs))
+	for conversationID, seqs := range conversationSeqs {
+		_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, req.UserID, conversationID, seqs)
+		if err != nil {
+			return nil, err
+		}
+		msgMap[conversationID] = make(map[int64]*sdkws.MsgData, len(msgs))
+		for _, msgData := range msgs {
+			if msgData != nil && msgData.Seq > 0 {
+				msgMap[conversationID][msgData.Seq] = msgData
+			}
+		}
+	}
+	resp := &msgext.SearchConversationMsgsResp{Total: total, Msgs: make([]*msgext.SearchedMsg, 0, len(indexes))}
+	for _, index := range indexes {
+		msgData := msgMap[index.ConversationID][index.Seq]
+		if msgData == nil || msgData.ContentType == constant.MsgRevokeNotification {
+			continue
+		}
+		text := msgprocessor.SearchableText(msgData.ContentType, msgData.Content)
+		highlights := msgprocessor.SearchHighlights(text, req.Keyword)
+		if len(highlights) == 0 {
+			// 索引尚未随消息内容更新
+			log.ZDebug(ctx, "stale msg search index", "conversationID", index.ConversationID, "seq", index.Seq)
+			continue
+		}
+		searched := &msgext.SearchedMsg{ConversationID: index.ConversationID, MsgData: msgData, Text: text}
+		for _, h := range highlights {
+			searched.Highlights = append(searched.Highlights, &msgext.MsgHighlight{Start: int32(h.Start), End: int32(h.End)})
+		}
+		resp.Msgs = append(resp.Msgs, searched)
+	}
+	return resp, nil
+}
+
+// getUserMinSeqs returns the smallest seq of each conversation visible to the user, taking both the
+// conversation min seq and the user's own min seq set by clearing the conversation into account.
+func (m *msgServer) getUserMinSeqs(ctx context.Context, userID string, conversationIDs []string) (map[string]int64, error) {
+	minSeqs, err := m.MsgDatabase.GetMinSeqs(ctx, conversationIDs)
+	if err != nil && errs.Unwrap(err) != redis.Nil {
+		return nil, err
+	}
+	res := make(map[string]int64, len(conversationIDs))
+	for _, conversationID := range conversationIDs {
+		userMinSeq, err := m.MsgDatabase.GetConversationUserMinSeq(ctx, conversationID, userID)
+		if err != nil && errs.Unwrap(err) != redis.Nil {
+			return nil, err
+		}
+		res[conversationID] = max(minSeqs[conversationID], userMinSeq)
+	}
+	return res, nil
+}
@@ -0,0 +1,69 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+
+	"github.com/Meikwei/aetim/pkg/msgprocessor"
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/Meikwei/go-tools/log"
+	"github.com/Meikwei/protocol/constant"
+	"github.com/Meikwei/protocol/sdkws"
+)
+
+// defaultSelfDestructUnreadTimeout is used when unreadTimeout is not configured, in seconds.
+const defaultSelfDestructUnreadTimeout = 7 * 24 * 60 * 60
+
+// setSelfDestruct checks the self-destruct timer set by the sender and stamps it with the time
+// the message is removed for everyone. The timers start in msgtransfer once the message is stored.
+func (m *msgServer) setSelfDestruct(msgData *sdkws.MsgData) error {
+	selfDestruct, err := msgprocessor.GetSelfDestruct(msgData.AttachedInfo)
+	if err != nil {
+		return errs.ErrArgs.WrapMsg("invalid selfDestruct: " + err.Error())
+	}
+	if selfDestruct == nil {
+		return nil
+	}
+	if msgData.SessionType != constant.SingleChatType && msgData.SessionType != constant.ReadGroupChatType {
+		return errs.ErrArgs.WrapMsg("selfDestruct is only supported for single and group chat messages")
+	}
+	conf := m.config.RpcConfig.SelfDestruct
+	if selfDestruct.TTL <= 0 {
+		return errs.ErrArgs.WrapMsg("selfDestruct ttl must be positive")
+	}
+	if conf.MaxTTL > 0 && selfDestruct.TTL > int64(conf.MaxTTL) {
+		return errs.ErrArgs.WrapMsg("selfDestruct ttl is too long", "ttl", selfDestruct.TTL, "maxTTL", conf.MaxTTL)
+	}
+	lifetime := selfDestruct.TTL
+	if selfDestruct.StartOnRead {
+		unreadTimeout := int64(conf.UnreadTimeout)
+		if unreadTimeout <= 0 {
+			unreadTimeout = defaultSelfDestructUnreadTimeout
+		}
+		lifetime += unreadTimeout
+	}
+	selfDestruct.ExpireTime = msgData.SendTime + lifetime*1000
+	msgData.AttachedInfo = msgprocessor.SetSelfDestruct(msgData.AttachedInfo, selfDestruct)
+	return nil
+}
+
+// startReadDestruct starts the self-destruct timers of the messages userID read: the seqs in (fromSeq, toSeq]
+// and seqs. A failure is logged only, the read itself succeeded.
+func (m *msgServer) startReadDestruct(ctx context.Context, conversationID string, userID string, fromSeq int64, toSeq int64, seqs []int64) {
+	if err := m.MsgDestructDatabase.StartOnRead(ctx, conversationID, userID, fromSeq, toSeq, seqs); err != nil {
+		log.ZWarn(ctx, "start read destruct failed", err, "conversationID", conversationID, "userID", userID, "fromSeq", fromSeq, "toSeq", toSeq)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/Meikwei/aetim/pkg/common/prommetrics"
 	"github.com/Meikwei/aetim/pkg/msgprocessor"
 	"github.com/Meikwei/aetim/pkg/util/conversationutil"
+	"github.com/Meikwei/aetim/pkg/util/sensitive"
 	"github.com/Meikwei/go-tools/errs"
 	"github.com/Meikwei/go-tools/log"
 	"github.com/Meikwei/go-tools/mcontext"
@@ -35,25 +36,53 @@ import (
 func (m *msgServer) SendMsg(ctx context.Context, req *pbmsg.SendMsgReq) (*pbmsg.SendMsgResp, error) {
 	if req.MsgData != nil {
 		m.encapsulateMsgData(req.MsgData)
-		switch req.MsgData.SessionType {
-		case constant.SingleChatType:
-			return m.sendMsgSingleChat(ctx, req)
-		case constant.NotificationChatType:
-			return m.sendMsgNotification(ctx, req)
-		case constant.ReadGroupChatType:
-			return m.sendMsgSuperGroupChat(ctx, req)
-		default:
-			return nil, errs.ErrArgs.WrapMsg("unknown sessionType")
+		if err := m.setSelfDestruct(req.MsgData); err != nil {
+			return nil, err
+		}
+		sent, claimed, err := m.claimSendMsg(ctx, req.MsgData)
+		if err != nil {
+			return nil, err
+		}
+		if !claimed {
+			return sent, nil
+		}
+		resp, err := m.sendMsg(ctx, req)
+		if err != nil {
+			m.releaseSendMsg(ctx, req.MsgData)
+			return nil, err
 		}
+		m.commitSendMsg(ctx, req.MsgData)
+		return resp, nil
 	}
 	return nil, errs.ErrArgs.WrapMsg("msgData is nil")
 }
 
+func (m *msgServer) sendMsg(ctx context.Context, req *pbmsg.SendMsgReq) (*pbmsg.SendMsgResp, error) {
+	switch req.MsgData.SessionType {
+	case constant.SingleChatType:
+		return m.sendMsgSingleChat(ctx, req)
+	case constant.NotificationChatType:
+		return m.sendMsgNotification(ctx, req)
+	case constant.ReadGroupChatType:
+		return m.sendMsgSuperGroupChat(ctx, req)
+	default:
+		return nil, errs.ErrArgs.WrapMsg("unknown sessionType")
+	}
+}
+
 func (m *msgServer) sendMsgSuperGroupChat(ctx context.Context, req *pbmsg.SendMsgReq) (resp *pbmsg.SendMsgResp, err error) {
 	if err = m.messageVerification(ctx, req); err != nil {
 		prommetrics.GroupChatMsgProcessFailedCounter.Inc()
 		return nil, err
 	}
+	policy, err := m.checkSensitive(ctx, req.MsgData)
+	if err != nil {
+		prommetrics.GroupChatMsgProcessFailedCounter.Inc()
+		return nil, err
+	}
+	if policy == sensitive.PolicySenderOnly {
+		return senderOnlyResp(req.MsgData), nil
+	}
 
 	if err = m.webhookBeforeSendGroupMsg(ctx, &m.config.WebhooksConfig.BeforeSendGroupMsg, req); err != nil {
 		return nil, err
@@ -134,6 +163,13 @@ func (m *msgServer) sendMsgSingleChat(ctx context.Context, req *pbmsg.SendMsgReq
 	if err := m.messageVerification(ctx, req); err != nil {
 		return nil, err
 	}
+	policy, err := m.checkSensitive(ctx, req.MsgData)
+	if err != nil {
+		return nil, err
+	}
+	if policy == sensitive.PolicySenderOnly {
+		return senderOnlyResp(req.MsgData), nil
+	}
 	isSend := true
 	isNotification := msgprocessor.IsNotificationByMsg(req.MsgData)
 	if !isNotification {
@@ -172,3 +208,13 @@ func (m *msgServer) sendMsgSingleChat(ctx context.Context, req *pbmsg.SendMsgReq
 		}, nil
 	}
 }
+
+// senderOnlyResp answers a message that is accepted but not delivered as if it had been sent, the
+// sender keeps the message it sent locally while nobody else receives it.
+func senderOnlyResp(msgData *sdkws.MsgData) *pbmsg.SendMsgResp {
+	return &pbmsg.SendMsgResp{
+		ServerMsgID: msgData.ServerMsgID,
+		ClientMsgID: msgData.ClientMsgID,
+		SendTime:    msgData.SendTime,
+	}
+}
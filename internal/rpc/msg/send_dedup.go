@@ -0,0 +1,109 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/cache"
+	"github.com/Meikwei/aetim/pkg/common/servererrs"
+	"github.com/Meikwei/aetim/pkg/msgprocessor"
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/Meikwei/go-tools/log"
+	"github.com/Meikwei/protocol/constant"
+	pbmsg "github.com/Meikwei/protocol/msg"
+	"github.com/Meikwei/protocol/sdkws"
+)
+
+// dedupSendMsg reports whether sends of the message are deduplicated. Notifications are generated by the server
+// and never retried by clients.
+func (m *msgServer) dedupSendMsg(msgData *sdkws.MsgData) bool {
+	return m.config.RpcConfig.Dedup.Window > 0 && msgData.SendID != "" && msgData.ClientMsgID != "" &&
+		msgData.SessionType != constant.NotificationChatType
+}
+
+const (
+	// sendMsgPendingExpire is how long a claimed send blocks duplicates if its instance stops before finishing it.
+	sendMsgPendingExpire = time.Second * 30
+	// sendMsgPendingWait is how long a duplicate waits for the original send to finish.
+	sendMsgPendingWait     = time.Second * 5
+	sendMsgPendingInterval = time.Millisecond * 50
+)
+
+// claimSendMsg records a pending send of the message by its client msg ID. If the same client message was already
+// sent within the dedup window, it returns the response of the original send and false, the duplicate must not be
+// sent. A duplicate of a send still in flight waits for it, and sends the message itself if the original fails.
+// Deduplication is skipped when redis fails, sending the message again is preferred to refusing it.
+func (m *msgServer) claimSendMsg(ctx context.Context, msgData *sdkws.MsgData) (*pbmsg.SendMsgResp, bool, error) {
+	if !m.dedupSendMsg(msgData) {
+		return nil, true, nil
+	}
+	deadline := time.Now().Add(sendMsgPendingWait)
+	for {
+		sent, claimed, err := m.sendDedup.ClaimSendMsg(ctx, msgData.SendID, msgData.ClientMsgID, msgData.ServerMsgID, sendMsgPendingExpire)
+		if err != nil {
+			log.ZWarn(ctx, "claim send msg failed", err, "sendID", msgData.SendID, "clientMsgID", msgData.ClientMsgID)
+			return nil, true, nil
+		}
+		if claimed {
+			return nil, true, nil
+		}
+		if !sent.Pending() {
+			log.ZInfo(ctx, "duplicate send msg", "sendID", msgData.SendID, "clientMsgID", msgData.ClientMsgID,
+				"serverMsgID", sent.ServerMsgID, "seq", sent.Seq)
+			resp := &pbmsg.SendMsgResp{
+				ServerMsgID: sent.ServerMsgID,
+				ClientMsgID: msgData.ClientMsgID,
+				SendTime:    sent.SendTime,
+			}
+			if sent.Seq > 0 {
+				msgprocessor.SetSendMsgRespSeq(resp, sent.Seq)
+			}
+			return resp, false, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, false, servererrs.ErrMsgSending.WrapMsg("the same client message is still being sent",
+				"sendID", msgData.SendID, "clientMsgID", msgData.ClientMsgID)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, false, errs.Wrap(ctx.Err())
+		case <-time.After(sendMsgPendingInterval):
+		}
+	}
+}
+
+// commitSendMsg records the result of a successful send, duplicates are answered with it from now on.
+func (m *msgServer) commitSendMsg(ctx context.Context, msgData *sdkws.MsgData) {
+	if !m.dedupSendMsg(msgData) {
+		return
+	}
+	window := time.Duration(m.config.RpcConfig.Dedup.Window) * time.Second
+	sent := &cache.SentMsg{ServerMsgID: msgData.ServerMsgID, SendTime: msgData.SendTime}
+	if err := m.sendDedup.CommitSendMsg(ctx, msgData.SendID, msgData.ClientMsgID, sent, window); err != nil {
+		log.ZWarn(ctx, "commit send msg failed", err, "sendID", msgData.SendID, "clientMsgID", msgData.ClientMsgID)
+	}
+}
+
+// releaseSendMsg forgets a message whose send failed, so that the client can retry it.
+func (m *msgServer) releaseSendMsg(ctx context.Context, msgData *sdkws.MsgData) {
+	if !m.dedupSendMsg(msgData) {
+		return
+	}
+	if err := m.sendDedup.DelSendMsg(ctx, msgData.SendID, msgData.ClientMsgID, msgData.ServerMsgID); err != nil {
+		log.ZWarn(ctx, "release send msg failed", err, "sendID", msgData.SendID, "clientMsgID", msgData.ClientMsgID)
+	}
+}
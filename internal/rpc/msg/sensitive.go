@@ -0,0 +1,168 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/mgo"
+	"github.com/Meikwei/aetim/pkg/common/servererrs"
+	"github.com/Meikwei/aetim/pkg/util/sensitive"
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/Meikwei/go-tools/log"
+	"github.com/Meikwei/go-tools/utils/datautil"
+	"github.com/Meikwei/protocol/constant"
+	"github.com/Meikwei/protocol/sdkws"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// sensitiveContentTypes are the message types whose text is checked for sensitive words.
+var sensitiveContentTypes = []int32{constant.Text, constant.AtText, constant.Quote, constant.AdvancedText}
+
+// startSensitiveFilter loads the sensitive words and keeps reloading them in the background, so that
+// changes to the word file or collection take effect without a restart.
+func (m *msgServer) startSensitiveFilter(ctx context.Context, db *mongo.Database) error {
+	conf := m.config.RpcConfig.Sensitive
+	if !conf.Enable {
+		return nil
+	}
+	defaultPolicy, err := sensitive.ParsePolicy(conf.DefaultPolicy)
+	if err != nil {
+		return err
+	}
+	var load func(ctx context.Context) ([]sensitive.Rule, error)
+	switch conf.Source {
+	case "file":
+		load = func(ctx context.Context) ([]sensitive.Rule, error) {
+			f, err := os.Open(conf.File)
+			if err != nil {
+				return nil, errs.WrapMsg(err, "open sensitive word file", "file", conf.File)
+			}
+			defer f.Close()
+			return sensitive.ParseRules(f, defaultPolicy)
+		}
+	case "mongo":
+		wordDB, err := mgo.NewSensitiveWordMongo(db)
+		if err != nil {
+			return err
+		}
+		load = func(ctx context.Context) ([]sensitive.Rule, error) {
+			words, err := wordDB.FindAll(ctx)
+			if err != nil {
+				return nil, err
+			}
+			rules := make([]sensitive.Rule, 0, len(words))
+			for _, word := range words {
+				policy := defaultPolicy
+				if word.Policy != "" {
+					if policy, err = sensitive.ParsePolicy(word.Policy); err != nil {
+						log.ZWarn(ctx, "skip sensitive word with unknown policy", err, "word", word.Word)
+						continue
+					}
+				}
+				rules = append(rules, sensitive.Rule{Word: word.Word, Policy: policy})
+			}
+			return rules, nil
+		}
+	default:
+		return errs.ErrArgs.WrapMsg("unknown sensitive word source", "source", conf.Source)
+	}
+	rules, err := load(ctx)
+	if err != nil {
+		return err
+	}
+	m.sensitiveFilter = sensitive.NewFilter()
+	m.sensitiveFilter.Reload(rules)
+	log.ZInfo(ctx, "sensitive words loaded", "source", conf.Source, "count", m.sensitiveFilter.Len())
+	if conf.ReloadInterval <= 0 {
+		return nil
+	}
+	go func() {
+		ticker := time.NewTicker(time.Duration(conf.ReloadInterval) * time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				rules, err := load(ctx)
+				if err != nil {
+					// 加载失败时继续使用之前的词表
+					log.ZWarn(ctx, "reload sensitive words failed", err, "source", conf.Source)
+					continue
+				}
+				m.sensitiveFilter.Reload(rules)
+			}
+		}
+	}()
+	return nil
+}
+
+// checkSensitive checks the text of msgData for sensitive words. Rejected messages return
+// ErrMsgSensitiveWord, masked ones get their content rewritten, and PolicySenderOnly is returned for
+// the caller to accept the message without delivering it. App managers are not checked.
+func (m *msgServer) checkSensitive(ctx context.Context, msgData *sdkws.MsgData) (sensitive.Policy, error) {
+	if m.sensitiveFilter == nil || !datautil.Contain(msgData.ContentType, sensitiveContentTypes...) {
+		return sensitive.PolicyNone, nil
+	}
+	if datautil.Contain(msgData.SendID, m.config.Share.IMAdminUserID...) {
+		return sensitive.PolicyNone, nil
+	}
+	// 只改写文本字段，其余字段原样保留
+	var content map[string]json.RawMessage
+	if err := json.Unmarshal(msgData.Content, &content); err != nil {
+		return sensitive.PolicyNone, nil
+	}
+	var key, text string
+	for _, k := range []string{"content", "text"} {
+		if raw, ok := content[k]; ok && json.Unmarshal(raw, &text) == nil && text != "" {
+			key = k
+			break
+		}
+	}
+	if key == "" {
+		return sensitive.PolicyNone, nil
+	}
+	res := m.sensitiveFilter.Check(text)
+	if res.Policy == sensitive.PolicyNone {
+		return sensitive.PolicyNone, nil
+	}
+	words := make([]string, 0, len(res.Hits))
+	for _, hit := range res.Hits {
+		words = append(words, hit.Word)
+	}
+	log.ZInfo(ctx, "sensitive words hit", "policy", res.Policy.String(), "words", datautil.Distinct(words),
+		"sendID", msgData.SendID, "recvID", msgData.RecvID, "groupID", msgData.GroupID,
+		"sessionType", msgData.SessionType, "clientMsgID", msgData.ClientMsgID)
+	switch res.Policy {
+	case sensitive.PolicyReject:
+		return res.Policy, servererrs.ErrMsgSensitiveWord.WrapMsg("msg contains sensitive words")
+	case sensitive.PolicyMask:
+		masked, err := json.Marshal(res.Masked)
+		if err != nil {
+			return res.Policy, errs.WrapMsg(err, "json.Marshal masked text")
+		}
+		content[key] = masked
+		data, err := json.Marshal(content)
+		if err != nil {
+			return res.Policy, errs.WrapMsg(err, "json.Marshal masked content")
+		}
+		msgData.Content = data
+	}
+	return res.Policy, nil
+}
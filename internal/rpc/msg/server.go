@@ -16,6 +16,7 @@ package msg
 
 import (
 	"context"
+	"sync"
 
 	"github.com/Meikwei/aetim/pkg/common/config"
 	"github.com/Meikwei/aetim/pkg/common/webhook"
@@ -26,12 +27,15 @@ import (
 	"github.com/Meikwei/aetim/pkg/common/db/cache"
 	"github.com/Meikwei/aetim/pkg/common/db/controller"
 	"github.com/Meikwei/aetim/pkg/common/db/mgo"
+	"github.com/Meikwei/aetim/pkg/protocol/msgext"
 	"github.com/Meikwei/aetim/pkg/rpccache"
 	"github.com/Meikwei/aetim/pkg/rpcclient"
+	"github.com/Meikwei/aetim/pkg/util/sensitive"
 	"github.com/Meikwei/go-tools/discovery"
 	"github.com/Meikwei/protocol/constant"
 	"github.com/Meikwei/protocol/conversation"
 	"github.com/Meikwei/protocol/msg"
+	"github.com/Meikwei/protocol/rtc"
 	"google.golang.org/grpc"
 )
 
@@ -44,6 +48,18 @@ type (
 	msgServer struct {
 		RegisterCenter         discovery.SvcDiscoveryRegistry   // Service discovery registry for service registration.
 		MsgDatabase            controller.CommonMsgDatabase     // Interface for message database operations.
+		SignalDatabase         controller.SignalDatabase        // Interface for call signaling records.
+		ScheduledMsgDatabase   controller.ScheduledMsgDatabase  // Interface for scheduled messages.
+		ThreadDatabase         controller.ThreadDatabase        // Interface for reply threads.
+		PinnedMsgDatabase      controller.PinnedMsgDatabase     // Interface for pinned messages.
+		MsgSearchDatabase      controller.MsgSearchDatabase     // Interface for the full-text message search index.
+		ReadReceiptDatabase    controller.ReadReceiptDatabase   // Interface for group read receipts.
+		RetentionDatabase      controller.RetentionDatabase     // Interface for conversation and group retention policies.
+		MsgExportDatabase      controller.MsgExportDatabase     // Interface for conversation export jobs.
+		MsgDestructDatabase    controller.MsgDestructDatabase   // Interface for message self-destruct timers.
+		BroadcastDatabase      controller.BroadcastDatabase     // Interface for broadcast jobs and their recipients.
+		UnreadCountDatabase    controller.UnreadCountDatabase   // Interface for the unread counters of users.
+		Third                  *rpcclient.Third                 // RPC client for object storage.
 		Conversation           *rpcclient.ConversationRpcClient // RPC client for conversation service.
 		UserLocalCache         *rpccache.UserLocalCache         // Local cache for user data.
 		FriendLocalCache       *rpccache.FriendLocalCache       // Local cache for friend data.
@@ -53,6 +69,12 @@ type (
 		notificationSender     *rpcclient.NotificationSender    // RPC client for sending notifications.
 		config                 *Config                          // Global configuration settings.
 		webhookClient          *webhook.Client
+		sensitiveFilter        *sensitive.Filter    // Sensitive word filter, nil when disabled.
+		exportLimiter          chan struct{}        // Limits the export jobs running at the same time.
+		sendDedup              cache.SendDedupCache // Sent messages by client msg ID, used to drop client retries.
+		broadcastLimiter       chan struct{}        // Limits the broadcast jobs running at the same time.
+		unreadRebuilds         sync.Map             // Users whose unread counter is being rebuilt in the background.
+		unreadRebuildLimiter   chan struct{}        // Limits the unread counters rebuilt at the same time.
 	}
 
 	Config struct {
@@ -91,9 +113,69 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	if err != nil {
 		return err
 	}
+	signalModel, err := mgo.NewSignalMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	scheduledMsgModel, err := mgo.NewScheduledMsgMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	threadModel, err := mgo.NewThreadMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	threadMemberModel, err := mgo.NewThreadMemberMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	pinnedMsgModel, err := mgo.NewPinnedMsgMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	msgSearchModel, err := mgo.NewMsgSearchIndexMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	groupReadReceiptModel, err := mgo.NewGroupReadReceiptMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	msgExportModel, err := mgo.NewMsgExportJobMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	conversationModel, err := mgo.NewConversationMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	groupModel, err := mgo.NewGroupMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	msgDestructModel, err := mgo.NewMsgDestructMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	seqDB, err := mgo.NewSeqMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	broadcastModel, err := mgo.NewBroadcastMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	userModel, err := mgo.NewUserMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	groupMemberModel, err := mgo.NewGroupMember(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
 	//todo MsgCacheTimeout
 	msgModel := cache.NewMsgCache(rdb, config.RedisConfig.EnablePipeline)
-	seqModel := cache.NewSeqCache(rdb)
+	seqModel := cache.NewSeqCache(rdb, seqDB, config.RedisConfig.SeqReplicas)
 	conversationClient := rpcclient.NewConversationRpcClient(client, config.Share.RpcRegisterName.Conversation)
 	userRpcClient := rpcclient.NewUserRpcClient(client, config.Share.RpcRegisterName.User, config.Share.IMAdminUserID)
 	groupRpcClient := rpcclient.NewGroupRpcClient(client, config.Share.RpcRegisterName.Group)
@@ -105,6 +187,18 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	s := &msgServer{
 		Conversation:           &conversationClient,
 		MsgDatabase:            msgDatabase,
+		SignalDatabase:         controller.NewSignalDatabase(signalModel),
+		ScheduledMsgDatabase:   controller.NewScheduledMsgDatabase(scheduledMsgModel),
+		ThreadDatabase:         controller.NewThreadDatabase(threadModel, threadMemberModel),
+		PinnedMsgDatabase:      controller.NewPinnedMsgDatabase(pinnedMsgModel),
+		MsgSearchDatabase:      controller.NewMsgSearchDatabase(msgSearchModel),
+		ReadReceiptDatabase:    controller.NewReadReceiptDatabase(groupReadReceiptModel),
+		RetentionDatabase:      controller.NewRetentionDatabase(conversationModel, groupModel, controller.DefaultRetention(&config.Share)),
+		MsgExportDatabase:      controller.NewMsgExportDatabase(msgExportModel),
+		MsgDestructDatabase:    controller.NewMsgDestructDatabase(msgDestructModel),
+		BroadcastDatabase:      controller.NewBroadcastDatabase(broadcastModel, userModel, groupMemberModel),
+		UnreadCountDatabase:    controller.NewUnreadCountDatabase(cache.NewUnreadCountCache(rdb)),
+		Third:                  rpcclient.NewThird(client, config.Share.RpcRegisterName.Third, ""),
 		RegisterCenter:         client,
 		UserLocalCache:         rpccache.NewUserLocalCache(userRpcClient, &config.LocalCacheConfig, rdb),
 		GroupLocalCache:        rpccache.NewGroupLocalCache(groupRpcClient, &config.LocalCacheConfig, rdb),
@@ -112,10 +206,20 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 		FriendLocalCache:       rpccache.NewFriendLocalCache(friendRpcClient, &config.LocalCacheConfig, rdb),
 		config:                 config,
 		webhookClient:          webhook.NewWebhookClient(config.WebhooksConfig.URL),
+		sendDedup:              cache.NewSendDedupCache(rdb),
+		unreadRebuildLimiter:   make(chan struct{}, unreadRebuildWorkers),
 	}
 
 	s.notificationSender = rpcclient.NewNotificationSender(&config.NotificationConfig, rpcclient.WithLocalSendMsg(s.SendMsg))
+	if err := s.startSensitiveFilter(ctx, mgocli.GetDB()); err != nil {
+		return err
+	}
+	s.initMsgExport(ctx)
 	msg.RegisterMsgServer(server, s)
+	rtc.RegisterRtcServiceServer(server, s)
+	msgext.RegisterMsgExtServer(server, s)
+	go s.signalTimeoutLoop(ctx)
+	go s.broadcastLoop(ctx)
 	return nil
 }
 
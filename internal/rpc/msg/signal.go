@@ -0,0 +1,667 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/authverify"
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/aetim/pkg/common/servererrs"
+	"github.com/Meikwei/aetim/pkg/msgprocessor"
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/Meikwei/go-tools/log"
+	"github.com/Meikwei/go-tools/mcontext"
+	"github.com/Meikwei/go-tools/utils/datautil"
+	"github.com/Meikwei/go-tools/utils/idutil"
+	"github.com/Meikwei/go-tools/utils/timeutil"
+	"github.com/Meikwei/protocol/constant"
+	"github.com/Meikwei/protocol/msg"
+	"github.com/Meikwei/protocol/rtc"
+	"github.com/Meikwei/protocol/sdkws"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultSignalRingTimeout   = 60
+	defaultSignalCheckInterval = 5
+)
+
+var (
+	signalRinging  = []int32{relation.SignalMemberRinging}
+	signalAccepted = []int32{relation.SignalMemberAccepted}
+)
+
+// SignalMessageAssemble handles a call signal sent by a client, updates the call state and
+// forwards the signal to the other participants as a SignalingNotification whose content is
+// the protobuf encoded SignalReq.
+func (m *msgServer) SignalMessageAssemble(ctx context.Context, req *rtc.SignalMessageAssembleReq) (*rtc.SignalMessageAssembleResp, error) {
+	if req.SignalReq == nil {
+		return nil, errs.ErrArgs.WrapMsg("signalReq is nil")
+	}
+	var resp rtc.SignalResp
+	switch payload := req.SignalReq.Payload.(type) {
+	case *rtc.SignalReq_Invite:
+		if payload.Invite == nil {
+			return nil, errs.ErrArgs.WrapMsg("invite is nil")
+		}
+		busy, err := m.signalInvite(ctx, payload.Invite.Invitation, payload.Invite.OfflinePushInfo, req.SignalReq)
+		if err != nil {
+			return nil, err
+		}
+		resp.Payload = &rtc.SignalResp_Invite{Invite: &rtc.SignalInviteResp{
+			RoomID:             payload.Invite.Invitation.RoomID,
+			BusyLineUserIDList: busy,
+		}}
+	case *rtc.SignalReq_InviteInGroup:
+		if payload.InviteInGroup == nil {
+			return nil, errs.ErrArgs.WrapMsg("inviteInGroup is nil")
+		}
+		busy, err := m.signalInvite(ctx, payload.InviteInGroup.Invitation, payload.InviteInGroup.OfflinePushInfo, req.SignalReq)
+		if err != nil {
+			return nil, err
+		}
+		resp.Payload = &rtc.SignalResp_InviteInGroup{InviteInGroup: &rtc.SignalInviteInGroupResp{
+			RoomID:             payload.InviteInGroup.Invitation.RoomID,
+			BusyLineUserIDList: busy,
+		}}
+	case *rtc.SignalReq_Cancel:
+		if payload.Cancel == nil {
+			return nil, errs.ErrArgs.WrapMsg("cancel is nil")
+		}
+		if err := m.signalCancel(ctx, payload.Cancel, req.SignalReq); err != nil {
+			return nil, err
+		}
+		resp.Payload = &rtc.SignalResp_Cancel{Cancel: &rtc.SignalCancelResp{}}
+	case *rtc.SignalReq_Accept:
+		if payload.Accept == nil {
+			return nil, errs.ErrArgs.WrapMsg("accept is nil")
+		}
+		if err := m.signalAccept(ctx, payload.Accept, req.SignalReq); err != nil {
+			return nil, err
+		}
+		resp.Payload = &rtc.SignalResp_Accept{Accept: &rtc.SignalAcceptResp{RoomID: payload.Accept.Invitation.RoomID}}
+	case *rtc.SignalReq_Reject:
+		if payload.Reject == nil {
+			return nil, errs.ErrArgs.WrapMsg("reject is nil")
+		}
+		if err := m.signalReject(ctx, payload.Reject, req.SignalReq); err != nil {
+			return nil, err
+		}
+		resp.Payload = &rtc.SignalResp_Reject{Reject: &rtc.SignalRejectResp{}}
+	case *rtc.SignalReq_HungUp:
+		if payload.HungUp == nil {
+			return nil, errs.ErrArgs.WrapMsg("hungUp is nil")
+		}
+		if err := m.signalHungUp(ctx, payload.HungUp, req.SignalReq); err != nil {
+			return nil, err
+		}
+		resp.Payload = &rtc.SignalResp_HungUp{HungUp: &rtc.SignalHungUpResp{}}
+	case *rtc.SignalReq_GetTokenByRoomID:
+		return nil, errs.ErrArgs.WrapMsg("getTokenByRoomID is not supported, no media server is configured")
+	default:
+		return nil, errs.ErrArgs.WrapMsg("unknown signal payload")
+	}
+	return &rtc.SignalMessageAssembleResp{SignalResp: &resp}, nil
+}
+
+// signalInvite creates the call room and rings every invitee that is not already in a call.
+// Busy invitees are returned and left out of the room; if all of them are busy no room is created.
+func (m *msgServer) signalInvite(ctx context.Context, invitation *rtc.InvitationInfo, offlinePushInfo *sdkws.OfflinePushInfo, signalReq *rtc.SignalReq) ([]string, error) {
+	if invitation == nil {
+		return nil, errs.ErrArgs.WrapMsg("invitation is nil")
+	}
+	if err := authverify.CheckAccessV3(ctx, invitation.InviterUserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	invitees := excludeUserIDs(datautil.Distinct(invitation.InviteeUserIDList), invitation.InviterUserID)
+	if len(invitees) == 0 {
+		return nil, errs.ErrArgs.WrapMsg("inviteeUserIDList is empty")
+	}
+	switch invitation.SessionType {
+	case constant.SingleChatType:
+		if len(invitees) != 1 {
+			return nil, errs.ErrArgs.WrapMsg("single chat call must have exactly one invitee")
+		}
+		invitation.GroupID = ""
+		if _, err := m.UserLocalCache.GetUserInfo(ctx, invitees[0]); err != nil {
+			return nil, err
+		}
+	case constant.ReadGroupChatType:
+		if invitation.GroupID == "" {
+			return nil, errs.ErrArgs.WrapMsg("groupID is empty")
+		}
+		members, err := m.GroupLocalCache.GetGroupMemberInfoMap(ctx, invitation.GroupID, append([]string{invitation.InviterUserID}, invitees...))
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := members[invitation.InviterUserID]; !ok {
+			return nil, servererrs.ErrNotInGroupYet.WrapMsg("inviter is not in group", "groupID", invitation.GroupID)
+		}
+		for _, userID := range invitees {
+			if _, ok := members[userID]; !ok {
+				return nil, servererrs.ErrNotInGroupYet.WrapMsg("invitee is not in group", "groupID", invitation.GroupID, "userID", userID)
+			}
+		}
+		active, err := m.SignalDatabase.TakeActiveSignalByGroupID(ctx, invitation.GroupID)
+		if err == nil {
+			return nil, servererrs.ErrSignalRoomExisted.WrapMsg("group call in progress", "roomID", active.RoomID)
+		} else if !IsNotFound(err) {
+			return nil, err
+		}
+	default:
+		return nil, errs.ErrArgs.WrapMsg("sessionType not supported", "sessionType", invitation.SessionType)
+	}
+	busy, err := m.SignalDatabase.FindBusyUserIDs(ctx, append([]string{invitation.InviterUserID}, invitees...))
+	if err != nil {
+		return nil, err
+	}
+	if datautil.Contain(invitation.InviterUserID, busy...) {
+		return nil, servererrs.ErrSignalBusyLine.WrapMsg("inviter is already in a call")
+	}
+	invitees = excludeUserIDs(invitees, busy...)
+	invitation.InviteeUserIDList = invitees
+	invitation.BusyLineUserIDList = busy
+	if len(invitees) == 0 {
+		return busy, nil
+	}
+	if invitation.RoomID == "" {
+		invitation.RoomID = idutil.GetMsgIDByMD5(invitation.InviterUserID)
+	}
+	if invitation.Timeout <= 0 {
+		invitation.Timeout = int32(m.config.RpcConfig.Signal.RingTimeout)
+		if invitation.Timeout <= 0 {
+			invitation.Timeout = defaultSignalRingTimeout
+		}
+	}
+	now := time.Now()
+	invitation.InitiateTime = now.UnixMilli()
+	signal := &relation.SignalModel{
+		RoomID:        invitation.RoomID,
+		InviterUserID: invitation.InviterUserID,
+		GroupID:       invitation.GroupID,
+		SessionType:   invitation.SessionType,
+		MediaType:     invitation.MediaType,
+		PlatformID:    invitation.PlatformID,
+		CustomData:    invitation.CustomData,
+		Timeout:       invitation.Timeout,
+		Members:       []*relation.SignalMemberModel{{UserID: invitation.InviterUserID, Status: relation.SignalMemberAccepted, HandleTime: now}},
+		Status:        relation.SignalStatusInviting,
+		InitiateTime:  now,
+		TimeoutTime:   now.Add(time.Duration(invitation.Timeout) * time.Second),
+	}
+	if offlinePushInfo != nil {
+		signal.PushTitle = offlinePushInfo.Title
+		signal.PushDesc = offlinePushInfo.Desc
+		signal.PushEx = offlinePushInfo.Ex
+	}
+	for _, userID := range invitees {
+		signal.Members = append(signal.Members, &relation.SignalMemberModel{UserID: userID, Status: relation.SignalMemberRinging, HandleTime: now})
+	}
+	if err := m.SignalDatabase.CreateSignal(ctx, signal); err != nil {
+		if mongo.IsDuplicateKeyError(errs.Unwrap(err)) {
+			return nil, servererrs.ErrDuplicateKey.WrapMsg("roomID already exists", "roomID", signal.RoomID)
+		}
+		return nil, err
+	}
+	m.sendSignal(ctx, invitation.InviterUserID, invitees, constant.SignalingNotification, signalReq, offlinePushInfo)
+	return busy, nil
+}
+
+func (m *msgServer) signalCancel(ctx context.Context, req *rtc.SignalCancelReq, signalReq *rtc.SignalReq) error {
+	signal, err := m.takeSignal(ctx, req.Invitation, req.UserID)
+	if err != nil {
+		return err
+	}
+	if signal.InviterUserID != req.UserID {
+		return errs.ErrNoPermission.WrapMsg("only the inviter can cancel the call")
+	}
+	before, err := m.SignalDatabase.FinishSignal(ctx, signal.RoomID, []int32{relation.SignalStatusInviting}, -1, relation.SignalStatusCanceled, relation.SignalMemberCanceled)
+	if err != nil {
+		return signalStateErr(err, "call is not ringing")
+	}
+	req.Invitation = convertSignalInvitation(before)
+	m.sendSignal(ctx, req.UserID, before.MemberUserIDs(relation.SignalMemberRinging), constant.SignalingNotification, signalReq, signalOfflinePushInfo(before))
+	return nil
+}
+
+func (m *msgServer) signalAccept(ctx context.Context, req *rtc.SignalAcceptReq, signalReq *rtc.SignalReq) error {
+	signal, err := m.takeSignal(ctx, req.Invitation, req.UserID)
+	if err != nil {
+		return err
+	}
+	before, err := m.SignalDatabase.UpdateSignalMember(ctx, signal.RoomID, req.UserID, signalRinging, relation.SignalMemberAccepted,
+		[]int32{relation.SignalStatusInviting}, relation.SignalStatusOngoing)
+	if err != nil {
+		return signalStateErr(err, "invitation is not ringing")
+	}
+	req.Invitation = convertSignalInvitation(before)
+	m.sendSignal(ctx, req.UserID, before.MemberUserIDs(relation.SignalMemberAccepted), constant.SignalingNotification, signalReq, nil)
+	return nil
+}
+
+func (m *msgServer) signalReject(ctx context.Context, req *rtc.SignalRejectReq, signalReq *rtc.SignalReq) error {
+	signal, err := m.takeSignal(ctx, req.Invitation, req.UserID)
+	if err != nil {
+		return err
+	}
+	before, err := m.SignalDatabase.UpdateSignalMember(ctx, signal.RoomID, req.UserID, signalRinging, relation.SignalMemberRejected, nil, 0)
+	if err != nil {
+		return signalStateErr(err, "invitation is not ringing")
+	}
+	// 最后一个振铃中的被邀请者拒绝时，房间结束
+	if _, err := m.SignalDatabase.FinishSignal(ctx, signal.RoomID, []int32{relation.SignalStatusInviting}, 1, relation.SignalStatusRejected, relation.SignalMemberCanceled); err != nil && !IsNotFound(err) {
+		return err
+	}
+	req.Invitation = convertSignalInvitation(before)
+	m.sendSignal(ctx, req.UserID, before.MemberUserIDs(relation.SignalMemberAccepted), constant.SignalingNotification, signalReq, nil)
+	return nil
+}
+
+func (m *msgServer) signalHungUp(ctx context.Context, req *rtc.SignalHungUpReq, signalReq *rtc.SignalReq) error {
+	signal, err := m.takeSignal(ctx, req.Invitation, req.UserID)
+	if err != nil {
+		return err
+	}
+	var before *relation.SignalModel
+	if signal.Status == relation.SignalStatusInviting && signal.InviterUserID == req.UserID {
+		// 无人接听时邀请者挂断等同于取消
+		before, err = m.SignalDatabase.FinishSignal(ctx, signal.RoomID, []int32{relation.SignalStatusInviting}, -1, relation.SignalStatusCanceled, relation.SignalMemberCanceled)
+	} else {
+		before, err = m.SignalDatabase.UpdateSignalMember(ctx, signal.RoomID, req.UserID, signalAccepted, relation.SignalMemberHungUp, nil, 0)
+	}
+	if err != nil {
+		return signalStateErr(err, "user is not in the call")
+	}
+	// 通话中只剩一人时结束通话
+	if before.Status == relation.SignalStatusOngoing {
+		if _, err := m.SignalDatabase.FinishSignal(ctx, signal.RoomID, []int32{relation.SignalStatusOngoing}, 1, relation.SignalStatusEnded, relation.SignalMemberCanceled); err != nil && !IsNotFound(err) {
+			return err
+		}
+	}
+	req.Invitation = convertSignalInvitation(before)
+	m.sendSignal(ctx, req.UserID, before.MemberUserIDs(relation.SignalMemberRinging, relation.SignalMemberAccepted), constant.SignalingNotification, signalReq, nil)
+	return nil
+}
+
+// takeSignal loads the room referenced by invitation after checking that the op user may act as userID.
+func (m *msgServer) takeSignal(ctx context.Context, invitation *rtc.InvitationInfo, userID string) (*relation.SignalModel, error) {
+	if invitation == nil || invitation.RoomID == "" {
+		return nil, errs.ErrArgs.WrapMsg("invitation roomID is empty")
+	}
+	if userID == "" {
+		return nil, errs.ErrArgs.WrapMsg("userID is empty")
+	}
+	if err := authverify.CheckAccessV3(ctx, userID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	signal, err := m.SignalDatabase.TakeSignal(ctx, invitation.RoomID)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, errs.ErrRecordNotFound.WrapMsg("room not found", "roomID", invitation.RoomID)
+		}
+		return nil, err
+	}
+	return signal, nil
+}
+
+func excludeUserIDs(userIDs []string, exclude ...string) []string {
+	return datautil.Filter(userIDs, func(userID string) (string, bool) {
+		return userID, !datautil.Contain(userID, exclude...)
+	})
+}
+
+func signalStateErr(err error, msg string) error {
+	if IsNotFound(err) {
+		return servererrs.ErrSignalStateMismatch.WrapMsg(msg)
+	}
+	return err
+}
+
+// sendSignal delivers content as an online only message from sendID to each recvID.
+// The sender's other devices are synced so that they stop ringing as well.
+func (m *msgServer) sendSignal(ctx context.Context, sendID string, recvIDs []string, contentType int32, content proto.Message, offlinePushInfo *sdkws.OfflinePushInfo) {
+	data, err := proto.Marshal(content)
+	if err != nil {
+		log.ZError(ctx, "marshal signal failed", err, "sendID", sendID, "contentType", contentType)
+		return
+	}
+	for _, recvID := range recvIDs {
+		if recvID == sendID {
+			continue
+		}
+		msgData := &sdkws.MsgData{
+			SendID:           sendID,
+			RecvID:           recvID,
+			ClientMsgID:      idutil.GetMsgIDByMD5(sendID),
+			SenderPlatformID: constant.AdminPlatformID,
+			SessionType:      constant.SingleChatType,
+			MsgFrom:          constant.SysMsgType,
+			ContentType:      contentType,
+			Content:          data,
+			CreateTime:       timeutil.GetCurrentTimestampByMill(),
+			Options:          msgprocessor.NewOptions(msgprocessor.WithOfflinePush(offlinePushInfo != nil)),
+			OfflinePushInfo:  offlinePushInfo,
+		}
+		if _, err := m.SendMsg(ctx, &msg.SendMsgReq{MsgData: msgData}); err != nil {
+			log.ZWarn(ctx, "send signal failed", err, "sendID", sendID, "recvID", recvID, "contentType", contentType)
+		}
+	}
+}
+
+func convertSignalInvitation(signal *relation.SignalModel) *rtc.InvitationInfo {
+	return &rtc.InvitationInfo{
+		InviterUserID:     signal.InviterUserID,
+		InviteeUserIDList: signal.InviteeUserIDs(),
+		CustomData:        signal.CustomData,
+		GroupID:           signal.GroupID,
+		RoomID:            signal.RoomID,
+		Timeout:           signal.Timeout,
+		MediaType:         signal.MediaType,
+		PlatformID:        signal.PlatformID,
+		SessionType:       signal.SessionType,
+		InitiateTime:      signal.InitiateTime.UnixMilli(),
+	}
+}
+
+func signalOfflinePushInfo(signal *relation.SignalModel) *sdkws.OfflinePushInfo {
+	if signal.PushTitle == "" && signal.PushDesc == "" && signal.PushEx == "" {
+		return nil
+	}
+	return &sdkws.OfflinePushInfo{Title: signal.PushTitle, Desc: signal.PushDesc, Ex: signal.PushEx}
+}
+
+// checkSignalMember returns nil if the op user is an app manager or a member of the room.
+func (m *msgServer) checkSignalMember(ctx context.Context, signal *relation.SignalModel) error {
+	if authverify.IsAppManagerUid(ctx, m.config.Share.IMAdminUserID) {
+		return nil
+	}
+	opUserID := mcontext.GetOpUserID(ctx)
+	for _, member := range signal.Members {
+		if member.UserID == opUserID {
+			return nil
+		}
+	}
+	return errs.ErrNoPermission.WrapMsg("not a member of the call", "roomID", signal.RoomID)
+}
+
+func (m *msgServer) signalParticipants(ctx context.Context, signal *relation.SignalModel) ([]*rtc.ParticipantMetaData, error) {
+	userIDs := signal.MemberUserIDs(relation.SignalMemberAccepted)
+	users, err := m.UserLocalCache.GetUsersInfoMap(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		groupInfo *sdkws.GroupInfo
+		members   map[string]*sdkws.GroupMemberFullInfo
+	)
+	if signal.GroupID != "" {
+		groupInfo, err = m.GroupLocalCache.GetGroupInfo(ctx, signal.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		members, err = m.GroupLocalCache.GetGroupMemberInfoMap(ctx, signal.GroupID, userIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	participants := make([]*rtc.ParticipantMetaData, 0, len(userIDs))
+	for _, userID := range userIDs {
+		participant := &rtc.ParticipantMetaData{GroupInfo: groupInfo, GroupMemberInfo: members[userID]}
+		if user, ok := users[userID]; ok {
+			participant.UserInfo = &sdkws.PublicUserInfo{UserID: user.UserID, Nickname: user.Nickname, FaceURL: user.FaceURL, Ex: user.Ex}
+		}
+		participants = append(participants, participant)
+	}
+	return participants, nil
+}
+
+func (m *msgServer) SignalGetRoomByGroupID(ctx context.Context, req *rtc.SignalGetRoomByGroupIDReq) (*rtc.SignalGetRoomByGroupIDResp, error) {
+	if req.GroupID == "" {
+		return nil, errs.ErrArgs.WrapMsg("groupID is empty")
+	}
+	if !authverify.IsAppManagerUid(ctx, m.config.Share.IMAdminUserID) {
+		if _, err := m.GroupLocalCache.GetGroupMember(ctx, req.GroupID, mcontext.GetOpUserID(ctx)); err != nil {
+			return nil, err
+		}
+	}
+	signal, err := m.SignalDatabase.TakeActiveSignalByGroupID(ctx, req.GroupID)
+	if err != nil {
+		if IsNotFound(err) {
+			return &rtc.SignalGetRoomByGroupIDResp{}, nil
+		}
+		return nil, err
+	}
+	participants, err := m.signalParticipants(ctx, signal)
+	if err != nil {
+		return nil, err
+	}
+	return &rtc.SignalGetRoomByGroupIDResp{Invitation: convertSignalInvitation(signal), Participant: participants, RoomID: signal.RoomID}, nil
+}
+
+func (m *msgServer) SignalGetTokenByRoomID(ctx context.Context, req *rtc.SignalGetTokenByRoomIDReq) (*rtc.SignalGetTokenByRoomIDResp, error) {
+	return nil, errs.ErrArgs.WrapMsg("getTokenByRoomID is not supported, no media server is configured")
+}
+
+func (m *msgServer) SignalGetRooms(ctx context.Context, req *rtc.SignalGetRoomsReq) (*rtc.SignalGetRoomsResp, error) {
+	if len(req.RoomIDs) == 0 {
+		return nil, errs.ErrArgs.WrapMsg("roomIDs is empty")
+	}
+	signals, err := m.SignalDatabase.FindSignals(ctx, datautil.Distinct(req.RoomIDs))
+	if err != nil {
+		return nil, err
+	}
+	resp := &rtc.SignalGetRoomsResp{RoomList: make([]*rtc.SignalGetRoomByGroupIDResp, 0, len(signals))}
+	for _, signal := range signals {
+		if m.checkSignalMember(ctx, signal) != nil {
+			continue
+		}
+		participants, err := m.signalParticipants(ctx, signal)
+		if err != nil {
+			return nil, err
+		}
+		resp.RoomList = append(resp.RoomList, &rtc.SignalGetRoomByGroupIDResp{Invitation: convertSignalInvitation(signal), Participant: participants, RoomID: signal.RoomID})
+	}
+	return resp, nil
+}
+
+func (m *msgServer) GetSignalInvitationInfo(ctx context.Context, req *rtc.GetSignalInvitationInfoReq) (*rtc.GetSignalInvitationInfoResp, error) {
+	if req.RoomID == "" {
+		return nil, errs.ErrArgs.WrapMsg("roomID is empty")
+	}
+	signal, err := m.SignalDatabase.TakeSignal(ctx, req.RoomID)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.checkSignalMember(ctx, signal); err != nil {
+		return nil, err
+	}
+	return &rtc.GetSignalInvitationInfoResp{InvitationInfo: convertSignalInvitation(signal), OfflinePushInfo: signalOfflinePushInfo(signal)}, nil
+}
+
+// GetSignalInvitationInfoStartApp returns the newest invitation still ringing for the user,
+// so that a client started from an offline push can show the incoming call.
+func (m *msgServer) GetSignalInvitationInfoStartApp(ctx context.Context, req *rtc.GetSignalInvitationInfoStartAppReq) (*rtc.GetSignalInvitationInfoStartAppResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	signals, err := m.SignalDatabase.FindRingingSignals(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if len(signals) == 0 {
+		return &rtc.GetSignalInvitationInfoStartAppResp{}, nil
+	}
+	return &rtc.GetSignalInvitationInfoStartAppResp{Invitation: convertSignalInvitation(signals[0]), OfflinePushInfo: signalOfflinePushInfo(signals[0])}, nil
+}
+
+func (m *msgServer) SignalSendCustomSignal(ctx context.Context, req *rtc.SignalSendCustomSignalReq) (*rtc.SignalSendCustomSignalResp, error) {
+	if req.RoomID == "" {
+		return nil, errs.ErrArgs.WrapMsg("roomID is empty")
+	}
+	signal, err := m.SignalDatabase.TakeSignal(ctx, req.RoomID)
+	if err != nil {
+		return nil, err
+	}
+	opUserID := mcontext.GetOpUserID(ctx)
+	if !datautil.Contain(opUserID, signal.MemberUserIDs(relation.SignalMemberRinging, relation.SignalMemberAccepted)...) {
+		return nil, errs.ErrNoPermission.WrapMsg("not in the call", "roomID", req.RoomID)
+	}
+	m.sendSignal(ctx, opUserID, signal.MemberUserIDs(relation.SignalMemberRinging, relation.SignalMemberAccepted), constant.CustomSignalNotification, req, nil)
+	return &rtc.SignalSendCustomSignalResp{}, nil
+}
+
+func (m *msgServer) GetSignalInvitationRecords(ctx context.Context, req *rtc.GetSignalInvitationRecordsReq) (*rtc.GetSignalInvitationRecordsResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	var start, end time.Time
+	if req.StartTime > 0 {
+		start = time.UnixMilli(req.StartTime)
+	}
+	if req.EndTime > 0 {
+		end = time.UnixMilli(req.EndTime)
+	}
+	total, signals, err := m.SignalDatabase.SearchSignals(ctx, req.SessionType, req.SendID, req.RecvID, start, end, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	var userIDs, groupIDs []string
+	for _, signal := range signals {
+		for _, member := range signal.Members {
+			userIDs = append(userIDs, member.UserID)
+		}
+		if signal.GroupID != "" {
+			groupIDs = append(groupIDs, signal.GroupID)
+		}
+	}
+	users, err := m.UserLocalCache.GetUsersInfoMap(ctx, datautil.Distinct(userIDs))
+	if err != nil {
+		log.ZWarn(ctx, "get signal record users failed", err)
+		users = map[string]*sdkws.UserInfo{}
+	}
+	groupNames := make(map[string]string)
+	if len(groupIDs) > 0 {
+		groups, err := m.GroupLocalCache.GetGroupInfos(ctx, datautil.Distinct(groupIDs))
+		if err != nil {
+			log.ZWarn(ctx, "get signal record groups failed", err)
+		}
+		for _, group := range groups {
+			groupNames[group.GroupID] = group.GroupName
+		}
+	}
+	nickname := func(userID string) string {
+		if user, ok := users[userID]; ok {
+			return user.Nickname
+		}
+		return ""
+	}
+	records := make([]*rtc.SignalRecord, 0, len(signals))
+	for _, signal := range signals {
+		record := &rtc.SignalRecord{
+			RoomID:              signal.RoomID,
+			SID:                 signal.RoomID,
+			MediaType:           signal.MediaType,
+			SessionType:         signal.SessionType,
+			InviterUserID:       signal.InviterUserID,
+			InviterUserNickname: nickname(signal.InviterUserID),
+			GroupID:             signal.GroupID,
+			GroupName:           groupNames[signal.GroupID],
+			CreateTime:          signal.InitiateTime.UnixMilli(),
+		}
+		if !signal.EndTime.IsZero() {
+			record.EndTime = signal.EndTime.UnixMilli()
+		}
+		for _, member := range signal.Members {
+			if member.UserID == signal.InviterUserID {
+				continue
+			}
+			record.InviterUsers = append(record.InviterUsers, &rtc.SignalUser{UserID: member.UserID, Nickname: nickname(member.UserID), Status: member.Status})
+		}
+		records = append(records, record)
+	}
+	return &rtc.GetSignalInvitationRecordsResp{Total: int32(total), SignalRecords: records}, nil
+}
+
+func (m *msgServer) DeleteSignalRecords(ctx context.Context, req *rtc.DeleteSignalRecordsReq) (*rtc.DeleteSignalRecordsResp, error) {
+	if err := authverify.CheckAdmin(ctx, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if len(req.SIDs) == 0 {
+		return nil, errs.ErrArgs.WrapMsg("sIDs is empty")
+	}
+	if err := m.SignalDatabase.DeleteSignals(ctx, req.SIDs); err != nil {
+		return nil, err
+	}
+	return &rtc.DeleteSignalRecordsResp{}, nil
+}
+
+// signalTimeoutLoop periodically expires invitations that were not answered in time.
+// Every msg instance runs it; the conditional update in TimeoutSignal makes sure each
+// expired invitation is handled by exactly one of them.
+func (m *msgServer) signalTimeoutLoop(ctx context.Context) {
+	interval := time.Duration(m.config.RpcConfig.Signal.CheckInterval) * time.Second
+	if interval <= 0 {
+		interval = defaultSignalCheckInterval * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.expireSignals(mcontext.SetOperationID(context.Background(), idutil.OperationIDGenerator()))
+		}
+	}
+}
+
+// expireSignals marks ringing members of expired rooms as timed out and notifies them and the
+// remaining participants with a SignalCancelReq whose userID is empty and whose invitation lists
+// the timed out invitees.
+func (m *msgServer) expireSignals(ctx context.Context) {
+	for {
+		before, err := m.SignalDatabase.TimeoutSignal(ctx, time.Now())
+		if err != nil {
+			if !IsNotFound(err) {
+				log.ZError(ctx, "timeout signal failed", err)
+			}
+			return
+		}
+		timeout := before.MemberUserIDs(relation.SignalMemberRinging)
+		if before.Status == relation.SignalStatusInviting {
+			_, err = m.SignalDatabase.FinishSignal(ctx, before.RoomID, []int32{relation.SignalStatusInviting}, 1, relation.SignalStatusTimeout, relation.SignalMemberTimeout)
+		} else {
+			_, err = m.SignalDatabase.FinishSignal(ctx, before.RoomID, []int32{relation.SignalStatusOngoing}, 1, relation.SignalStatusEnded, relation.SignalMemberTimeout)
+		}
+		if err != nil && !IsNotFound(err) {
+			log.ZError(ctx, "finish signal failed", err, "roomID", before.RoomID)
+		}
+		log.ZInfo(ctx, "signal ring timeout", "roomID", before.RoomID, "userIDs", timeout)
+		if len(timeout) == 0 {
+			continue
+		}
+		invitation := convertSignalInvitation(before)
+		invitation.InviteeUserIDList = timeout
+		signalReq := &rtc.SignalReq{Payload: &rtc.SignalReq_Cancel{Cancel: &rtc.SignalCancelReq{Invitation: invitation}}}
+		m.sendSignal(mcontext.WithOpUserIDContext(ctx, before.InviterUserID), before.InviterUserID, timeout, constant.SignalingNotification, signalReq, nil)
+		if before.Status == relation.SignalStatusInviting {
+			// 无人接听，同时通知邀请者结束振铃
+			m.sendSignal(mcontext.WithOpUserIDContext(ctx, timeout[0]), timeout[0], []string{before.InviterUserID}, constant.SignalingNotification, signalReq, nil)
+		}
+	}
+}
@@ -0,0 +1,272 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/authverify"
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/aetim/pkg/common/servererrs"
+	"github.com/Meikwei/aetim/pkg/msgprocessor"
+	"github.com/Meikwei/aetim/pkg/protocol/msgext"
+	"github.com/Meikwei/aetim/pkg/util/sensitive"
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/Meikwei/go-tools/log"
+	"github.com/Meikwei/go-tools/utils/datautil"
+	"github.com/Meikwei/protocol/constant"
+	pbmsg "github.com/Meikwei/protocol/msg"
+	"github.com/Meikwei/protocol/sdkws"
+	"github.com/redis/go-redis/v9"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func (m *msgServer) CreateThread(ctx context.Context, req *msgext.CreateThreadReq) (*msgext.CreateThreadResp, error) {
+	if msgprocessor.IsNotification(req.ConversationID) {
+		return nil, errs.ErrArgs.WrapMsg("notification conversation not support thread")
+	}
+	root, err := m.getMemberMsg(ctx, req.UserID, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	if root.SessionType != constant.ReadGroupChatType {
+		return nil, errs.ErrArgs.WrapMsg("thread only supported in group chat", "sessionType", root.SessionType)
+	}
+	thread := &relation.ThreadModel{
+		ThreadID:        msgprocessor.GetThreadID(req.ConversationID, req.Seq),
+		ConversationID:  req.ConversationID,
+		RootSeq:         req.Seq,
+		RootClientMsgID: root.ClientMsgID,
+		SessionType:     root.SessionType,
+		GroupID:         root.GroupID,
+		CreatorUserID:   req.UserID,
+		CreateTime:      time.Now(),
+	}
+	if err := m.ThreadDatabase.CreateThread(ctx, thread); err != nil {
+		if !mongo.IsDuplicateKeyError(errs.Unwrap(err)) {
+			return nil, err
+		}
+		// 线程已存在，返回已有的线程
+		thread, err = m.ThreadDatabase.TakeThread(ctx, thread.ThreadID)
+		if err != nil {
+			return nil, err
+		}
+	} else if err := m.MsgDatabase.SetMsgThread(ctx, req.ConversationID, root, &relation.MsgThreadModel{ThreadID: thread.ThreadID}); err != nil {
+		return nil, err
+	}
+	if err := m.ThreadDatabase.SubscribeThread(ctx, thread.ThreadID, req.UserID, true); err != nil {
+		return nil, err
+	}
+	return &msgext.CreateThreadResp{Thread: convertThreadInfo(thread)}, nil
+}
+
+// SendThreadMsg stores a reply in the thread under the next thread seq, updates the reply metadata of
+// the thread and its root message, and notifies the group.
+func (m *msgServer) SendThreadMsg(ctx context.Context, req *msgext.SendThreadMsgReq) (*msgext.SendThreadMsgResp, error) {
+	msgData := req.MsgData
+	if err := authverify.CheckAccessV3(ctx, msgData.SendID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	thread, err := m.takeThread(ctx, req.ThreadID)
+	if err != nil {
+		return nil, err
+	}
+	msgData.SessionType = thread.SessionType
+	msgData.GroupID = thread.GroupID
+	msgData.RecvID = ""
+	m.encapsulateMsgData(msgData)
+	if err := m.messageVerification(ctx, &pbmsg.SendMsgReq{MsgData: msgData}); err != nil {
+		return nil, err
+	}
+	policy, err := m.checkSensitive(ctx, msgData)
+	if err != nil {
+		return nil, err
+	}
+	// 线程消息需要分配seq，不支持只投递给发送者
+	if policy == sensitive.PolicySenderOnly {
+		return nil, servererrs.ErrMsgSensitiveWord.WrapMsg("msg contains sensitive words")
+	}
+	if err := m.MsgDatabase.AppendMsgs(ctx, thread.ThreadID, []*sdkws.MsgData{msgData}); err != nil {
+		return nil, err
+	}
+	thread, err = m.ThreadDatabase.IncrThreadReply(ctx, thread.ThreadID, msgData.Seq, msgData.SendID, time.UnixMilli(msgData.SendTime))
+	if err != nil {
+		return nil, err
+	}
+	if err := m.ThreadDatabase.SubscribeThread(ctx, thread.ThreadID, msgData.SendID, true); err != nil {
+		log.ZWarn(ctx, "subscribe thread failed", err, "threadID", thread.ThreadID, "userID", msgData.SendID)
+	}
+	m.updateThreadRoot(ctx, thread)
+	tips := msgext.ThreadReplyTips{Thread: convertThreadInfo(thread), MsgData: msgData}
+	m.notificationSender.NotificationWithSessionType(ctx, msgData.SendID, thread.GroupID, msgext.ThreadReplyNotification, thread.SessionType, &tips)
+	return &msgext.SendThreadMsgResp{
+		ServerMsgID: msgData.ServerMsgID,
+		ClientMsgID: msgData.ClientMsgID,
+		Seq:         msgData.Seq,
+		SendTime:    msgData.SendTime,
+	}, nil
+}
+
+// updateThreadRoot copies the reply metadata of thread to its root message. Failures only leave the
+// summary on the root message stale, the thread itself stays authoritative.
+func (m *msgServer) updateThreadRoot(ctx context.Context, thread *relation.ThreadModel) {
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, "", thread.ConversationID, []int64{thread.RootSeq})
+	if err != nil {
+		log.ZWarn(ctx, "get thread root msg failed", err, "threadID", thread.ThreadID)
+		return
+	}
+	if len(msgs) == 0 || msgs[0] == nil {
+		return
+	}
+	msgThread := &relation.MsgThreadModel{
+		ThreadID:        thread.ThreadID,
+		ReplyCount:      thread.ReplyCount,
+		LastReplySeq:    thread.LastReplySeq,
+		LastReplyUserID: thread.LastReplyUserID,
+		LastReplyTime:   thread.LastReplyTime.UnixMilli(),
+	}
+	if err := m.MsgDatabase.SetMsgThread(ctx, thread.ConversationID, msgs[0], msgThread); err != nil {
+		log.ZWarn(ctx, "set thread root msg failed", err, "threadID", thread.ThreadID)
+	}
+}
+
+func (m *msgServer) PullThreadMsgs(ctx context.Context, req *msgext.PullThreadMsgsReq) (*msgext.PullThreadMsgsResp, error) {
+	if _, err := m.takeMemberThread(ctx, req.UserID, req.ThreadID); err != nil {
+		return nil, err
+	}
+	minSeq, maxSeq, msgs, err := m.MsgDatabase.GetMsgBySeqsRange(ctx, req.UserID, req.ThreadID, req.BeginSeq, req.EndSeq, req.Num, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.PullThreadMsgsResp{Msgs: msgs, MinSeq: minSeq, MaxSeq: maxSeq}, nil
+}
+
+func (m *msgServer) SubscribeThread(ctx context.Context, req *msgext.SubscribeThreadReq) (*msgext.SubscribeThreadResp, error) {
+	if _, err := m.takeMemberThread(ctx, req.UserID, req.ThreadID); err != nil {
+		return nil, err
+	}
+	if err := m.ThreadDatabase.SubscribeThread(ctx, req.ThreadID, req.UserID, req.Subscribe); err != nil {
+		return nil, err
+	}
+	return &msgext.SubscribeThreadResp{}, nil
+}
+
+func (m *msgServer) MarkThreadAsRead(ctx context.Context, req *msgext.MarkThreadAsReadReq) (*msgext.MarkThreadAsReadResp, error) {
+	if _, err := m.takeMemberThread(ctx, req.UserID, req.ThreadID); err != nil {
+		return nil, err
+	}
+	maxSeq, err := m.MsgDatabase.GetMaxSeq(ctx, req.ThreadID)
+	if err != nil && errs.Unwrap(err) != redis.Nil {
+		return nil, err
+	}
+	if req.HasReadSeq > maxSeq {
+		return nil, errs.ErrArgs.WrapMsg("hasReadSeq must not be larger than maxSeq", "maxSeq", maxSeq)
+	}
+	if err := m.MsgDatabase.SetHasReadSeq(ctx, req.UserID, req.ThreadID, req.HasReadSeq); err != nil {
+		return nil, err
+	}
+	return &msgext.MarkThreadAsReadResp{}, nil
+}
+
+func (m *msgServer) GetUserThreads(ctx context.Context, req *msgext.GetUserThreadsReq) (*msgext.GetUserThreadsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	total, members, err := m.ThreadDatabase.FindSubscribedThreads(ctx, req.UserID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	threadIDs := datautil.Slice(members, func(member *relation.ThreadMemberModel) string { return member.ThreadID })
+	resp := &msgext.GetUserThreadsResp{Total: total, Threads: make([]*msgext.UserThread, 0, len(threadIDs))}
+	if len(threadIDs) == 0 {
+		return resp, nil
+	}
+	threads, err := m.ThreadDatabase.FindThreads(ctx, threadIDs)
+	if err != nil {
+		return nil, err
+	}
+	maxSeqs, err := m.MsgDatabase.GetMaxSeqs(ctx, threadIDs)
+	if err != nil {
+		return nil, err
+	}
+	hasReadSeqs, err := m.MsgDatabase.GetHasReadSeqs(ctx, req.UserID, threadIDs)
+	if err != nil {
+		return nil, err
+	}
+	threadMap := datautil.SliceToMap(threads, func(thread *relation.ThreadModel) string { return thread.ThreadID })
+	for _, threadID := range threadIDs {
+		thread, ok := threadMap[threadID]
+		if !ok {
+			continue
+		}
+		userThread := &msgext.UserThread{
+			Thread:     convertThreadInfo(thread),
+			MaxSeq:     maxSeqs[threadID],
+			HasReadSeq: hasReadSeqs[threadID],
+		}
+		if userThread.MaxSeq > userThread.HasReadSeq {
+			userThread.UnreadCount = userThread.MaxSeq - userThread.HasReadSeq
+		}
+		resp.Threads = append(resp.Threads, userThread)
+	}
+	return resp, nil
+}
+
+func (m *msgServer) takeThread(ctx context.Context, threadID string) (*relation.ThreadModel, error) {
+	thread, err := m.ThreadDatabase.TakeThread(ctx, threadID)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, errs.ErrRecordNotFound.WrapMsg("thread not found", "threadID", threadID)
+		}
+		return nil, err
+	}
+	return thread, nil
+}
+
+// takeMemberThread loads the thread after checking that the user is a member of the conversation of its root message.
+func (m *msgServer) takeMemberThread(ctx context.Context, userID, threadID string) (*relation.ThreadModel, error) {
+	if err := authverify.CheckAccessV3(ctx, userID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	thread, err := m.takeThread(ctx, threadID)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.checkConversationMember(ctx, userID, thread.SessionType, "", "", thread.GroupID); err != nil {
+		return nil, err
+	}
+	return thread, nil
+}
+
+func convertThreadInfo(thread *relation.ThreadModel) *msgext.ThreadInfo {
+	res := &msgext.ThreadInfo{
+		ThreadID:        thread.ThreadID,
+		ConversationID:  thread.ConversationID,
+		RootSeq:         thread.RootSeq,
+		RootClientMsgID: thread.RootClientMsgID,
+		SessionType:     thread.SessionType,
+		GroupID:         thread.GroupID,
+		CreatorUserID:   thread.CreatorUserID,
+		ReplyCount:      thread.ReplyCount,
+		LastReplySeq:    thread.LastReplySeq,
+		LastReplyUserID: thread.LastReplyUserID,
+		CreateTime:      thread.CreateTime.UnixMilli(),
+	}
+	if !thread.LastReplyTime.IsZero() {
+		res.LastReplyTime = thread.LastReplyTime.UnixMilli()
+	}
+	return res
+}
@@ -0,0 +1,138 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+
+	"github.com/Meikwei/aetim/pkg/authverify"
+	"github.com/Meikwei/aetim/pkg/protocol/msgext"
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/Meikwei/go-tools/log"
+	"github.com/Meikwei/protocol/constant"
+	"github.com/redis/go-redis/v9"
+)
+
+func (m *msgServer) GetUnreadCount(ctx context.Context, req *msgext.GetUnreadCountReq) (*msgext.GetUnreadCountResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	total, counts, err := m.getUnreadCount(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.GetUnreadCountResp{Total: total, ConversationUnreads: counts}, nil
+}
+
+// unreadRebuildWorkers is the number of unread counters rebuilt at the same time off the push path.
+const unreadRebuildWorkers = 10
+
+// GetUsersUnreadCount returns the unread totals used as badges by offline pushes. It is on the push path, so users
+// without a counter are left out and their counters are rebuilt in the background.
+func (m *msgServer) GetUsersUnreadCount(ctx context.Context, req *msgext.GetUsersUnreadCountReq) (*msgext.GetUsersUnreadCountResp, error) {
+	totals, err := m.UnreadCountDatabase.GetUnreadTotals(ctx, req.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, userID := range req.UserIDs {
+		if _, ok := totals[userID]; !ok {
+			m.rebuildUnreadCountAsync(ctx, userID)
+		}
+	}
+	return &msgext.GetUsersUnreadCountResp{Totals: totals}, nil
+}
+
+// rebuildUnreadCountAsync rebuilds the missing counter of the user in the background, once at a time per user.
+func (m *msgServer) rebuildUnreadCountAsync(ctx context.Context, userID string) {
+	if _, loaded := m.unreadRebuilds.LoadOrStore(userID, struct{}{}); loaded {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer m.unreadRebuilds.Delete(userID)
+		m.unreadRebuildLimiter <- struct{}{}
+		defer func() { <-m.unreadRebuildLimiter }()
+		if _, _, err := m.rebuildUnreadCount(ctx, userID); err != nil {
+			log.ZWarn(ctx, "rebuild unread count failed", err, "userID", userID)
+		}
+	}()
+}
+
+// getUnreadCount returns the unread counter of the user, rebuilding it when it doesn't exist.
+func (m *msgServer) getUnreadCount(ctx context.Context, userID string) (int64, map[string]int64, error) {
+	total, counts, err := m.UnreadCountDatabase.GetUnreadCount(ctx, userID)
+	if err == nil {
+		return total, counts, nil
+	}
+	if errs.Unwrap(err) != redis.Nil {
+		return 0, nil, err
+	}
+	return m.rebuildUnreadCount(ctx, userID)
+}
+
+// rebuildUnreadCount computes the unread count of each conversation of the user from the seqs, the same way as
+// GetConversationsHasReadAndMaxSeq, and stores the counter. Conversations not received normally are left out of the total.
+func (m *msgServer) rebuildUnreadCount(ctx context.Context, userID string) (int64, map[string]int64, error) {
+	conversationIDs, err := m.ConversationLocalCache.GetConversationIDs(ctx, userID)
+	if err != nil {
+		return 0, nil, err
+	}
+	conversations, err := m.ConversationLocalCache.GetConversations(ctx, userID, conversationIDs)
+	if err != nil {
+		return 0, nil, err
+	}
+	hasReadSeqs, err := m.MsgDatabase.GetHasReadSeqs(ctx, userID, conversationIDs)
+	if err != nil {
+		return 0, nil, err
+	}
+	maxSeqs, err := m.MsgDatabase.GetMaxSeqs(ctx, conversationIDs)
+	if err != nil {
+		return 0, nil, err
+	}
+	var (
+		total int64
+		muted []string
+	)
+	counts := make(map[string]int64, len(conversations))
+	for _, conversation := range conversations {
+		maxSeq := maxSeqs[conversation.ConversationID]
+		if conversation.MaxSeq != 0 {
+			maxSeq = conversation.MaxSeq
+		}
+		count := max(maxSeq-hasReadSeqs[conversation.ConversationID], 0)
+		counts[conversation.ConversationID] = count
+		if conversation.RecvMsgOpt != constant.ReceiveMessage {
+			muted = append(muted, conversation.ConversationID)
+			continue
+		}
+		total += count
+	}
+	if err := m.UnreadCountDatabase.InitUnreadCount(ctx, userID, counts, muted); err != nil {
+		return 0, nil, err
+	}
+	return total, counts, nil
+}
+
+// updateUnreadCount sets the unread count of the conversation after the has read seq of the user changed.
+func (m *msgServer) updateUnreadCount(ctx context.Context, userID string, conversationID string, hasReadSeq int64) {
+	maxSeq, err := m.MsgDatabase.GetMaxSeq(ctx, conversationID)
+	if err != nil {
+		log.ZWarn(ctx, "get max seq for unread count failed", err, "conversationID", conversationID)
+		return
+	}
+	if err := m.UnreadCountDatabase.SetUnreadCount(ctx, userID, conversationID, maxSeq-hasReadSeq); err != nil {
+		log.ZWarn(ctx, "set unread count failed", err, "userID", userID, "conversationID", conversationID)
+	}
+}
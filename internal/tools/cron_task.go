@@ -16,13 +16,22 @@ package tools
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
 	"github.com/Meikwei/aetim/pkg/common/config"
+	"github.com/Meikwei/aetim/pkg/common/db/controller"
+	"github.com/Meikwei/aetim/pkg/common/db/mgo"
+	kdisc "github.com/Meikwei/aetim/pkg/common/discoveryregister"
+	"github.com/Meikwei/aetim/pkg/rpcclient"
+	"github.com/Meikwei/go-tools/db/mongoutil"
 	"github.com/Meikwei/go-tools/db/redisutil"
+	"github.com/Meikwei/go-tools/mw"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/Meikwei/go-tools/errs"
 	"github.com/Meikwei/go-tools/log"
@@ -31,12 +40,14 @@ import (
 )
 
 type CronTaskConfig struct {
-	CronTask        config.CronTask
-	RedisConfig     config.Redis
-	MongodbConfig   config.Mongo
-	ZookeeperConfig config.ZooKeeper
-	Share           config.Share
-	KafkaConfig     config.Kafka
+	CronTask           config.CronTask
+	RedisConfig        config.Redis
+	MongodbConfig      config.Mongo
+	ZookeeperConfig    config.ZooKeeper
+	Share              config.Share
+	KafkaConfig        config.Kafka
+	NotificationConfig config.Notification
+	LocalCacheConfig   config.LocalCache
 }
 
 func Start(ctx context.Context, config *CronTaskConfig) error {
@@ -44,13 +55,15 @@ func Start(ctx context.Context, config *CronTaskConfig) error {
 	log.CInfo(ctx, "CRON-TASK server is initializing", "chatRecordsClearTime",
 		config.CronTask.ChatRecordsClearTime, "msgDestructTime", config.CronTask.MsgDestructTime)
 
+	if err := startMsgDispatchers(ctx, config); err != nil {
+		return err
+	}
+
 	msgTool, err := InitMsgTool(ctx, config)
 	if err != nil {
 		return err
 	}
 
-	msgTool.convertTools()
-
 	rdb, err := redisutil.NewRedisClient(ctx, config.RedisConfig.Build())
 	if err != nil {
 		return err
@@ -92,6 +105,33 @@ func Start(ctx context.Context, config *CronTaskConfig) error {
 	return nil
 }
 
+// startMsgDispatchers starts handing due scheduled messages and expired self-destruct messages to the msg rpc.
+func startMsgDispatchers(ctx context.Context, config *CronTaskConfig) error {
+	mgocli, err := mongoutil.NewMongoDB(ctx, config.MongodbConfig.Build())
+	if err != nil {
+		return err
+	}
+	scheduledMsgModel, err := mgo.NewScheduledMsgMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	msgDestructModel, err := mgo.NewMsgDestructMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	discov, err := kdisc.NewDiscoveryRegister(&config.ZookeeperConfig, &config.Share)
+	if err != nil {
+		return err
+	}
+	discov.AddOption(mw.GrpcClient(), grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"LoadBalancingPolicy": "%s"}`, "round_robin")))
+	msgRpcClient := rpcclient.NewMessageRpcClient(discov, config.Share.RpcRegisterName.Msg)
+	dispatcher := newScheduledMsgDispatcher(controller.NewScheduledMsgDatabase(scheduledMsgModel), &msgRpcClient, config)
+	go dispatcher.run(ctx)
+	go newMsgDestructDispatcher(controller.NewMsgDestructDatabase(msgDestructModel), &msgRpcClient, config).run(ctx)
+	return nil
+}
+
 // netlock redis lock.
 func netlock(rdb redis.UniversalClient, key string, ttl time.Duration) bool {
 	value := "used"
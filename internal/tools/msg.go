@@ -22,14 +22,24 @@ import (
 
 	"github.com/Meikwei/aetim/internal/rpc/msg"
 
+	"github.com/Meikwei/aetim/pkg/common/db/cache"
 	"github.com/Meikwei/aetim/pkg/common/db/controller"
+	"github.com/Meikwei/aetim/pkg/common/db/mgo"
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	kdisc "github.com/Meikwei/aetim/pkg/common/discoveryregister"
+	"github.com/Meikwei/aetim/pkg/rpcclient"
 	"github.com/Meikwei/aetim/pkg/util/conversationutil"
+	"github.com/Meikwei/go-tools/db/mongoutil"
+	"github.com/Meikwei/go-tools/db/redisutil"
 	"github.com/Meikwei/go-tools/errs"
 	"github.com/Meikwei/go-tools/log"
 	"github.com/Meikwei/go-tools/mcontext"
+	"github.com/Meikwei/go-tools/mw"
 	"github.com/Meikwei/go-tools/utils/stringutil"
 	"github.com/Meikwei/protocol/sdkws"
 	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 type MsgTool struct {
@@ -37,12 +47,15 @@ type MsgTool struct {
 	conversationDatabase  controller.ConversationDatabase
 	userDatabase          controller.UserDatabase
 	groupDatabase         controller.GroupDatabase
+	retentionDatabase     controller.RetentionDatabase
+	msgSearchDatabase     controller.MsgSearchDatabase
 	msgNotificationSender *msg.MsgNotificationSender
 	config                *CronTaskConfig
 }
 
 func NewMsgTool(msgDatabase controller.CommonMsgDatabase, userDatabase controller.UserDatabase,
 	groupDatabase controller.GroupDatabase, conversationDatabase controller.ConversationDatabase,
+	retentionDatabase controller.RetentionDatabase, msgSearchDatabase controller.MsgSearchDatabase,
 	msgNotificationSender *msg.MsgNotificationSender, config *CronTaskConfig,
 ) *MsgTool {
 	return &MsgTool{
@@ -50,69 +63,81 @@ func NewMsgTool(msgDatabase controller.CommonMsgDatabase, userDatabase controlle
 		userDatabase:          userDatabase,
 		groupDatabase:         groupDatabase,
 		conversationDatabase:  conversationDatabase,
+		retentionDatabase:     retentionDatabase,
+		msgSearchDatabase:     msgSearchDatabase,
 		msgNotificationSender: msgNotificationSender,
 		config:                config,
 	}
 }
 
 func InitMsgTool(ctx context.Context, config *CronTaskConfig) (*MsgTool, error) {
-	ch := make(chan int)
-	<-ch
-	//mgocli, err := mongoutil.NewMongoDB(ctx, config.MongodbConfig.Build())
-	//if err != nil {
-	//	return nil, err
-	//}
-	//rdb, err := redisutil.NewRedisClient(ctx, config.RedisConfig.Build())
-	//if err != nil {
-	//	return nil, err
-	//}
-	//discov, err := kdisc.NewDiscoveryRegister(&config.ZookeeperConfig, &config.Share)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//discov.AddOption(mw.GrpcClient(), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"LoadBalancingPolicy": "%s"}`, "round_robin")))
-	//userDB, err := mgo.NewUserMongo(mgocli.GetDB())
-	//if err != nil {
-	//	return nil, err
-	//}
-	////msgDatabase, err := controller.InitCommonMsgDatabase(rdb, mgocli.GetDB(), config)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//userMongoDB := mgo.NewUserMongoDriver(mgocli.GetDB())
-	//userDatabase := controller.NewUserDatabase(
-	//	userDB,
-	//	cache.NewUserCacheRedis(rdb, userDB, cache.GetDefaultOpt()),
-	//	mgocli.GetTx(),
-	//	userMongoDB,
-	//)
-	//groupDB, err := mgo.NewGroupMongo(mgocli.GetDB())
-	//if err != nil {
-	//	return nil, err
-	//}
-	//groupMemberDB, err := mgo.NewGroupMember(mgocli.GetDB())
-	//if err != nil {
-	//	return nil, err
-	//}
-	//groupRequestDB, err := mgo.NewGroupRequestMgo(mgocli.GetDB())
-	//if err != nil {
-	//	return nil, err
-	//}
-	//conversationDB, err := mgo.NewConversationMongo(mgocli.GetDB())
-	//if err != nil {
-	//	return nil, err
-	//}
-	//groupDatabase := controller.NewGroupDatabase(rdb, groupDB, groupMemberDB, groupRequestDB, mgocli.GetTx(), nil)
-	//conversationDatabase := controller.NewConversationDatabase(
-	//	conversationDB,
-	//	cache.NewConversationRedis(rdb, cache.GetDefaultOpt(), conversationDB),
-	//	mgocli.GetTx(),
-	//)
-	//msgRpcClient := rpcclient.NewMessageRpcClient(discov, config.Share.RpcRegisterName.Msg)
-	//msgNotificationSender := notification.NewMsgNotificationSender(config, rpcclient.WithRpcClient(&msgRpcClient))
-	//msgTool := NewMsgTool(msgDatabase, userDatabase, groupDatabase, conversationDatabase, msgNotificationSender, config)
-	//return msgTool, nil
-	return nil, nil
+	mgocli, err := mongoutil.NewMongoDB(ctx, config.MongodbConfig.Build())
+	if err != nil {
+		return nil, err
+	}
+	rdb, err := redisutil.NewRedisClient(ctx, config.RedisConfig.Build())
+	if err != nil {
+		return nil, err
+	}
+	discov, err := kdisc.NewDiscoveryRegister(&config.ZookeeperConfig, &config.Share)
+	if err != nil {
+		return nil, err
+	}
+	discov.AddOption(mw.GrpcClient(), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"LoadBalancingPolicy": "%s"}`, "round_robin")))
+	userDB, err := mgo.NewUserMongo(mgocli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	msgDocModel, err := mgo.NewMsgMongo(mgocli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	seqDB, err := mgo.NewSeqMongo(mgocli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	msgDatabase, err := controller.NewCommonMsgDatabase(msgDocModel, cache.NewMsgCache(rdb, config.RedisConfig.EnablePipeline), cache.NewSeqCache(rdb, seqDB, config.RedisConfig.SeqReplicas), &config.KafkaConfig)
+	if err != nil {
+		return nil, err
+	}
+	userDatabase := controller.NewUserDatabase(
+		userDB,
+		cache.NewUserCacheRedis(rdb, &config.LocalCacheConfig, userDB, cache.GetDefaultOpt()),
+		mgocli.GetTx(),
+		mgo.NewUserMongoDriver(mgocli.GetDB()),
+	)
+	groupDB, err := mgo.NewGroupMongo(mgocli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	groupMemberDB, err := mgo.NewGroupMember(mgocli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	groupRequestDB, err := mgo.NewGroupRequestMgo(mgocli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	conversationDB, err := mgo.NewConversationMongo(mgocli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	groupDatabase := controller.NewGroupDatabase(rdb, &config.LocalCacheConfig, groupDB, groupMemberDB, groupRequestDB, mgocli.GetTx(), nil)
+	conversationDatabase := controller.NewConversationDatabase(
+		conversationDB,
+		cache.NewConversationRedis(rdb, &config.LocalCacheConfig, cache.GetDefaultOpt(), conversationDB),
+		mgocli.GetTx(),
+	)
+	retentionDatabase := controller.NewRetentionDatabase(conversationDB, groupDB, controller.DefaultRetention(&config.Share))
+	msgSearchDB, err := mgo.NewMsgSearchIndexMongo(mgocli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	msgRpcClient := rpcclient.NewMessageRpcClient(discov, config.Share.RpcRegisterName.Msg)
+	msgNotificationSender := msg.NewMsgNotificationSender(&msg.Config{NotificationConfig: config.NotificationConfig}, rpcclient.WithRpcClient(&msgRpcClient))
+	msgTool := NewMsgTool(msgDatabase, userDatabase, groupDatabase, conversationDatabase, retentionDatabase,
+		controller.NewMsgSearchDatabase(msgSearchDB), msgNotificationSender, config)
+	return msgTool, nil
 }
 
 // func (c *MsgTool) AllConversationClearMsgAndFixSeq() {
@@ -172,10 +197,15 @@ func (c *MsgTool) AllConversationClearMsgAndFixSeq() {
 }
 
 func (c *MsgTool) ClearConversationsMsg(ctx context.Context, conversationIDs []string) {
+	retentions, err := c.retentionDatabase.FindEffectiveRetentions(ctx, conversationIDs)
+	if err != nil {
+		log.ZError(ctx, "FindEffectiveRetentions failed", err, "conversationIDs", conversationIDs)
+		return
+	}
 	for _, conversationID := range conversationIDs {
-		if err := c.msgDatabase.DeleteConversationMsgsAndSetMinSeq(ctx, conversationID, int64(c.config.CronTask.RetainChatRecords*24*60*60)); err != nil {
-			log.ZError(ctx, "DeleteUserSuperGroupMsgsAndSetMinSeq failed", err, "conversationID",
-				conversationID, "DBRetainChatRecords", c.config.CronTask.RetainChatRecords)
+		if err := c.clearConversationMsg(ctx, conversationID, retentions[conversationID]); err != nil {
+			log.ZError(ctx, "clearConversationMsg failed", err, "conversationID", conversationID,
+				"retention", retentions[conversationID])
 		}
 		if err := c.checkMaxSeq(ctx, conversationID); err != nil {
 			log.ZError(ctx, "fixSeq failed", err, "conversationID", conversationID)
@@ -183,6 +213,36 @@ func (c *MsgTool) ClearConversationsMsg(ctx context.Context, conversationIDs []s
 	}
 }
 
+// clearConversationMsg deletes the messages of a conversation that fall outside its retention,
+// together with their search index entries.
+func (c *MsgTool) clearConversationMsg(ctx context.Context, conversationID string, retention *controller.EffectiveRetention) error {
+	log.ZDebug(ctx, "clearConversationMsg", "conversationID", conversationID, "source", retention.Source,
+		"mode", retention.Retention.Mode, "days", retention.Retention.Days, "count", retention.Retention.Count)
+	var err error
+	switch retention.Retention.Mode {
+	case relation.RetentionDays:
+		err = c.msgDatabase.DeleteConversationMsgsAndSetMinSeq(ctx, conversationID, int64(retention.Retention.Days)*24*60*60)
+	case relation.RetentionCount:
+		err = c.msgDatabase.DeleteConversationMsgsBeforeLastAndSetMinSeq(ctx, conversationID, retention.Retention.Count)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	minSeq, err := c.msgDatabase.GetMinSeq(ctx, conversationID)
+	if err != nil {
+		if errs.Unwrap(err) == redis.Nil {
+			return nil
+		}
+		return err
+	}
+	if minSeq <= 0 {
+		return nil
+	}
+	return c.msgSearchDatabase.DeleteMsgsBefore(ctx, conversationID, minSeq)
+}
+
 func (c *MsgTool) checkMaxSeqWithMongo(ctx context.Context, conversationID string, maxSeqCache int64) error {
 	minSeqMongo, maxSeqMongo, err := c.msgDatabase.GetMongoMaxAndMinSeq(ctx, conversationID)
 	if err != nil {
@@ -0,0 +1,127 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tools
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/controller"
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/aetim/pkg/rpcclient"
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/Meikwei/go-tools/log"
+	"github.com/Meikwei/go-tools/mcontext"
+	"github.com/Meikwei/go-tools/utils/idutil"
+	"github.com/Meikwei/protocol/msg"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	defaultMsgDestructInterval    = 1
+	defaultMsgDestructLease       = 60
+	defaultMsgDestructMaxAttempts = 3
+)
+
+type deleteMsgsFunc func(ctx context.Context, req *msg.DeleteMsgsReq) (*msg.DeleteMsgsResp, error)
+
+// msgDestructDispatcher deletes self-destruct messages whose timers expired through DeleteMsgs,
+// which removes them from mongo and redis and notifies the clients.
+// Timers are claimed through their expire time index with a lease like scheduled messages,
+// so every crontask replica can run one.
+type msgDestructDispatcher struct {
+	db          controller.MsgDestructDatabase
+	deleteMsgs  deleteMsgsFunc
+	interval    time.Duration
+	lease       time.Duration
+	maxAttempts int32
+}
+
+func newMsgDestructDispatcher(db controller.MsgDestructDatabase, msgClient *rpcclient.MessageRpcClient, config *CronTaskConfig) *msgDestructDispatcher {
+	conf := config.CronTask.SelfDestruct
+	d := &msgDestructDispatcher{
+		db:          db,
+		deleteMsgs:  msgClient.DeleteMsgs,
+		interval:    time.Duration(conf.Interval) * time.Second,
+		lease:       time.Duration(conf.Lease) * time.Second,
+		maxAttempts: int32(conf.MaxAttempts),
+	}
+	if d.interval <= 0 {
+		d.interval = defaultMsgDestructInterval * time.Second
+	}
+	if d.lease <= 0 {
+		d.lease = defaultMsgDestructLease * time.Second
+	}
+	if d.maxAttempts <= 0 {
+		d.maxAttempts = defaultMsgDestructMaxAttempts
+	}
+	return d
+}
+
+func (d *msgDestructDispatcher) run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.destructDue(mcontext.SetOperationID(context.Background(), idutil.OperationIDGenerator()))
+		}
+	}
+}
+
+// destructDue claims and handles expired timers one at a time until none is left.
+func (d *msgDestructDispatcher) destructDue(ctx context.Context) {
+	for {
+		destruct, err := d.db.ClaimMsgDestruct(ctx, d.lease)
+		if err != nil {
+			if !errors.Is(errs.Unwrap(err), mongo.ErrNoDocuments) {
+				log.ZError(ctx, "claim msg destruct failed", err)
+			}
+			return
+		}
+		d.destruct(ctx, destruct)
+	}
+}
+
+// destruct deletes the message for everyone, or only for the reader whose timer expired.
+func (d *msgDestructDispatcher) destruct(ctx context.Context, destruct *relation.MsgDestructModel) {
+	req := &msg.DeleteMsgsReq{
+		ConversationID: destruct.ConversationID,
+		Seqs:           []int64{destruct.Seq},
+		UserID:         destruct.UserID,
+		DeleteSyncOpt:  &msg.DeleteSyncOpt{IsSyncSelf: true},
+	}
+	if destruct.UserID == "" {
+		req.UserID = destruct.SendID
+		req.DeleteSyncOpt.IsSyncOther = true
+	}
+	deleteCtx := mcontext.WithOpUserIDContext(ctx, req.UserID)
+	_, err := d.deleteMsgs(deleteCtx, req)
+	if err != nil && isRetryableRpcErr(err) && destruct.Attempts < d.maxAttempts {
+		// the claim expires after the lease and the timer is picked up again
+		log.ZWarn(ctx, "destruct msg failed, retry later", err, "conversationID", destruct.ConversationID, "seq", destruct.Seq,
+			"userID", destruct.UserID, "attempts", destruct.Attempts)
+		return
+	}
+	if err != nil {
+		log.ZError(ctx, "destruct msg failed", err, "conversationID", destruct.ConversationID, "seq", destruct.Seq, "userID", destruct.UserID)
+	}
+	if err := d.db.DeleteMsgDestruct(ctx, destruct); err != nil {
+		log.ZError(ctx, "delete msg destruct failed", err, "conversationID", destruct.ConversationID, "seq", destruct.Seq, "userID", destruct.UserID)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tools
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/controller"
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/Meikwei/go-tools/mcontext"
+	"github.com/Meikwei/protocol/msg"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type fakeMsgDestructDatabase struct {
+	controller.MsgDestructDatabase
+	due     []*relation.MsgDestructModel
+	deleted []int64
+}
+
+func (f *fakeMsgDestructDatabase) ClaimMsgDestruct(ctx context.Context, lease time.Duration) (*relation.MsgDestructModel, error) {
+	if len(f.due) == 0 {
+		return nil, errs.Wrap(mongo.ErrNoDocuments)
+	}
+	destruct := f.due[0]
+	f.due = f.due[1:]
+	destruct.Attempts++
+	return destruct, nil
+}
+
+func (f *fakeMsgDestructDatabase) DeleteMsgDestruct(ctx context.Context, destruct *relation.MsgDestructModel) error {
+	f.deleted = append(f.deleted, destruct.Seq)
+	return nil
+}
+
+func TestMsgDestructDispatch(t *testing.T) {
+	db := &fakeMsgDestructDatabase{
+		due: []*relation.MsgDestructModel{
+			{ConversationID: "sg_g1", Seq: 1, SendID: "u1"},
+			{ConversationID: "sg_g1", Seq: 2, SendID: "u1", UserID: "u2", StartOnRead: true},
+			{ConversationID: "sg_g1", Seq: 3, SendID: "u1"},
+			{ConversationID: "sg_g1", Seq: 4, SendID: "u1", Attempts: 2},
+		},
+	}
+	type deleted struct {
+		opUserID    string
+		userID      string
+		isSyncOther bool
+	}
+	calls := make(map[int64]deleted)
+	d := &msgDestructDispatcher{
+		db: db,
+		deleteMsgs: func(ctx context.Context, req *msg.DeleteMsgsReq) (*msg.DeleteMsgsResp, error) {
+			calls[req.Seqs[0]] = deleted{mcontext.GetOpUserID(ctx), req.UserID, req.DeleteSyncOpt.IsSyncOther}
+			if req.Seqs[0] >= 3 {
+				return nil, errs.ErrInternalServer.WrapMsg("connection refused")
+			}
+			return &msg.DeleteMsgsResp{}, nil
+		},
+		maxAttempts: 3,
+	}
+	d.destructDue(context.Background())
+
+	assert.Empty(t, db.due)
+	assert.Equal(t, map[int64]deleted{
+		1: {"u1", "u1", true},
+		2: {"u2", "u2", false},
+		3: {"u1", "u1", true},
+		4: {"u1", "u1", true},
+	}, calls)
+	// seq 3 is retried after its lease, seq 4 ran out of attempts
+	assert.Equal(t, []int64{1, 2, 4}, db.deleted)
+}
@@ -0,0 +1,157 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/controller"
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/aetim/pkg/rpcclient"
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/Meikwei/go-tools/log"
+	"github.com/Meikwei/go-tools/mcontext"
+	"github.com/Meikwei/go-tools/utils/idutil"
+	"github.com/Meikwei/protocol/msg"
+	"github.com/Meikwei/protocol/sdkws"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultScheduledMsgInterval    = 5
+	defaultScheduledMsgLease       = 60
+	defaultScheduledMsgMaxAttempts = 3
+)
+
+type sendMsgFunc func(ctx context.Context, req *msg.SendMsgReq) (*msg.SendMsgResp, error)
+
+// scheduledMsgDispatcher hands due scheduled messages to SendMsg.
+// Every crontask replica runs one; a message is claimed atomically with a lease,
+// and a claim left behind by a crashed replica is picked up again once its lease
+// expires. Before sending, the claim is turned into the sent status while the lease
+// is still held, so a replica that stalled past its lease can not send a message
+// another replica has claimed since. A replica crashing between the two loses the
+// message rather than sending it twice.
+type scheduledMsgDispatcher struct {
+	db          controller.ScheduledMsgDatabase
+	sendMsg     sendMsgFunc
+	owner       string
+	interval    time.Duration
+	lease       time.Duration
+	maxAttempts int32
+}
+
+func newScheduledMsgDispatcher(db controller.ScheduledMsgDatabase, msgClient *rpcclient.MessageRpcClient, config *CronTaskConfig) *scheduledMsgDispatcher {
+	conf := config.CronTask.ScheduledMsg
+	d := &scheduledMsgDispatcher{
+		db:          db,
+		sendMsg:     msgClient.SendMsg,
+		interval:    time.Duration(conf.Interval) * time.Second,
+		lease:       time.Duration(conf.Lease) * time.Second,
+		maxAttempts: int32(conf.MaxAttempts),
+	}
+	if d.interval <= 0 {
+		d.interval = defaultScheduledMsgInterval * time.Second
+	}
+	if d.lease <= 0 {
+		d.lease = defaultScheduledMsgLease * time.Second
+	}
+	if d.maxAttempts <= 0 {
+		d.maxAttempts = defaultScheduledMsgMaxAttempts
+	}
+	hostname, _ := os.Hostname()
+	d.owner = fmt.Sprintf("%s_%d_%s", hostname, os.Getpid(), idutil.OperationIDGenerator())
+	return d
+}
+
+func (d *scheduledMsgDispatcher) run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.dispatchDue(mcontext.SetOperationID(context.Background(), idutil.OperationIDGenerator()))
+		}
+	}
+}
+
+// dispatchDue claims and sends due messages one at a time until none is left.
+func (d *scheduledMsgDispatcher) dispatchDue(ctx context.Context) {
+	for {
+		model, err := d.db.ClaimScheduledMsg(ctx, time.Now(), d.owner, d.lease)
+		if err != nil {
+			if !errors.Is(errs.Unwrap(err), mongo.ErrNoDocuments) {
+				log.ZError(ctx, "claim scheduled msg failed", err)
+			}
+			return
+		}
+		d.dispatch(ctx, model)
+	}
+}
+
+func (d *scheduledMsgDispatcher) dispatch(ctx context.Context, model *relation.ScheduledMsgModel) {
+	if err := d.db.MarkScheduledMsgSent(ctx, model.ScheduleID, d.owner, time.Now()); err != nil {
+		// 领取已过期并可能被其他实例重新领取，由其他实例发送
+		log.ZWarn(ctx, "scheduled msg lease lost before send", err, "scheduleID", model.ScheduleID)
+		return
+	}
+	msgData := &sdkws.MsgData{}
+	if err := proto.Unmarshal(model.MsgData, msgData); err != nil {
+		d.finish(ctx, model, relation.ScheduledMsgFailed, err)
+		return
+	}
+	sendCtx := mcontext.WithOpUserIDContext(mcontext.SetOperationID(context.Background(), mcontext.GetOperationID(ctx)+"_"+model.ScheduleID), model.SendID)
+	_, err := d.sendMsg(sendCtx, &msg.SendMsgReq{MsgData: msgData})
+	if err == nil {
+		return
+	}
+	if !isRetryableRpcErr(err) || model.Attempts >= d.maxAttempts {
+		d.finish(ctx, model, relation.ScheduledMsgFailed, err)
+		return
+	}
+	log.ZWarn(ctx, "send scheduled msg failed, retry later", err, "scheduleID", model.ScheduleID, "attempts", model.Attempts)
+	if err := d.db.ReleaseScheduledMsg(ctx, model.ScheduleID, d.owner, err.Error()); err != nil {
+		log.ZError(ctx, "release scheduled msg failed", err, "scheduleID", model.ScheduleID)
+	}
+}
+
+func (d *scheduledMsgDispatcher) finish(ctx context.Context, model *relation.ScheduledMsgModel, status int32, sendErr error) {
+	var errMsg string
+	if sendErr != nil {
+		errMsg = sendErr.Error()
+		log.ZWarn(ctx, "scheduled msg failed", sendErr, "scheduleID", model.ScheduleID, "attempts", model.Attempts)
+	}
+	if err := d.db.FinishScheduledMsg(ctx, model.ScheduleID, d.owner, status, errMsg); err != nil {
+		log.ZError(ctx, "finish scheduled msg failed", err, "scheduleID", model.ScheduleID, "status", status)
+	}
+}
+
+// isRetryableRpcErr reports whether a failure of a msg rpc call is transient.
+// 业务错误（如发送时已被拉黑、已退群）的错误码从1001开始，重试也不会成功；
+// 网络错误和服务内部错误会被rpc客户端转换为grpc状态码或500，可以重试。
+func isRetryableRpcErr(err error) bool {
+	var codeErr errs.CodeError
+	if !errors.As(err, &codeErr) {
+		return true
+	}
+	return codeErr.Code() < errs.ArgsError
+}
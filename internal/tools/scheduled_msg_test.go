@@ -0,0 +1,107 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tools
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/controller"
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/Meikwei/protocol/msg"
+	"github.com/Meikwei/protocol/sdkws"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/proto"
+)
+
+type fakeScheduledMsgDatabase struct {
+	controller.ScheduledMsgDatabase
+	due      []*relation.ScheduledMsgModel
+	expired  map[string]bool
+	released []string
+	finished map[string]int32
+}
+
+func (f *fakeScheduledMsgDatabase) ClaimScheduledMsg(ctx context.Context, now time.Time, owner string, lease time.Duration) (*relation.ScheduledMsgModel, error) {
+	if len(f.due) == 0 {
+		return nil, errs.Wrap(mongo.ErrNoDocuments)
+	}
+	model := f.due[0]
+	f.due = f.due[1:]
+	model.Attempts++
+	return model, nil
+}
+
+func (f *fakeScheduledMsgDatabase) MarkScheduledMsgSent(ctx context.Context, scheduleID string, owner string, now time.Time) error {
+	if f.expired[scheduleID] {
+		return errs.Wrap(mongo.ErrNoDocuments)
+	}
+	f.finished[scheduleID] = relation.ScheduledMsgSent
+	return nil
+}
+
+func (f *fakeScheduledMsgDatabase) ReleaseScheduledMsg(ctx context.Context, scheduleID string, owner string, errMsg string) error {
+	f.released = append(f.released, scheduleID)
+	delete(f.finished, scheduleID)
+	return nil
+}
+
+func (f *fakeScheduledMsgDatabase) FinishScheduledMsg(ctx context.Context, scheduleID string, owner string, status int32, errMsg string) error {
+	f.finished[scheduleID] = status
+	return nil
+}
+
+func TestScheduledMsgDispatch(t *testing.T) {
+	newModel := func(scheduleID string, attempts int32) *relation.ScheduledMsgModel {
+		data, err := proto.Marshal(&sdkws.MsgData{SendID: "u1", RecvID: scheduleID, ClientMsgID: scheduleID})
+		assert.NoError(t, err)
+		return &relation.ScheduledMsgModel{ScheduleID: scheduleID, SendID: "u1", MsgData: data, Attempts: attempts}
+	}
+	db := &fakeScheduledMsgDatabase{
+		due:      []*relation.ScheduledMsgModel{newModel("ok", 0), newModel("blocked", 0), newModel("down", 0), newModel("down-last", 2), newModel("stalled", 0)},
+		expired:  map[string]bool{"stalled": true},
+		finished: make(map[string]int32),
+	}
+	var sent []string
+	d := &scheduledMsgDispatcher{
+		db: db,
+		sendMsg: func(ctx context.Context, req *msg.SendMsgReq) (*msg.SendMsgResp, error) {
+			sent = append(sent, req.MsgData.RecvID)
+			switch req.MsgData.RecvID {
+			case "ok":
+				return &msg.SendMsgResp{}, nil
+			case "blocked":
+				return nil, errs.NewCodeError(1302, "BlockedByPeer").Wrap()
+			default:
+				return nil, errs.ErrInternalServer.WrapMsg("connection refused")
+			}
+		},
+		maxAttempts: 3,
+	}
+	d.dispatchDue(context.Background())
+
+	assert.Empty(t, db.due)
+	assert.Equal(t, map[string]int32{
+		"ok":        relation.ScheduledMsgSent,
+		"blocked":   relation.ScheduledMsgFailed,
+		"down-last": relation.ScheduledMsgFailed,
+	}, db.finished)
+	assert.Equal(t, []string{"down"}, db.released)
+	// a claim whose lease expired before the send is left to the replica that claims it next
+	assert.NotContains(t, sent, "stalled")
+}
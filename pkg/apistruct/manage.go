@@ -23,6 +23,10 @@ type SendMsg struct {
 	// SendID uniquely identifies the sender.
 	SendID string `json:"sendID" binding:"required"`
 
+	// ClientMsgID is the client-side identifier of the message, generated when empty.
+	// Retries of a message with the same ClientMsgID are only sent once.
+	ClientMsgID string `json:"clientMsgID"`
+
 	// GroupID is the identifier for the group, required if SessionType is 2 or 3.
 	GroupID string `json:"groupID" binding:"required_if=SessionType 2|required_if=SessionType 3"`
 
@@ -64,6 +68,21 @@ type SendMsgReq struct {
 	SendMsg
 }
 
+// SendMsgResp is the result of sending a message.
+type SendMsgResp struct {
+	// ServerMsgID is the message identifier on the server-side.
+	ServerMsgID string `json:"serverMsgID"`
+
+	// ClientMsgID is the message identifier on the client-side.
+	ClientMsgID string `json:"clientMsgID"`
+
+	// SendTime is the timestamp when the message was sent.
+	SendTime int64 `json:"sendTime"`
+
+	// Seq is the sequence number of the message, only known when the request is a retry of a stored message.
+	Seq int64 `json:"seq,omitempty"`
+}
+
 type GetConversationListReq struct {
 	// userID uniquely identifies the user.
 	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty" binding:"required"`
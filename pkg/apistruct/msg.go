@@ -81,6 +81,16 @@ type TextElem struct {
 	Content string `json:"content" validate:"required"`
 }
 
+type QuoteElem struct {
+	Text         string         `mapstructure:"text"         validate:"required"`
+	QuoteMessage map[string]any `mapstructure:"quoteMessage" validate:"required"`
+}
+
+type AdvancedTextElem struct {
+	Text              string `mapstructure:"text"              validate:"required"`
+	MessageEntityList []any  `mapstructure:"messageEntityList"`
+}
+
 type RevokeElem struct {
 	RevokeMsgClientID string `mapstructure:"revokeMsgClientID" validate:"required"`
 }
@@ -86,6 +86,12 @@ type CallbackGroupMsgReadReq struct {
 	ReceiveID       string `json:"receiveID"`
 	UnreadMsgNum    int64  `json:"unreadMsgNum"`
 	ContentType     int64  `json:"contentType"`
+	ConversationID  string `json:"conversationID,omitempty"`
+	GroupID         string `json:"groupID,omitempty"`
+	PrevHasReadSeq  int64  `json:"prevHasReadSeq,omitempty"` // 群成员之前的已读位置
+	HasReadSeq      int64  `json:"hasReadSeq,omitempty"`     // 群成员当前的已读位置
+	ReadCount       int64  `json:"readCount,omitempty"`      // 已读到hasReadSeq这条消息的成员数，不含发送者
+	MemberCount     int64  `json:"memberCount,omitempty"`    // 群成员数
 }
 
 type CallbackGroupMsgReadResp struct {
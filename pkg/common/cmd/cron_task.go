@@ -33,20 +33,26 @@ type CronTaskCmd struct {
 func NewCronTaskCmd() *CronTaskCmd {
 	var cronTaskConfig tools.CronTaskConfig
 	ret := &CronTaskCmd{cronTaskConfig: &cronTaskConfig}
-	ret.configMap = map[string]any{
+	ret.configMap = cronTaskConfigMap(&cronTaskConfig)
+	ret.RootCmd = NewRootCmd(program.GetProcessName(), WithConfigMap(ret.configMap))
+	ret.ctx = context.WithValue(context.Background(), "version", config.Version)
+	ret.Command.RunE = func(cmd *cobra.Command, args []string) error {
+		return ret.runE()
+	}
+	return ret
+}
+
+func cronTaskConfigMap(cronTaskConfig *tools.CronTaskConfig) map[string]any {
+	return map[string]any{
 		OpenIMCronTaskCfgFileName: &cronTaskConfig.CronTask,
 		RedisConfigFileName:       &cronTaskConfig.RedisConfig,
 		MongodbConfigFileName:     &cronTaskConfig.MongodbConfig,
 		ZookeeperConfigFileName:   &cronTaskConfig.ZookeeperConfig,
 		ShareFileName:             &cronTaskConfig.Share,
 		KafkaConfigFileName:       &cronTaskConfig.KafkaConfig,
+		NotificationFileName:      &cronTaskConfig.NotificationConfig,
+		LocalCacheConfigFileName:  &cronTaskConfig.LocalCacheConfig,
 	}
-	ret.RootCmd = NewRootCmd(program.GetProcessName(), WithConfigMap(ret.configMap))
-	ret.ctx = context.WithValue(context.Background(), "version", config.Version)
-	ret.Command.RunE = func(cmd *cobra.Command, args []string) error {
-		return ret.runE()
-	}
-	return ret
 }
 
 func (a *CronTaskCmd) Exec() error {
@@ -16,8 +16,11 @@ package cmd
 
 import (
 	"context"
+	"path/filepath"
 
 	"github.com/Meikwei/aetim/internal/tools"
+	"github.com/Meikwei/aetim/pkg/common/config"
+	"github.com/Meikwei/go-tools/errs"
 	"github.com/Meikwei/go-tools/system/program"
 	"github.com/spf13/cobra"
 )
@@ -39,6 +42,23 @@ func (m *MsgUtilsCmd) AddConfigDirFlag() {
 
 }
 
+// loadCronTaskConfig loads the config of the msg tool from the config directory flag.
+func (m *MsgUtilsCmd) loadCronTaskConfig(cmdLines *cobra.Command) (*tools.CronTaskConfig, error) {
+	configDirectory, err := cmdLines.Flags().GetString(FlagConf)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	var cronTaskConfig tools.CronTaskConfig
+	for configFileName, configStruct := range cronTaskConfigMap(&cronTaskConfig) {
+		err := config.LoadConfig(filepath.Join(configDirectory, configFileName),
+			ConfigEnvPrefixMap[configFileName], configStruct)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &cronTaskConfig, nil
+}
+
 func (m *MsgUtilsCmd) getUserIDFlag(cmdLines *cobra.Command) string {
 	userID, _ := cmdLines.Flags().GetString("userID")
 	return userID
@@ -146,7 +166,11 @@ func NewSeqCmd() *SeqCmd {
 
 func (s *SeqCmd) GetSeqCmd() *cobra.Command {
 	s.Command.Run = func(cmdLines *cobra.Command, args []string) {
-		_, err := tools.InitMsgTool(context.Background(), nil)
+		cronTaskConfig, err := s.loadCronTaskConfig(cmdLines)
+		if err != nil {
+			program.ExitWithError(err)
+		}
+		_, err = tools.InitMsgTool(context.Background(), cronTaskConfig)
 		if err != nil {
 			program.ExitWithError(err)
 		}
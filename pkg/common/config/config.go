@@ -121,8 +121,17 @@ type API struct {
 type CronTask struct {
 	ChatRecordsClearTime string `mapstructure:"chatRecordsClearTime"` // 聊天记录清除时间配置
 	MsgDestructTime      string `mapstructure:"msgDestructTime"`      // 消息自毁时间配置
-	RetainChatRecords    int    `mapstructure:"retainChatRecords"`    // 保留聊天记录的时间（天数）
 	EnableCronLocker     bool   `yaml:"enableCronLocker"`             // 是否启用定时任务锁
+	ScheduledMsg         struct {
+		Interval    int `mapstructure:"interval"`    // 扫描到期定时消息的间隔（秒）
+		Lease       int `mapstructure:"lease"`       // 领取定时消息的有效期（秒），过期未完成会被其他实例重新领取
+		MaxAttempts int `mapstructure:"maxAttempts"` // 发送失败的最大尝试次数
+	} `mapstructure:"scheduledMsg"`
+	SelfDestruct struct {
+		Interval    int `mapstructure:"interval"`    // 扫描到期自毁消息的间隔（秒）
+		Lease       int `mapstructure:"lease"`       // 领取自毁计时的有效期（秒），过期未完成会被其他实例重新领取
+		MaxAttempts int `mapstructure:"maxAttempts"` // 删除失败的最大尝试次数
+	} `mapstructure:"selfDestruct"`
 }
 
 // OfflinePushConfig 定义了离线推送的配置
@@ -193,14 +202,52 @@ type MsgGateway struct {
 	Prometheus  Prometheus `mapstructure:"prometheus"`  // Prometheus监控配置
 	ListenIP    string     `mapstructure:"listenIP"`    // 消息网关监听的IP地址
 	LongConnSvr struct {
-		Ports               []int `mapstructure:"ports"`               // 长连接服务器监听的端口列表
-		WebsocketMaxConnNum int   `mapstructure:"websocketMaxConnNum"` // WebSocket最大连接数
-		WebsocketMaxMsgLen  int   `mapstructure:"websocketMaxMsgLen"`  // WebSocket最大消息长度
-		WebsocketTimeout    int   `mapstructure:"websocketTimeout"`    // WebSocket超时时间
+		Ports                   []int `mapstructure:"ports"`                   // 长连接服务器监听的端口列表
+		WebsocketMaxConnNum     int   `mapstructure:"websocketMaxConnNum"`     // WebSocket最大连接数
+		WebsocketMaxMsgLen      int   `mapstructure:"websocketMaxMsgLen"`      // WebSocket最大消息长度
+		WebsocketTimeout        int   `mapstructure:"websocketTimeout"`        // WebSocket超时时间
+		EnablePerMessageDeflate bool  `mapstructure:"enablePerMessageDeflate"` // 是否允许协商permessage-deflate压缩
 	} `mapstructure:"longConnSvr"` // 长连接服务器配置
+	WriteQueue struct {
+		Size           int    `mapstructure:"size"`           // 每个连接待写出的推送数量上限
+		OverflowPolicy string `mapstructure:"overflowPolicy"` // 队列满时的处理策略：drop、resync或disconnect
+	} `mapstructure:"writeQueue"` // 推送写队列配置
+	Resume struct {
+		Enable   bool `mapstructure:"enable"`   // 是否允许断线重连后恢复会话并补发推送
+		Window   int  `mapstructure:"window"`   // 断线后会话保留的时间，单位秒
+		RingSize int  `mapstructure:"ringSize"` // 每个会话缓存的推送条数
+	} `mapstructure:"resume"` // 会话恢复配置
+	RateLimit struct {
+		Enable          bool       `mapstructure:"enable"`          // 是否启用限流
+		Conn            RateLimits `mapstructure:"conn"`            // 每个连接的限流
+		User            RateLimits `mapstructure:"user"`            // 每个用户所有连接合计的限流
+		ViolationWindow int        `mapstructure:"violationWindow"` // 统计违规次数的时间窗口，单位秒
+		MuteThreshold   int        `mapstructure:"muteThreshold"`   // 窗口内被限流次数达到该值后禁言
+		MuteDuration    int        `mapstructure:"muteDuration"`    // 禁言时长，单位秒
+		MuteWindow      int        `mapstructure:"muteWindow"`      // 统计禁言次数的时间窗口，单位秒
+		KickThreshold   int        `mapstructure:"kickThreshold"`   // 窗口内被禁言次数达到该值后踢下线
+	} `mapstructure:"rateLimit"` // 限流配置
+	Drain struct {
+		Period          int `mapstructure:"period"`          // 摘除节点时关闭全部连接所用的时间，单位秒
+		TerminatePeriod int `mapstructure:"terminatePeriod"` // 收到SIGTERM退出时关闭全部连接所用的时间，单位秒，应小于进程的终止宽限期
+		BatchInterval   int `mapstructure:"batchInterval"`   // 分批关闭连接的间隔，单位秒
+	} `mapstructure:"drain"` // 节点摘除配置
 	MultiLoginPolicy int `mapstructure:"multiLoginPolicy"` // 多设备登录策略
 }
 
+// RateLimit 定义了令牌桶限流参数
+type RateLimit struct {
+	Rate  float64 `mapstructure:"rate"`  // 每秒补充的令牌数，0表示不限流
+	Burst int     `mapstructure:"burst"` // 令牌桶容量
+}
+
+// RateLimits 定义了网关各类请求的限流参数
+type RateLimits struct {
+	SendMsg      RateLimit `mapstructure:"sendMsg"`      // 发送消息
+	PullMsg      RateLimit `mapstructure:"pullMsg"`      // 按seq拉取消息
+	GetNewestSeq RateLimit `mapstructure:"getNewestSeq"` // 获取最新seq
+}
+
 // MsgTransfer 定义了消息传输的配置
 type MsgTransfer struct {
 	Prometheus Prometheus `mapstructure:"prometheus"` // Prometheus监控配置
@@ -215,6 +262,7 @@ type Push struct {
 	} `mapstructure:"rpc"` // RPC服务配置
 	Prometheus           Prometheus `mapstructure:"prometheus"` // Prometheus监控配置
 	MaxConcurrentWorkers int        `mapstructure:"maxConcurrentWorkers"` // 最大并发工作器数量
+	GatewayRouting       string     `mapstructure:"gatewayRouting"`       // 在线推送选择网关节点的方式：registry或all
 	Enable               string     `mapstructure:"enable"` // 启用标志
 	GeTui                struct { // GeTui推送服务配置
 		PushUrl      string `mapstructure:"pushUrl"`      // 推送URL
@@ -290,8 +338,49 @@ type Msg struct {
 		ListenIP   string `mapstructure:"listenIP"`   // 监听IP地址
 		Ports      []int  `mapstructure:"ports"`      // 使用的端口号列表
 	} `mapstructure:"rpc"` // RPC服务配置
-	Prometheus   Prometheus `mapstructure:"prometheus"` // Prometheus监控配置
+	Prometheus   Prometheus `mapstructure:"prometheus"`   // Prometheus监控配置
 	FriendVerify bool       `mapstructure:"friendVerify"` // 好友验证标志
+	Signal       struct {
+		RingTimeout   int `mapstructure:"ringTimeout"`   // 邀请未指定超时时的默认振铃时长（秒）
+		CheckInterval int `mapstructure:"checkInterval"` // 振铃超时扫描间隔（秒）
+	} `mapstructure:"signal"` // 音视频通话信令配置
+	Edit struct {
+		TimeLimit int `mapstructure:"timeLimit"` // 消息发送后可编辑的时长（秒），0 表示不限制
+	} `mapstructure:"edit"` // 消息编辑配置
+	Pin struct {
+		MaxPinned int `mapstructure:"maxPinned"` // 每个会话最多置顶的消息数
+	} `mapstructure:"pin"` // 消息置顶配置
+	ReadReceipt struct {
+		MaxListCount      int `mapstructure:"maxListCount"`      // 群消息已读、未读成员列表返回的最大人数
+		NotifyMemberLimit int `mapstructure:"notifyMemberLimit"` // 成员数不超过该值的群才发送已读数通知，0 表示不限制
+	} `mapstructure:"readReceipt"` // 群消息已读回执配置
+	Sensitive struct {
+		Enable         bool   `mapstructure:"enable"`         // 是否启用敏感词过滤
+		Source         string `mapstructure:"source"`         // 敏感词来源，file 或 mongo
+		File           string `mapstructure:"file"`           // source 为 file 时的词表文件路径
+		DefaultPolicy  string `mapstructure:"defaultPolicy"`  // 未指定处理方式的敏感词的处理方式：reject、mask 或 senderOnly
+		ReloadInterval int    `mapstructure:"reloadInterval"` // 重新加载词表的间隔（秒），0 表示不重新加载
+	} `mapstructure:"sensitive"` // 敏感词过滤配置
+	Export struct {
+		MaxRunning   int `mapstructure:"maxRunning"`   // 每个实例同时执行的导出任务数，超出的任务排队
+		StaleTimeout int `mapstructure:"staleTimeout"` // 未结束的任务超过该时间（秒）没有进展时，启动时置为失败
+	} `mapstructure:"export"` // 消息导出配置
+	Dedup struct {
+		Window int `mapstructure:"window"` // 相同 sendID 和 clientMsgID 的消息在该时间（秒）内只发送一次，0 表示不去重
+	} `mapstructure:"dedup"` // 消息发送去重配置
+	SelfDestruct struct {
+		MaxTTL        int `mapstructure:"maxTTL"`        // 发送者可设置的最长自毁时间（秒），0 表示不限制
+		UnreadTimeout int `mapstructure:"unreadTimeout"` // 阅读后开始计时的消息在该时间（秒）内未被阅读时，TTL 从此时开始计时
+	} `mapstructure:"selfDestruct"` // 消息自毁配置
+	Broadcast struct {
+		MaxRunning  int `mapstructure:"maxRunning"`  // 每个实例同时执行的广播任务数
+		Interval    int `mapstructure:"interval"`    // 领取等待中的广播任务的间隔（秒）
+		Lease       int `mapstructure:"lease"`       // 领取广播任务的有效期（秒），实例退出后任务在过期后被其他实例继续执行
+		BatchSize   int `mapstructure:"batchSize"`   // 每批读取的接收者数，每批结束时记录进度
+		DefaultRate int `mapstructure:"defaultRate"` // 创建任务时未指定发送速率时每秒发送的消息数
+		MaxRate     int `mapstructure:"maxRate"`     // 每个任务每秒发送的最大消息数
+		MaxUserIDs  int `mapstructure:"maxUserIDs"`  // 按用户ID指定目标时的最大用户数
+	} `mapstructure:"broadcast"` // 广播任务配置
 }
 
 // Third 定义了与第三方服务配置相关的结构体
@@ -365,6 +454,7 @@ type Redis struct {
 	ClusterMode    bool     `mapstructure:"clusterMode"`    // 是否为集群模式
 	DB             int      `mapstructure:"db"`             // 数据库索引
 	MaxRetry       int      `mapstructure:"MaxRetry"`       // 最大重试次数
+	SeqReplicas    int      `mapstructure:"seqReplicas"`    // 分配seq后需确认写入的副本数，0表示不等待副本
 }
 
 // BeforeConfig 定义了前置任务的配置项
@@ -386,6 +476,10 @@ type Share struct {
 	Env             string          `mapstructure:"env"`             // 环境
 	RpcRegisterName RpcRegisterName `mapstructure:"rpcRegisterName"` // RPC注册名称
 	IMAdminUserID   []string        `mapstructure:"imAdminUserID"`   // IM管理员用户ID列表
+	Retention       struct {
+		Days  int   `mapstructure:"days"`  // 默认保留消息的天数，<=0时按Count处理
+		Count int64 `mapstructure:"count"` // 默认保留最近的消息条数，与Days都<=0时永久保留
+	} `mapstructure:"retention"` // 未设置保留策略的会话、群使用的默认策略
 }
 
 // RpcRegisterName 定义了RPC服务的注册名称
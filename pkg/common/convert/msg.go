@@ -16,6 +16,7 @@ package convert
 
 import (
 	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/aetim/pkg/msgprocessor"
 	"github.com/Meikwei/protocol/constant"
 	"github.com/Meikwei/protocol/sdkws"
 )
@@ -96,3 +97,13 @@ func MsgDB2Pb(msgModel *relation.MsgDataModel) *sdkws.MsgData {
 	msg.Ex = msgModel.Ex
 	return &msg
 }
+
+func MsgThreadDB2Summary(thread *relation.MsgThreadModel) *msgprocessor.ThreadSummary {
+	return &msgprocessor.ThreadSummary{
+		ThreadID:        thread.ThreadID,
+		ReplyCount:      thread.ReplyCount,
+		LastReplySeq:    thread.LastReplySeq,
+		LastReplyUserID: thread.LastReplyUserID,
+		LastReplyTime:   thread.LastReplyTime,
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/Meikwei/go-tools/utils/datautil"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	connRouteUser  = "MSG_GATEWAY_ROUTE_USER:"
+	connRouteNode  = "MSG_GATEWAY_ROUTE_NODE:"
+	connRouteAlive = "MSG_GATEWAY_ROUTE_ALIVE:"
+	connRouteNodes = "MSG_GATEWAY_ROUTE_NODES"
+)
+
+// ConnRoute locates one gateway connection of a user. Node is the RPC target the
+// gateway instance is registered under.
+type ConnRoute struct {
+	Node       string `json:"node"`
+	PlatformID int    `json:"platformID"`
+	ConnID     string `json:"connID"`
+}
+
+// ConnRouteCache is the cluster wide registry of which gateway node holds the connections
+// of a user. Each node keeps an alive key while running, routes of nodes whose alive key
+// expired are stale and removed with ClearNode.
+type ConnRouteCache interface {
+	SetConnRoute(ctx context.Context, userID string, route *ConnRoute) error
+	// DelConnRoute 删除用户在节点上的一个连接路由，该节点上已无该用户的连接时将用户移出节点集合
+	DelConnRoute(ctx context.Context, userID string, node string, connID string) error
+	// DelConnRoutes 删除用户的多个连接路由，key为userID，value为connID列表
+	DelConnRoutes(ctx context.Context, userConnIDs map[string][]string) error
+	GetUsersConnRoutes(ctx context.Context, userIDs []string) (map[string][]*ConnRoute, error)
+	KeepNodeAlive(ctx context.Context, node string, ttl time.Duration) error
+	GetNodes(ctx context.Context) ([]string, error)
+	GetAliveNodes(ctx context.Context, nodes []string) (map[string]bool, error)
+	// ClearNode 删除节点登记的全部连接路由
+	ClearNode(ctx context.Context, node string) error
+}
+
+func NewConnRouteCache(rdb redis.UniversalClient) ConnRouteCache {
+	return &connRouteCache{rdb: rdb}
+}
+
+type connRouteCache struct {
+	rdb redis.UniversalClient
+}
+
+func (c *connRouteCache) getUserKey(userID string) string {
+	return connRouteUser + userID
+}
+
+func (c *connRouteCache) getNodeKey(node string) string {
+	return connRouteNode + node
+}
+
+func (c *connRouteCache) getAliveKey(node string) string {
+	return connRouteAlive + node
+}
+
+func (c *connRouteCache) SetConnRoute(ctx context.Context, userID string, route *ConnRoute) error {
+	data, err := json.Marshal(route)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	_, err = c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, c.getUserKey(userID), route.ConnID, data)
+		pipe.SAdd(ctx, c.getNodeKey(route.Node), userID)
+		return nil
+	})
+	return errs.Wrap(err)
+}
+
+func (c *connRouteCache) DelConnRoute(ctx context.Context, userID string, node string, connID string) error {
+	var values *redis.StringSliceCmd
+	_, err := c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HDel(ctx, c.getUserKey(userID), connID)
+		values = pipe.HVals(ctx, c.getUserKey(userID))
+		return nil
+	})
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	// Routes of a node are only written by that node, so no conn can be added between
+	// reading the remaining routes and removing the user from the node set.
+	for _, value := range values.Val() {
+		var route ConnRoute
+		if err := json.Unmarshal([]byte(value), &route); err == nil && route.Node == node {
+			return nil
+		}
+	}
+	return errs.Wrap(c.rdb.SRem(ctx, c.getNodeKey(node), userID).Err())
+}
+
+func (c *connRouteCache) DelConnRoutes(ctx context.Context, userConnIDs map[string][]string) error {
+	if len(userConnIDs) == 0 {
+		return nil
+	}
+	_, err := c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for userID, connIDs := range userConnIDs {
+			if len(connIDs) > 0 {
+				pipe.HDel(ctx, c.getUserKey(userID), connIDs...)
+			}
+		}
+		return nil
+	})
+	return errs.Wrap(err)
+}
+
+func (c *connRouteCache) GetUsersConnRoutes(ctx context.Context, userIDs []string) (map[string][]*ConnRoute, error) {
+	if len(userIDs) == 0 {
+		return map[string][]*ConnRoute{}, nil
+	}
+	cmds := make([]*redis.MapStringStringCmd, len(userIDs))
+	_, err := c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, userID := range userIDs {
+			cmds[i] = pipe.HGetAll(ctx, c.getUserKey(userID))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	routes := make(map[string][]*ConnRoute)
+	for i, cmd := range cmds {
+		for _, value := range cmd.Val() {
+			var route ConnRoute
+			if err := json.Unmarshal([]byte(value), &route); err != nil {
+				continue
+			}
+			routes[userIDs[i]] = append(routes[userIDs[i]], &route)
+		}
+	}
+	return routes, nil
+}
+
+func (c *connRouteCache) KeepNodeAlive(ctx context.Context, node string, ttl time.Duration) error {
+	_, err := c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, c.getAliveKey(node), time.Now().UnixMilli(), ttl)
+		pipe.SAdd(ctx, connRouteNodes, node)
+		return nil
+	})
+	return errs.Wrap(err)
+}
+
+func (c *connRouteCache) GetNodes(ctx context.Context) ([]string, error) {
+	nodes, err := c.rdb.SMembers(ctx, connRouteNodes).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return nodes, nil
+}
+
+func (c *connRouteCache) GetAliveNodes(ctx context.Context, nodes []string) (map[string]bool, error) {
+	cmds := make([]*redis.IntCmd, len(nodes))
+	_, err := c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, node := range nodes {
+			cmds[i] = pipe.Exists(ctx, c.getAliveKey(node))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	alive := make(map[string]bool, len(nodes))
+	for i, cmd := range cmds {
+		alive[nodes[i]] = cmd.Val() > 0
+	}
+	return alive, nil
+}
+
+func (c *connRouteCache) ClearNode(ctx context.Context, node string) error {
+	userIDs, err := c.rdb.SMembers(ctx, c.getNodeKey(node)).Result()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	routes, err := c.GetUsersConnRoutes(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	userConnIDs := make(map[string][]string)
+	for userID, userRoutes := range routes {
+		for _, route := range userRoutes {
+			if route.Node == node {
+				userConnIDs[userID] = append(userConnIDs[userID], route.ConnID)
+			}
+		}
+	}
+	if err := c.DelConnRoutes(ctx, userConnIDs); err != nil {
+		return err
+	}
+	_, err = c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		if len(userIDs) > 0 {
+			pipe.SRem(ctx, c.getNodeKey(node), datautil.Slice(userIDs, func(e string) any { return e })...)
+		}
+		pipe.SRem(ctx, connRouteNodes, node)
+		return nil
+	})
+	return errs.Wrap(err)
+}
@@ -0,0 +1,194 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	resumeSession = "MSG_GATEWAY_RESUME_SESSION:"
+	resumeRing    = "MSG_GATEWAY_RESUME_RING:"
+)
+
+// appendResumeScript appends a push to the ring of a session owned by the given connection.
+// It returns the push ID, or -1 if the session expired or was resumed by another connection.
+var appendResumeScript = redis.NewScript(`
+if redis.call('HGET', KEYS[1], 'conn_id') ~= ARGV[1] then
+	return -1
+end
+local id = redis.call('HINCRBY', KEYS[1], 'seq', 1)
+redis.call('RPUSH', KEYS[2], id .. ':' .. ARGV[2])
+redis.call('LTRIM', KEYS[2], -tonumber(ARGV[3]), -1)
+redis.call('EXPIRE', KEYS[1], ARGV[4])
+redis.call('EXPIRE', KEYS[2], ARGV[4])
+return id
+`)
+
+// takeResumeScript hands a session over to a new connection and returns the last push ID
+// together with the buffered pushes.
+var takeResumeScript = redis.NewScript(`
+if redis.call('HGET', KEYS[1], 'user_id') ~= ARGV[1] or redis.call('HGET', KEYS[1], 'platform_id') ~= ARGV[2] then
+	return false
+end
+redis.call('HSET', KEYS[1], 'conn_id', ARGV[3])
+redis.call('EXPIRE', KEYS[1], ARGV[4])
+redis.call('EXPIRE', KEYS[2], ARGV[4])
+return {redis.call('HGET', KEYS[1], 'seq'), redis.call('LRANGE', KEYS[2], 0, -1)}
+`)
+
+// ResumePush is a push to be buffered for the session identified by Token.
+type ResumePush struct {
+	Token  string
+	ConnID string
+	Data   []byte
+}
+
+// ResumeCache buffers the pushes sent to a gateway connection in a bounded ring, so that
+// a client reconnecting shortly after a disconnect can replay what it missed.
+// Sessions are identified by a resume token and owned by one connection at a time.
+type ResumeCache interface {
+	CreateResumeSession(ctx context.Context, userID string, platformID int, token string, connID string, ttl time.Duration) error
+	ExpireResumeSession(ctx context.Context, token string, ttl time.Duration) error
+	// AppendResumePushes 将推送写入各会话的环形缓冲区，返回对应的推送编号，会话已失效或被其他连接接管时为-1
+	AppendResumePushes(ctx context.Context, pushes []*ResumePush, ringSize int, ttl time.Duration) ([]int64, error)
+	// TakeResumeSession 由connID接管会话并返回lastID之后的推送，complete为false表示会话不存在或缓冲区已溢出
+	TakeResumeSession(ctx context.Context, userID string, platformID int, token string, connID string, lastID int64, ttl time.Duration) (pushes map[int64][]byte, complete bool, err error)
+	DelResumeSessions(ctx context.Context, tokens []string) error
+}
+
+func NewResumeCache(rdb redis.UniversalClient) ResumeCache {
+	return &resumeCache{rdb: rdb}
+}
+
+type resumeCache struct {
+	rdb redis.UniversalClient
+}
+
+// 使用hash tag保证同一会话的键位于同一个slot
+func (c *resumeCache) getSessionKey(token string) string {
+	return resumeSession + "{" + token + "}"
+}
+
+func (c *resumeCache) getRingKey(token string) string {
+	return resumeRing + "{" + token + "}"
+}
+
+func (c *resumeCache) CreateResumeSession(ctx context.Context, userID string, platformID int, token string, connID string, ttl time.Duration) error {
+	_, err := c.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, c.getRingKey(token))
+		pipe.HSet(ctx, c.getSessionKey(token), "user_id", userID, "platform_id", platformID, "conn_id", connID, "seq", 0)
+		pipe.Expire(ctx, c.getSessionKey(token), ttl)
+		return nil
+	})
+	return errs.Wrap(err)
+}
+
+func (c *resumeCache) ExpireResumeSession(ctx context.Context, token string, ttl time.Duration) error {
+	_, err := c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Expire(ctx, c.getSessionKey(token), ttl)
+		pipe.Expire(ctx, c.getRingKey(token), ttl)
+		return nil
+	})
+	return errs.Wrap(err)
+}
+
+func (c *resumeCache) AppendResumePushes(ctx context.Context, pushes []*ResumePush, ringSize int, ttl time.Duration) ([]int64, error) {
+	if len(pushes) == 0 {
+		return nil, nil
+	}
+	seconds := int64(ttl / time.Second)
+	cmds := make([]*redis.Cmd, len(pushes))
+	_, err := c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, push := range pushes {
+			keys := []string{c.getSessionKey(push.Token), c.getRingKey(push.Token)}
+			cmds[i] = appendResumeScript.Eval(ctx, pipe, keys, push.ConnID, push.Data, ringSize, seconds)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	ids := make([]int64, len(pushes))
+	for i, cmd := range cmds {
+		id, err := cmd.Int64()
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+func (c *resumeCache) TakeResumeSession(ctx context.Context, userID string, platformID int, token string, connID string, lastID int64, ttl time.Duration) (map[int64][]byte, bool, error) {
+	keys := []string{c.getSessionKey(token), c.getRingKey(token)}
+	res, err := takeResumeScript.Run(ctx, c.rdb, keys, userID, platformID, connID, int64(ttl/time.Second)).Slice()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, false, nil
+		}
+		return nil, false, errs.Wrap(err)
+	}
+	if len(res) != 2 {
+		return nil, false, errs.New("invalid resume session reply").Wrap()
+	}
+	seq, err := strconv.ParseInt(res[0].(string), 10, 64)
+	if err != nil {
+		return nil, false, errs.WrapMsg(err, "invalid resume session seq")
+	}
+	entries, _ := res[1].([]any)
+	pushes := make(map[int64][]byte)
+	firstID := seq + 1
+	for _, entry := range entries {
+		s, _ := entry.(string)
+		idStr, data, ok := strings.Cut(s, ":")
+		if !ok {
+			continue
+		}
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			continue
+		}
+		if id < firstID {
+			firstID = id
+		}
+		if id > lastID {
+			pushes[id] = []byte(data)
+		}
+	}
+	// 客户端收到的编号之后的推送必须全部仍在缓冲区中
+	complete := lastID <= seq && (lastID == seq || firstID <= lastID+1)
+	return pushes, complete, nil
+}
+
+func (c *resumeCache) DelResumeSessions(ctx context.Context, tokens []string) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+	_, err := c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, token := range tokens {
+			pipe.Del(ctx, c.getSessionKey(token), c.getRingKey(token))
+		}
+		return nil
+	})
+	return errs.Wrap(err)
+}
@@ -0,0 +1,153 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/Meikwei/go-tools/utils/stringutil"
+	"github.com/Meikwei/protocol/constant"
+	"github.com/Meikwei/protocol/sdkws"
+	"github.com/redis/go-redis/v9"
+)
+
+const sendDedup = "SEND_MSG_DEDUP:"
+
+// SentMsg is what a duplicate send of a message answers with. A message whose send is still in flight has no
+// SendTime yet, and Seq is filled in once msgtransfer has stored the message.
+type SentMsg struct {
+	ServerMsgID string
+	SendTime    int64
+	Seq         int64
+}
+
+// Pending reports whether the send of the message hasn't finished yet.
+func (s *SentMsg) Pending() bool {
+	return s.SendTime == 0
+}
+
+// SendDedupCache remembers the messages sent by (sendID, clientMsgID) for a window, so that client retries are not sent twice.
+// A record is owned by the server msg ID of the send that claimed it.
+type SendDedupCache interface {
+	// ClaimSendMsg records a pending send of the client message for pendingExpire. If the client message is already
+	// recorded, it returns the record and false.
+	ClaimSendMsg(ctx context.Context, sendID string, clientMsgID string, serverMsgID string, pendingExpire time.Duration) (*SentMsg, bool, error)
+	// CommitSendMsg completes the record of a successful send and keeps it for the window.
+	CommitSendMsg(ctx context.Context, sendID string, clientMsgID string, msg *SentMsg, window time.Duration) error
+	// DelSendMsg forgets a client message whose send failed, so that the client can retry it.
+	DelSendMsg(ctx context.Context, sendID string, clientMsgID string, serverMsgID string) error
+	// SetSentMsgSeqs adds the seqs of stored messages to their records.
+	SetSentMsgSeqs(ctx context.Context, msgs []*sdkws.MsgData) error
+}
+
+// claimSendMsgScript returns the record KEYS[1] if it exists, otherwise it creates a pending record
+// owned by ARGV[1] that expires after ARGV[2] milliseconds.
+var claimSendMsgScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	return redis.call('HGETALL', KEYS[1])
+end
+redis.call('HSET', KEYS[1], 'serverMsgID', ARGV[1])
+redis.call('PEXPIRE', KEYS[1], ARGV[2])
+return false
+`)
+
+// commitSendMsgScript sets the send time ARGV[2] of the record KEYS[1] owned by ARGV[1] and keeps it for ARGV[3] milliseconds.
+var commitSendMsgScript = redis.NewScript(`
+if redis.call('HGET', KEYS[1], 'serverMsgID') ~= ARGV[1] then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'sendTime', ARGV[2])
+redis.call('PEXPIRE', KEYS[1], ARGV[3])
+return 1
+`)
+
+// delSendMsgScript deletes the record KEYS[1] if it is owned by ARGV[1].
+var delSendMsgScript = redis.NewScript(`
+if redis.call('HGET', KEYS[1], 'serverMsgID') ~= ARGV[1] then
+	return 0
+end
+return redis.call('DEL', KEYS[1])
+`)
+
+// setSentMsgSeqScript sets the seq ARGV[2] of the record KEYS[1] if it is owned by ARGV[1].
+var setSentMsgSeqScript = redis.NewScript(`
+if redis.call('HGET', KEYS[1], 'serverMsgID') ~= ARGV[1] then
+	return 0
+end
+return redis.call('HSET', KEYS[1], 'seq', ARGV[2])
+`)
+
+func NewSendDedupCache(rdb redis.UniversalClient) SendDedupCache {
+	return &sendDedupCache{rdb: rdb}
+}
+
+type sendDedupCache struct {
+	rdb redis.UniversalClient
+}
+
+func (c *sendDedupCache) getSendDedupKey(sendID string, clientMsgID string) string {
+	return sendDedup + sendID + ":" + clientMsgID
+}
+
+func (c *sendDedupCache) ClaimSendMsg(ctx context.Context, sendID string, clientMsgID string, serverMsgID string, pendingExpire time.Duration) (*SentMsg, bool, error) {
+	key := c.getSendDedupKey(sendID, clientMsgID)
+	val, err := claimSendMsgScript.Run(ctx, c.rdb, []string{key}, serverMsgID, pendingExpire.Milliseconds()).StringSlice()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, true, nil
+		}
+		return nil, false, errs.Wrap(err)
+	}
+	var sent SentMsg
+	for i := 0; i+1 < len(val); i += 2 {
+		switch val[i] {
+		case "serverMsgID":
+			sent.ServerMsgID = val[i+1]
+		case "sendTime":
+			sent.SendTime = stringutil.StringToInt64(val[i+1])
+		case "seq":
+			sent.Seq = stringutil.StringToInt64(val[i+1])
+		}
+	}
+	return &sent, false, nil
+}
+
+func (c *sendDedupCache) CommitSendMsg(ctx context.Context, sendID string, clientMsgID string, msg *SentMsg, window time.Duration) error {
+	key := c.getSendDedupKey(sendID, clientMsgID)
+	return errs.Wrap(commitSendMsgScript.Run(ctx, c.rdb, []string{key}, msg.ServerMsgID, msg.SendTime, window.Milliseconds()).Err())
+}
+
+func (c *sendDedupCache) DelSendMsg(ctx context.Context, sendID string, clientMsgID string, serverMsgID string) error {
+	return errs.Wrap(delSendMsgScript.Run(ctx, c.rdb, []string{c.getSendDedupKey(sendID, clientMsgID)}, serverMsgID).Err())
+}
+
+func (c *sendDedupCache) SetSentMsgSeqs(ctx context.Context, msgs []*sdkws.MsgData) error {
+	pipe := c.rdb.Pipeline()
+	for _, msg := range msgs {
+		if msg.SendID == "" || msg.ClientMsgID == "" || msg.SessionType == constant.NotificationChatType {
+			continue
+		}
+		// Eval instead of Run, a pipeline can't fall back from EVALSHA
+		setSentMsgSeqScript.Eval(ctx, pipe, []string{c.getSendDedupKey(msg.SendID, msg.ClientMsgID)}, msg.ServerMsgID, msg.Seq)
+	}
+	if pipe.Len() == 0 {
+		return nil
+	}
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
@@ -2,16 +2,27 @@ package cache
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	relationtb "github.com/Meikwei/aetim/pkg/common/db/table/relation"
 	"github.com/Meikwei/go-tools/errs"
+	"github.com/Meikwei/go-tools/log"
+	"github.com/Meikwei/go-tools/utils/idutil"
 	"github.com/Meikwei/go-tools/utils/stringutil"
 	"github.com/redis/go-redis/v9"
 )
 
+// SeqCache keeps conversation seqs in redis. Max and min seqs are backed by relation.SeqInterface:
+// max seqs are allocated from blocks leased from mongo, so they stay monotonic and are never reused
+// after redis loses data, and min seqs are written through. Has-read and user min seqs live only in redis.
 type SeqCache interface {
+	// SetMaxSeq raises the max seq of the conversation to at least maxSeq, it never lowers it
 	SetMaxSeq(ctx context.Context, conversationID string, maxSeq int64) error
 	GetMaxSeqs(ctx context.Context, conversationIDs []string) (map[string]int64, error)
 	GetMaxSeq(ctx context.Context, conversationID string) (int64, error)
+	// IncrMaxSeq atomically allocates size seqs and returns the new max seq
+	IncrMaxSeq(ctx context.Context, conversationID string, size int64) (int64, error)
 	SetMinSeq(ctx context.Context, conversationID string, minSeq int64) error
 	SetMinSeqs(ctx context.Context, seqs map[string]int64) error
 	GetMinSeqs(ctx context.Context, conversationIDs []string) (map[string]int64, error)
@@ -33,18 +44,97 @@ type SeqCache interface {
 	GetHasReadSeq(ctx context.Context, userID string, conversationID string) (int64, error)
 }
 
-func NewSeqCache(rdb redis.UniversalClient) SeqCache {
-	return &seqCache{rdb: rdb}
+const (
+	mallocSeq     = "MALLOC_SEQ:"
+	mallocSeqLock = "MALLOC_SEQ_LOCK:"
+
+	// seqBlockSize is the number of seqs leased from mongo at a time.
+	// Losing redis data skips at most the unused part of one block.
+	seqBlockSize = 100
+
+	seqLockExpire   = time.Second * 3
+	seqLockInterval = time.Millisecond * 20
+	seqLockRetry    = 150
+
+	// seqWaitTimeout bounds how long an allocation waits for the replicas.
+	seqWaitTimeout = time.Second
+)
+
+// mallocSeqScript allocates ARGV[1] seqs from the leased block (curr, last].
+// It returns the new curr, or -1 if the block is missing or too small.
+var mallocSeqScript = redis.NewScript(`
+local curr = redis.call('HGET', KEYS[1], 'curr')
+local last = redis.call('HGET', KEYS[1], 'last')
+if curr == false or last == false then
+	return -1
+end
+if tonumber(curr) + tonumber(ARGV[1]) > tonumber(last) then
+	return -1
+end
+return redis.call('HINCRBY', KEYS[1], 'curr', ARGV[1])
+`)
+
+// leaseSeqScript installs the block (ARGV[1], ARGV[2]] leased from mongo. Neither curr nor last ever
+// decreases, and a block following the current one directly keeps its remaining seqs usable.
+// A conversation without curr, e.g. a new one, starts at the beginning of the block.
+var leaseSeqScript = redis.NewScript(`
+local curr = tonumber(redis.call('HGET', KEYS[1], 'curr') or '0')
+local last = tonumber(redis.call('HGET', KEYS[1], 'last') or '0')
+local start = tonumber(ARGV[1])
+local stop = tonumber(ARGV[2])
+if redis.call('HEXISTS', KEYS[1], 'curr') == 0 or (last ~= start and curr < start) then
+	redis.call('HSET', KEYS[1], 'curr', start)
+end
+if stop > last then
+	redis.call('HSET', KEYS[1], 'last', stop)
+end
+return 0
+`)
+
+// unlockSeqScript deletes the lock KEYS[1] only if it still holds the token ARGV[1].
+var unlockSeqScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// raiseSeqScript raises both curr and last to at least ARGV[1].
+var raiseSeqScript = redis.NewScript(`
+local seq = tonumber(ARGV[1])
+if tonumber(redis.call('HGET', KEYS[1], 'curr') or '0') < seq then
+	redis.call('HSET', KEYS[1], 'curr', seq)
+end
+if tonumber(redis.call('HGET', KEYS[1], 'last') or '0') < seq then
+	redis.call('HSET', KEYS[1], 'last', seq)
+end
+return 0
+`)
+
+// NewSeqCache returns a SeqCache whose allocations wait for replicas redis replicas, see mallocSeq.
+func NewSeqCache(rdb redis.UniversalClient, seqDB relationtb.SeqInterface, replicas int) SeqCache {
+	return &seqCache{rdb: rdb, seqDB: seqDB, replicas: replicas}
 }
 
 type seqCache struct {
-	rdb redis.UniversalClient
+	rdb      redis.UniversalClient
+	seqDB    relationtb.SeqInterface
+	replicas int
 }
 
+// getMaxSeqKey is the key used before seqs were leased from mongo, it is only read to carry the old max seq over.
 func (c *seqCache) getMaxSeqKey(conversationID string) string {
 	return maxSeq + conversationID
 }
 
+func (c *seqCache) getMallocSeqKey(conversationID string) string {
+	return mallocSeq + conversationID
+}
+
+func (c *seqCache) getMallocSeqLockKey(conversationID string) string {
+	return mallocSeqLock + conversationID
+}
+
 func (c *seqCache) getMinSeqKey(conversationID string) string {
 	return minSeq + conversationID
 }
@@ -85,19 +175,213 @@ func (c *seqCache) getSeqs(ctx context.Context, items []string, getkey func(s st
 	return m, nil
 }
 
+// lease takes a block of at least size seqs from mongo and installs it in redis.
+// floor carries over the max seq kept in redis before it was backed by mongo.
+func (c *seqCache) lease(ctx context.Context, conversationID string, size int64) error {
+	var floor int64
+	curr, err := c.rdb.HGet(ctx, c.getMallocSeqKey(conversationID), "curr").Int64()
+	switch {
+	case err == nil:
+		floor = curr
+	case errors.Is(err, redis.Nil):
+		floor, err = c.rdb.Get(ctx, c.getMaxSeqKey(conversationID)).Int64()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return errs.Wrap(err)
+		}
+	default:
+		return errs.Wrap(err)
+	}
+	size = max(size, seqBlockSize)
+	start, err := c.seqDB.Malloc(ctx, conversationID, size, floor)
+	if err != nil {
+		return err
+	}
+	return errs.Wrap(leaseSeqScript.Run(ctx, c.rdb, []string{c.getMallocSeqKey(conversationID)}, start, start+size).Err())
+}
+
+// lock takes the lease lock of the conversation with token, so that an expired holder can't release
+// the lock of the next one.
+func (c *seqCache) lock(ctx context.Context, conversationID string, token string) (bool, error) {
+	ok, err := c.rdb.SetNX(ctx, c.getMallocSeqLockKey(conversationID), token, seqLockExpire).Result()
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return ok, nil
+}
+
+func (c *seqCache) unlock(ctx context.Context, conversationID string, token string) {
+	if err := unlockSeqScript.Run(ctx, c.rdb, []string{c.getMallocSeqLockKey(conversationID)}, token).Err(); err != nil {
+		log.ZWarn(ctx, "unlock malloc seq failed", err, "conversationID", conversationID)
+	}
+}
+
+// mallocSeq allocates size seqs from the leased block, it returns -1 if a block must be leased first.
+// curr only lives in redis, so a failover to a replica that missed the allocation would hand the seqs out
+// again. With replicas set, the allocation is used only once it reached them.
+func (c *seqCache) mallocSeq(ctx context.Context, key string, size int64) (int64, error) {
+	if c.replicas <= 0 {
+		seq, err := mallocSeqScript.Run(ctx, c.rdb, []string{key}, size).Int64()
+		return seq, errs.Wrap(err)
+	}
+	node := redis.Cmdable(c.rdb)
+	if cluster, ok := c.rdb.(*redis.ClusterClient); ok {
+		master, err := cluster.MasterForKey(ctx, key)
+		if err != nil {
+			return 0, errs.Wrap(err)
+		}
+		node = master
+	}
+	// WAIT covers the writes of its own connection, a pipeline keeps both on one
+	var (
+		seqCmd  *redis.Cmd
+		waitCmd *redis.Cmd
+	)
+	if _, err := node.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		seqCmd = mallocSeqScript.Eval(ctx, pipe, []string{key}, size)
+		waitCmd = pipe.Do(ctx, "WAIT", c.replicas, seqWaitTimeout.Milliseconds())
+		return nil
+	}); err != nil {
+		return 0, errs.Wrap(err)
+	}
+	seq, err := seqCmd.Int64()
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	if seq < 0 {
+		return seq, nil
+	}
+	acked, err := waitCmd.Int64()
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	if acked < int64(c.replicas) {
+		return 0, errs.New("malloc seq not replicated", "key", key, "replicas", acked, "want", c.replicas).Wrap()
+	}
+	return seq, nil
+}
+
+func (c *seqCache) IncrMaxSeq(ctx context.Context, conversationID string, size int64) (int64, error) {
+	if size <= 0 {
+		return 0, errs.ErrArgs.WrapMsg("malloc seq size must be positive", "size", size)
+	}
+	key := c.getMallocSeqKey(conversationID)
+	for i := 0; i < seqLockRetry; i++ {
+		seq, err := c.mallocSeq(ctx, key, size)
+		if err != nil {
+			return 0, err
+		}
+		if seq >= 0 {
+			return seq, nil
+		}
+		token := idutil.OperationIDGenerator()
+		ok, err := c.lock(ctx, conversationID, token)
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			// another process is leasing a block for this conversation
+			select {
+			case <-ctx.Done():
+				return 0, errs.Wrap(ctx.Err())
+			case <-time.After(seqLockInterval):
+			}
+			continue
+		}
+		err = c.lease(ctx, conversationID, size)
+		c.unlock(ctx, conversationID, token)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return 0, errs.New("malloc seq timeout", "conversationID", conversationID).Wrap()
+}
+
+// rebuildMaxSeq restores the max seq of a conversation missing in redis from mongo, or carries the
+// legacy redis value over to mongo. It returns redis.Nil if the conversation has no seq at all.
+func (c *seqCache) rebuildMaxSeq(ctx context.Context, conversationID string, mongoSeq int64) (int64, error) {
+	if mongoSeq == 0 {
+		legacy, err := c.rdb.Get(ctx, c.getMaxSeqKey(conversationID)).Int64()
+		if err != nil {
+			return 0, errs.Wrap(err)
+		}
+		if mongoSeq, err = c.seqDB.Malloc(ctx, conversationID, 0, legacy); err != nil {
+			return 0, err
+		}
+	}
+	if err := raiseSeqScript.Run(ctx, c.rdb, []string{c.getMallocSeqKey(conversationID)}, mongoSeq).Err(); err != nil {
+		return 0, errs.Wrap(err)
+	}
+	seq, err := c.rdb.HGet(ctx, c.getMallocSeqKey(conversationID), "curr").Int64()
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return seq, nil
+}
+
 func (c *seqCache) SetMaxSeq(ctx context.Context, conversationID string, maxSeq int64) error {
-	return c.setSeq(ctx, conversationID, maxSeq, c.getMaxSeqKey)
+	if _, err := c.seqDB.Malloc(ctx, conversationID, 0, maxSeq); err != nil {
+		return err
+	}
+	return errs.Wrap(raiseSeqScript.Run(ctx, c.rdb, []string{c.getMallocSeqKey(conversationID)}, maxSeq).Err())
 }
 
-func (c *seqCache) GetMaxSeqs(ctx context.Context, conversationIDs []string) (m map[string]int64, err error) {
-	return c.getSeqs(ctx, conversationIDs, c.getMaxSeqKey)
+func (c *seqCache) GetMaxSeqs(ctx context.Context, conversationIDs []string) (map[string]int64, error) {
+	m := make(map[string]int64, len(conversationIDs))
+	var missing []string
+	for _, conversationID := range conversationIDs {
+		seq, err := c.rdb.HGet(ctx, c.getMallocSeqKey(conversationID), "curr").Int64()
+		switch {
+		case err == nil:
+			if seq != 0 {
+				m[conversationID] = seq
+			}
+		case errors.Is(err, redis.Nil):
+			missing = append(missing, conversationID)
+		default:
+			return nil, errs.Wrap(err)
+		}
+	}
+	if len(missing) == 0 {
+		return m, nil
+	}
+	mongoSeqs, err := c.seqDB.GetMaxSeqs(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+	for _, conversationID := range missing {
+		seq, err := c.rebuildMaxSeq(ctx, conversationID, mongoSeqs[conversationID])
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				continue
+			}
+			return nil, err
+		}
+		if seq != 0 {
+			m[conversationID] = seq
+		}
+	}
+	return m, nil
 }
 
 func (c *seqCache) GetMaxSeq(ctx context.Context, conversationID string) (int64, error) {
-	return c.getSeq(ctx, conversationID, c.getMaxSeqKey)
+	seq, err := c.rdb.HGet(ctx, c.getMallocSeqKey(conversationID), "curr").Int64()
+	if err == nil {
+		return seq, nil
+	}
+	if !errors.Is(err, redis.Nil) {
+		return 0, errs.Wrap(err)
+	}
+	mongoSeqs, err := c.seqDB.GetMaxSeqs(ctx, []string{conversationID})
+	if err != nil {
+		return 0, err
+	}
+	return c.rebuildMaxSeq(ctx, conversationID, mongoSeqs[conversationID])
 }
 
 func (c *seqCache) SetMinSeq(ctx context.Context, conversationID string, minSeq int64) error {
+	if err := c.seqDB.SetMinSeq(ctx, conversationID, minSeq); err != nil {
+		return err
+	}
 	return c.setSeq(ctx, conversationID, minSeq, c.getMinSeqKey)
 }
 
@@ -111,15 +395,62 @@ func (c *seqCache) setSeqs(ctx context.Context, seqs map[string]int64, getkey fu
 }
 
 func (c *seqCache) SetMinSeqs(ctx context.Context, seqs map[string]int64) error {
-	return c.setSeqs(ctx, seqs, c.getMinSeqKey)
+	for conversationID, seq := range seqs {
+		if err := c.SetMinSeq(ctx, conversationID, seq); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (c *seqCache) GetMinSeqs(ctx context.Context, conversationIDs []string) (map[string]int64, error) {
-	return c.getSeqs(ctx, conversationIDs, c.getMinSeqKey)
+	m := make(map[string]int64, len(conversationIDs))
+	var missing []string
+	for _, conversationID := range conversationIDs {
+		seq, err := c.rdb.Get(ctx, c.getMinSeqKey(conversationID)).Int64()
+		switch {
+		case err == nil:
+			if seq != 0 {
+				m[conversationID] = seq
+			}
+		case errors.Is(err, redis.Nil):
+			missing = append(missing, conversationID)
+		default:
+			return nil, errs.Wrap(err)
+		}
+	}
+	if len(missing) == 0 {
+		return m, nil
+	}
+	mongoSeqs, err := c.seqDB.GetMinSeqs(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+	for conversationID, seq := range mongoSeqs {
+		if err := c.setSeq(ctx, conversationID, seq, c.getMinSeqKey); err != nil {
+			return nil, err
+		}
+		if seq != 0 {
+			m[conversationID] = seq
+		}
+	}
+	return m, nil
 }
 
 func (c *seqCache) GetMinSeq(ctx context.Context, conversationID string) (int64, error) {
-	return c.getSeq(ctx, conversationID, c.getMinSeqKey)
+	seq, err := c.getSeq(ctx, conversationID, c.getMinSeqKey)
+	if err == nil || !errors.Is(err, redis.Nil) {
+		return seq, err
+	}
+	mongoSeqs, err := c.seqDB.GetMinSeqs(ctx, []string{conversationID})
+	if err != nil {
+		return 0, err
+	}
+	seq, ok := mongoSeqs[conversationID]
+	if !ok {
+		return 0, errs.Wrap(redis.Nil)
+	}
+	return seq, c.setSeq(ctx, conversationID, seq, c.getMinSeqKey)
 }
 
 func (c *seqCache) GetConversationUserMinSeq(ctx context.Context, conversationID string, userID string) (int64, error) {
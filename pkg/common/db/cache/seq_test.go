@@ -0,0 +1,125 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+// memSeq is an in-memory relation.SeqInterface.
+type memSeq struct {
+	lock sync.Mutex
+	max  map[string]int64
+}
+
+func (m *memSeq) Malloc(_ context.Context, conversationID string, size int64, floor int64) (int64, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	start := max(m.max[conversationID], floor)
+	m.max[conversationID] = start + size
+	return start, nil
+}
+
+func (m *memSeq) GetMaxSeqs(_ context.Context, conversationIDs []string) (map[string]int64, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	res := make(map[string]int64)
+	for _, conversationID := range conversationIDs {
+		if seq, ok := m.max[conversationID]; ok {
+			res[conversationID] = seq
+		}
+	}
+	return res, nil
+}
+
+func (m *memSeq) SetMinSeq(context.Context, string, int64) error {
+	return nil
+}
+
+func (m *memSeq) GetMinSeqs(context.Context, []string) (map[string]int64, error) {
+	return map[string]int64{}, nil
+}
+
+func newTestSeqCache(t *testing.T) (*seqCache, *memSeq, string) {
+	rdb := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	t.Cleanup(func() { rdb.Close() })
+	cid := fmt.Sprintf("cid-%v", rand.Int63())
+	seqDB := &memSeq{max: make(map[string]int64)}
+	return &seqCache{rdb: rdb, seqDB: seqDB}, seqDB, cid
+}
+
+func TestIncrMaxSeqNewConversation(t *testing.T) {
+	c, seqDB, cid := newTestSeqCache(t)
+	ctx := context.Background()
+
+	seq, err := c.IncrMaxSeq(ctx, cid, 1)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 1, seq)
+	seq, err = c.IncrMaxSeq(ctx, cid, 2)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 3, seq)
+	assert.EqualValues(t, seqBlockSize, seqDB.max[cid])
+}
+
+func TestIncrMaxSeqContiguousBlock(t *testing.T) {
+	c, seqDB, cid := newTestSeqCache(t)
+	ctx := context.Background()
+	seqDB.max[cid] = 100
+	assert.Nil(t, c.rdb.HSet(ctx, c.getMallocSeqKey(cid), "curr", 95, "last", 100).Err())
+
+	// the next block starts at the current last, so seqs 96-100 are still used
+	seq, err := c.IncrMaxSeq(ctx, cid, 10)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 105, seq)
+	assert.EqualValues(t, 100+seqBlockSize, seqDB.max[cid])
+}
+
+func TestIncrMaxSeqNonContiguousBlock(t *testing.T) {
+	c, seqDB, cid := newTestSeqCache(t)
+	ctx := context.Background()
+	// seqs up to 300 were leased elsewhere, e.g. before redis lost data
+	seqDB.max[cid] = 300
+	assert.Nil(t, c.rdb.HSet(ctx, c.getMallocSeqKey(cid), "curr", 95, "last", 100).Err())
+
+	seq, err := c.IncrMaxSeq(ctx, cid, 10)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 310, seq)
+	last, err := c.rdb.HGet(ctx, c.getMallocSeqKey(cid), "last").Int64()
+	assert.Nil(t, err)
+	assert.EqualValues(t, 300+seqBlockSize, last)
+}
+
+func TestSeqUnlockKeepsOtherToken(t *testing.T) {
+	c, _, cid := newTestSeqCache(t)
+	ctx := context.Background()
+
+	ok, err := c.lock(ctx, cid, "a")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	c.unlock(ctx, cid, "b")
+	token, err := c.rdb.Get(ctx, c.getMallocSeqLockKey(cid)).Result()
+	assert.Nil(t, err)
+	assert.Equal(t, "a", token)
+	c.unlock(ctx, cid, "a")
+	assert.ErrorIs(t, c.rdb.Get(ctx, c.getMallocSeqLockKey(cid)).Err(), redis.Nil)
+}
@@ -0,0 +1,203 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Meikwei/go-tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	unreadCount = "UNREAD_COUNT:"
+	// unreadCountExpire bounds how long an unread counter is maintained incrementally before it is rebuilt from the seqs,
+	// so that drift from races between new messages and read marks does not last.
+	unreadCountExpire = time.Hour * 24
+
+	// fields of the unread counter hash
+	unreadTotalField        = "total"
+	unreadConversationField = "c:" // unread count of a conversation
+	unreadMutedField        = "m:" // the conversation is not counted in the total
+)
+
+// incrUnreadScript adds ARGV[2] to the unread count of conversation ARGV[1], and to the total unless the conversation is muted.
+// Counters that don't exist are left to be rebuilt.
+var incrUnreadScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+redis.call('HINCRBY', KEYS[1], 'c:' .. ARGV[1], ARGV[2])
+if redis.call('HEXISTS', KEYS[1], 'm:' .. ARGV[1]) == 0 then
+	redis.call('HINCRBY', KEYS[1], 'total', ARGV[2])
+end
+return 1
+`)
+
+// setUnreadScript sets the unread count of conversation ARGV[1] to ARGV[2] and moves the total by the difference.
+var setUnreadScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+local old = tonumber(redis.call('HGET', KEYS[1], 'c:' .. ARGV[1]) or '0')
+local val = tonumber(ARGV[2])
+redis.call('HSET', KEYS[1], 'c:' .. ARGV[1], val)
+if redis.call('HEXISTS', KEYS[1], 'm:' .. ARGV[1]) == 0 then
+	redis.call('HINCRBY', KEYS[1], 'total', val - old)
+end
+return 1
+`)
+
+// UnreadCountCache keeps the unread message count of each user, per conversation and in total. The total leaves out
+// the conversations marked muted when the counter is built. Updates to a counter that doesn't exist are dropped;
+// GetUnreadCount returns redis.Nil for it and the caller rebuilds it with InitUnreadCount.
+type UnreadCountCache interface {
+	// IncrUnreadCounts adds counts[userID] to the unread count of the conversation of each user
+	IncrUnreadCounts(ctx context.Context, conversationID string, counts map[string]int64) error
+	// SetUnreadCounts sets the unread count of the conversation of each user
+	SetUnreadCounts(ctx context.Context, conversationID string, counts map[string]int64) error
+	// InitUnreadCount replaces the counter of the user
+	InitUnreadCount(ctx context.Context, userID string, counts map[string]int64, mutedConversationIDs []string) error
+	// GetUnreadCount returns the total and the unread count of each conversation
+	GetUnreadCount(ctx context.Context, userID string) (total int64, counts map[string]int64, err error)
+	// GetUnreadTotals returns the total of the users whose counter exists
+	GetUnreadTotals(ctx context.Context, userIDs []string) (map[string]int64, error)
+	// DelUnreadCounts drops the counters, e.g. after the conversations counted in the total changed
+	DelUnreadCounts(ctx context.Context, userIDs []string) error
+}
+
+func NewUnreadCountCache(rdb redis.UniversalClient) UnreadCountCache {
+	return &unreadCountCache{rdb: rdb}
+}
+
+type unreadCountCache struct {
+	rdb redis.UniversalClient
+}
+
+func (c *unreadCountCache) getUnreadCountKey(userID string) string {
+	return unreadCount + userID
+}
+
+func (c *unreadCountCache) evalUnreadCounts(ctx context.Context, script *redis.Script, conversationID string, counts map[string]int64) error {
+	if len(counts) == 0 {
+		return nil
+	}
+	pipe := c.rdb.Pipeline()
+	for userID, count := range counts {
+		// Eval instead of Run, a pipeline can't fall back from EVALSHA
+		script.Eval(ctx, pipe, []string{c.getUnreadCountKey(userID)}, conversationID, count)
+	}
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *unreadCountCache) IncrUnreadCounts(ctx context.Context, conversationID string, counts map[string]int64) error {
+	return c.evalUnreadCounts(ctx, incrUnreadScript, conversationID, counts)
+}
+
+func (c *unreadCountCache) SetUnreadCounts(ctx context.Context, conversationID string, counts map[string]int64) error {
+	return c.evalUnreadCounts(ctx, setUnreadScript, conversationID, counts)
+}
+
+func (c *unreadCountCache) InitUnreadCount(ctx context.Context, userID string, counts map[string]int64, mutedConversationIDs []string) error {
+	muted := make(map[string]struct{}, len(mutedConversationIDs))
+	values := make([]any, 0, len(counts)*2+len(mutedConversationIDs)*2+2)
+	for _, conversationID := range mutedConversationIDs {
+		muted[conversationID] = struct{}{}
+		values = append(values, unreadMutedField+conversationID, 1)
+	}
+	var total int64
+	for conversationID, count := range counts {
+		values = append(values, unreadConversationField+conversationID, count)
+		if _, ok := muted[conversationID]; !ok {
+			total += count
+		}
+	}
+	values = append(values, unreadTotalField, total)
+	key := c.getUnreadCountKey(userID)
+	pipe := c.rdb.TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.HSet(ctx, key, values...)
+	pipe.Expire(ctx, key, unreadCountExpire)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *unreadCountCache) GetUnreadCount(ctx context.Context, userID string) (int64, map[string]int64, error) {
+	val, err := c.rdb.HGetAll(ctx, c.getUnreadCountKey(userID)).Result()
+	if err != nil {
+		return 0, nil, errs.Wrap(err)
+	}
+	if len(val) == 0 {
+		return 0, nil, errs.Wrap(redis.Nil)
+	}
+	var total int64
+	counts := make(map[string]int64)
+	for field, v := range val {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, nil, errs.WrapMsg(err, "invalid unread count", "userID", userID, "field", field)
+		}
+		switch {
+		case field == unreadTotalField:
+			total = n
+		case strings.HasPrefix(field, unreadConversationField):
+			counts[strings.TrimPrefix(field, unreadConversationField)] = n
+		}
+	}
+	return total, counts, nil
+}
+
+func (c *unreadCountCache) GetUnreadTotals(ctx context.Context, userIDs []string) (map[string]int64, error) {
+	if len(userIDs) == 0 {
+		return map[string]int64{}, nil
+	}
+	pipe := c.rdb.Pipeline()
+	cmds := make([]*redis.StringCmd, len(userIDs))
+	for i, userID := range userIDs {
+		cmds[i] = pipe.HGet(ctx, c.getUnreadCountKey(userID), unreadTotalField)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, errs.Wrap(err)
+	}
+	totals := make(map[string]int64, len(userIDs))
+	for i, cmd := range cmds {
+		total, err := cmd.Int64()
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				continue
+			}
+			return nil, errs.Wrap(err)
+		}
+		totals[userIDs[i]] = total
+	}
+	return totals, nil
+}
+
+func (c *unreadCountCache) DelUnreadCounts(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	pipe := c.rdb.Pipeline()
+	for _, userID := range userIDs {
+		pipe.Del(ctx, c.getUnreadCountKey(userID))
+	}
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
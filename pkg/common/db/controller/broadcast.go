@@ -0,0 +1,149 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/go-tools/db/pagination"
+	"github.com/Meikwei/go-tools/errs"
+)
+
+// BroadcastDatabase 广播任务及其接收者的存储，状态变更方法在前置条件不满足时返回mongo.ErrNoDocuments。
+type BroadcastDatabase interface {
+	CreateBroadcastJob(ctx context.Context, job *relation.BroadcastJobModel) error
+	TakeBroadcastJob(ctx context.Context, jobID string) (*relation.BroadcastJobModel, error)
+	FindBroadcastJobs(ctx context.Context, status []int32, pagination pagination.Pagination) (int64, []*relation.BroadcastJobModel, error)
+	PauseBroadcastJob(ctx context.Context, jobID string) error
+	ResumeBroadcastJob(ctx context.Context, jobID string) error
+	// CancelBroadcastJob 取消任务并删除其已发送接收者的记录
+	CancelBroadcastJob(ctx context.Context, jobID string) error
+	ClaimBroadcastJob(ctx context.Context, now time.Time, owner string, lease time.Duration) (*relation.BroadcastJobModel, error)
+	// ProgressBroadcastJob 记录处理到的接收者和本批的发送结果，并将领取续期lease
+	ProgressBroadcastJob(ctx context.Context, jobID string, owner string, cursor string, sent int64, failed int64, lease time.Duration) error
+	// FinishBroadcastJob 结束任务并删除其已发送接收者的记录
+	FinishBroadcastJob(ctx context.Context, jobID string, owner string, status int32, errMsg string) error
+	// MarkBroadcastSent 在发送前记录接收者，接收者在任务中断前已记录过时返回false，不应再次发送
+	MarkBroadcastSent(ctx context.Context, jobID string, userID string) (bool, error)
+	AddBroadcastFailures(ctx context.Context, failures []*relation.BroadcastFailureModel) error
+	FindBroadcastFailures(ctx context.Context, jobID string, pagination pagination.Pagination) (int64, []*relation.BroadcastFailureModel, error)
+	// CountBroadcastRecipients 统计任务目标的接收者数
+	CountBroadcastRecipients(ctx context.Context, job *relation.BroadcastJobModel) (int64, error)
+	// NextBroadcastRecipients 按user_id升序返回cursor之后的接收者，结果为空表示没有更多接收者
+	NextBroadcastRecipients(ctx context.Context, job *relation.BroadcastJobModel, cursor string, limit int64) ([]string, error)
+}
+
+type broadcastDatabase struct {
+	broadcastDB   relation.BroadcastInterface
+	userDB        relation.UserModelInterface
+	groupMemberDB relation.GroupMemberModelInterface
+}
+
+func NewBroadcastDatabase(broadcastDB relation.BroadcastInterface, userDB relation.UserModelInterface, groupMemberDB relation.GroupMemberModelInterface) BroadcastDatabase {
+	return &broadcastDatabase{broadcastDB: broadcastDB, userDB: userDB, groupMemberDB: groupMemberDB}
+}
+
+func (b *broadcastDatabase) CreateBroadcastJob(ctx context.Context, job *relation.BroadcastJobModel) error {
+	return b.broadcastDB.Create(ctx, job)
+}
+
+func (b *broadcastDatabase) TakeBroadcastJob(ctx context.Context, jobID string) (*relation.BroadcastJobModel, error) {
+	return b.broadcastDB.Take(ctx, jobID)
+}
+
+func (b *broadcastDatabase) FindBroadcastJobs(ctx context.Context, status []int32, pagination pagination.Pagination) (int64, []*relation.BroadcastJobModel, error) {
+	return b.broadcastDB.Find(ctx, status, pagination)
+}
+
+func (b *broadcastDatabase) PauseBroadcastJob(ctx context.Context, jobID string) error {
+	return b.broadcastDB.Pause(ctx, jobID)
+}
+
+func (b *broadcastDatabase) ResumeBroadcastJob(ctx context.Context, jobID string) error {
+	return b.broadcastDB.Resume(ctx, jobID)
+}
+
+func (b *broadcastDatabase) CancelBroadcastJob(ctx context.Context, jobID string) error {
+	if err := b.broadcastDB.Cancel(ctx, jobID); err != nil {
+		return err
+	}
+	return b.broadcastDB.DeleteSent(ctx, jobID)
+}
+
+func (b *broadcastDatabase) ClaimBroadcastJob(ctx context.Context, now time.Time, owner string, lease time.Duration) (*relation.BroadcastJobModel, error) {
+	return b.broadcastDB.Claim(ctx, now, owner, lease)
+}
+
+func (b *broadcastDatabase) ProgressBroadcastJob(ctx context.Context, jobID string, owner string, cursor string, sent int64, failed int64, lease time.Duration) error {
+	return b.broadcastDB.Progress(ctx, jobID, owner, cursor, sent, failed, time.Now().Add(lease))
+}
+
+func (b *broadcastDatabase) FinishBroadcastJob(ctx context.Context, jobID string, owner string, status int32, errMsg string) error {
+	if err := b.broadcastDB.Finish(ctx, jobID, owner, status, errMsg); err != nil {
+		return err
+	}
+	return b.broadcastDB.DeleteSent(ctx, jobID)
+}
+
+func (b *broadcastDatabase) MarkBroadcastSent(ctx context.Context, jobID string, userID string) (bool, error) {
+	return b.broadcastDB.MarkSent(ctx, jobID, userID)
+}
+
+func (b *broadcastDatabase) AddBroadcastFailures(ctx context.Context, failures []*relation.BroadcastFailureModel) error {
+	return b.broadcastDB.AddFailures(ctx, failures)
+}
+
+func (b *broadcastDatabase) FindBroadcastFailures(ctx context.Context, jobID string, pagination pagination.Pagination) (int64, []*relation.BroadcastFailureModel, error) {
+	return b.broadcastDB.FindFailures(ctx, jobID, pagination)
+}
+
+func (b *broadcastDatabase) CountBroadcastRecipients(ctx context.Context, job *relation.BroadcastJobModel) (int64, error) {
+	switch job.TargetType {
+	case relation.BroadcastTargetAll:
+		return b.userDB.CountUsers(ctx, nil)
+	case relation.BroadcastTargetUsers:
+		return int64(len(job.UserIDs)), nil
+	case relation.BroadcastTargetGroups:
+		return b.groupMemberDB.CountDistinctUserID(ctx, job.GroupIDs)
+	case relation.BroadcastTargetFilter:
+		return b.userDB.CountUsers(ctx, job.UserFilter())
+	default:
+		return 0, errs.ErrArgs.WrapMsg("unknown broadcast target type", "targetType", job.TargetType)
+	}
+}
+
+func (b *broadcastDatabase) NextBroadcastRecipients(ctx context.Context, job *relation.BroadcastJobModel, cursor string, limit int64) ([]string, error) {
+	switch job.TargetType {
+	case relation.BroadcastTargetAll:
+		return b.userDB.FindUserIDsAfter(ctx, nil, cursor, limit)
+	case relation.BroadcastTargetUsers:
+		return nextUserIDs(job.UserIDs, cursor, limit), nil
+	case relation.BroadcastTargetGroups:
+		return b.groupMemberDB.FindUserIDsAfter(ctx, job.GroupIDs, cursor, limit)
+	case relation.BroadcastTargetFilter:
+		return b.userDB.FindUserIDsAfter(ctx, job.UserFilter(), cursor, limit)
+	default:
+		return nil, errs.ErrArgs.WrapMsg("unknown broadcast target type", "targetType", job.TargetType)
+	}
+}
+
+// nextUserIDs 返回有序的userIDs中cursor之后的至多limit个用户
+func nextUserIDs(userIDs []string, cursor string, limit int64) []string {
+	i := sort.Search(len(userIDs), func(i int) bool { return userIDs[i] > cursor })
+	return userIDs[i:min(i+int(limit), len(userIDs))]
+}
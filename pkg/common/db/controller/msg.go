@@ -17,6 +17,7 @@ package controller
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/Meikwei/aetim/pkg/common/config"
@@ -24,6 +25,7 @@ import (
 	"github.com/Meikwei/aetim/pkg/common/db/cache"
 	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
 	"github.com/Meikwei/aetim/pkg/common/prommetrics"
+	"github.com/Meikwei/aetim/pkg/msgprocessor"
 	"github.com/Meikwei/go-tools/errs"
 	"github.com/Meikwei/go-tools/log"
 	"github.com/Meikwei/go-tools/mq/kafka"
@@ -39,6 +41,9 @@ import (
 const (
 	updateKeyMsg = iota
 	updateKeyRevoke
+	updateKeyEdit
+	updateKeyReaction
+	updateKeyThread
 )
 
 // CommonMsgDatabase defines the interface for message database operations.
@@ -47,6 +52,23 @@ type CommonMsgDatabase interface {
 	BatchInsertChat2DB(ctx context.Context, conversationID string, msgs []*sdkws.MsgData, currentMaxSeq int64) error
 	// RevokeMsg revokes a message in a conversation.
 	RevokeMsg(ctx context.Context, conversationID string, seq int64, revoke *relation.RevokeModel) error
+	// EditMsg records an edit of a message, msg is the message with the edited content.
+	EditMsg(ctx context.Context, conversationID string, seq int64, edit *relation.EditModel, msg *sdkws.MsgData) error
+	// GetMsgEdits returns the edits of a message, oldest first.
+	GetMsgEdits(ctx context.Context, userID string, conversationID string, seq int64) ([]*relation.EditModel, error)
+	// AddMsgReaction records a reaction to msg and refreshes the cached msg with the reaction summary.
+	// It returns whether the reactions changed and the reactions after the change.
+	AddMsgReaction(ctx context.Context, conversationID string, msg *sdkws.MsgData, reaction *relation.ReactionModel) (bool, []*relation.ReactionModel, error)
+	// RemoveMsgReaction removes a reaction from msg and refreshes the cached msg with the reaction summary.
+	// It returns whether the reactions changed and the reactions after the change.
+	RemoveMsgReaction(ctx context.Context, conversationID string, msg *sdkws.MsgData, reaction *relation.ReactionModel) (bool, []*relation.ReactionModel, error)
+	// GetMsgReactions returns the reactions of a message, oldest first.
+	GetMsgReactions(ctx context.Context, conversationID string, seq int64) ([]*relation.ReactionModel, error)
+	// AppendMsgs allocates seqs for msgs and stores them in Redis and Mongo directly instead of going
+	// through Kafka, for message streams such as threads that are not bound to a Kafka partition.
+	AppendMsgs(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error
+	// SetMsgThread records the thread metadata on the root msg and refreshes the cached msg with the thread summary.
+	SetMsgThread(ctx context.Context, conversationID string, msg *sdkws.MsgData, thread *relation.MsgThreadModel) error
 	// MarkSingleChatMsgsAsRead marks messages as read for a single chat by sequence numbers.
 	MarkSingleChatMsgsAsRead(ctx context.Context, userID string, conversationID string, seqs []int64) error
 	// DeleteMessagesFromCache deletes message caches from Redis by sequence numbers.
@@ -62,6 +84,9 @@ type CommonMsgDatabase interface {
 	// DeleteConversationMsgsAndSetMinSeq deletes conversation messages and resets the minimum sequence number. If `remainTime` is 0, all messages are deleted (this method does not delete Redis
 	// cache).
 	DeleteConversationMsgsAndSetMinSeq(ctx context.Context, conversationID string, remainTime int64) error
+	// DeleteConversationMsgsBeforeLastAndSetMinSeq keeps only the latest `remainCount` messages of the conversation, deletes the older ones and resets the minimum
+	// sequence number (this method does not delete Redis cache).
+	DeleteConversationMsgsBeforeLastAndSetMinSeq(ctx context.Context, conversationID string, remainCount int64) error
 	// UserMsgsDestruct marks messages for deletion based on destruct time and returns a list of sequence numbers for marked messages.
 	UserMsgsDestruct(ctx context.Context, userID string, conversationID string, destructTime int64, lastMsgDestructTime time.Time) (seqs []int64, err error)
 	// DeleteUserMsgsBySeqs allows a user to delete messages based on sequence numbers.
@@ -200,6 +225,12 @@ func (db *commonMsgDatabase) BatchInsertBlock(ctx context.Context, conversationI
 			}
 		case updateKeyRevoke:
 			_, ok = field.(*relation.RevokeModel)
+		case updateKeyEdit:
+			_, ok = field.(*relation.EditModel)
+		case updateKeyReaction:
+			_, ok = field.(*relation.ReactionModel)
+		case updateKeyThread:
+			_, ok = field.(*relation.MsgThreadModel)
 		default:
 			return errs.ErrInternalServer.WrapMsg("key is invalid")
 		}
@@ -221,6 +252,12 @@ func (db *commonMsgDatabase) BatchInsertBlock(ctx context.Context, conversationI
 			res, err = db.msgDocDatabase.UpdateMsg(ctx, docID, index, "msg", field)
 		case updateKeyRevoke:
 			res, err = db.msgDocDatabase.UpdateMsg(ctx, docID, index, "revoke", field)
+		case updateKeyEdit:
+			res, err = db.msgDocDatabase.PushUnique(ctx, docID, index, "edits", []any{field})
+		case updateKeyReaction:
+			res, err = db.msgDocDatabase.PushUnique(ctx, docID, index, "reactions", []any{field})
+		case updateKeyThread:
+			res, err = db.msgDocDatabase.UpdateMsg(ctx, docID, index, "thread", field)
 		}
 		if err != nil {
 			return false, err
@@ -259,6 +296,18 @@ func (db *commonMsgDatabase) BatchInsertBlock(ctx context.Context, conversationI
 				doc.Msg[db.msgTable.GetMsgIndex(seq)] = &relation.MsgInfoModel{
 					Revoke: fields[j].(*relation.RevokeModel),
 				}
+			case updateKeyEdit:
+				doc.Msg[db.msgTable.GetMsgIndex(seq)] = &relation.MsgInfoModel{
+					Edits: []*relation.EditModel{fields[j].(*relation.EditModel)},
+				}
+			case updateKeyReaction:
+				doc.Msg[db.msgTable.GetMsgIndex(seq)] = &relation.MsgInfoModel{
+					Reactions: []*relation.ReactionModel{fields[j].(*relation.ReactionModel)},
+				}
+			case updateKeyThread:
+				doc.Msg[db.msgTable.GetMsgIndex(seq)] = &relation.MsgInfoModel{
+					Thread: fields[j].(*relation.MsgThreadModel),
+				}
 			}
 		}
 		for i, model := range doc.Msg {
@@ -334,6 +383,130 @@ func (db *commonMsgDatabase) RevokeMsg(ctx context.Context, conversationID strin
 	return db.BatchInsertBlock(ctx, conversationID, []any{revoke}, updateKeyRevoke, seq)
 }
 
+// EditMsg appends an edit of the message to Mongo and replaces the cached message with the edited one.
+func (db *commonMsgDatabase) EditMsg(ctx context.Context, conversationID string, seq int64, edit *relation.EditModel, msg *sdkws.MsgData) error {
+	if err := db.BatchInsertBlock(ctx, conversationID, []any{edit}, updateKeyEdit, seq); err != nil {
+		return err
+	}
+	if _, err := db.msg.SetMessageToCache(ctx, conversationID, []*sdkws.MsgData{msg}); err != nil {
+		log.ZWarn(ctx, "set edited msg to cache failed", err, "conversationID", conversationID, "seq", seq)
+		return db.msg.DeleteMessages(ctx, conversationID, []int64{seq})
+	}
+	return nil
+}
+
+func (db *commonMsgDatabase) GetMsgEdits(ctx context.Context, userID string, conversationID string, seq int64) ([]*relation.EditModel, error) {
+	msgs, err := db.msgDocDatabase.GetMsgBySeqIndexIn1Doc(ctx, userID, db.msgTable.GetDocID(conversationID, seq), []int64{seq})
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(msgs) == 0 {
+		return nil, nil
+	}
+	return msgs[0].Edits, nil
+}
+
+func (db *commonMsgDatabase) AddMsgReaction(ctx context.Context, conversationID string, msg *sdkws.MsgData, reaction *relation.ReactionModel) (bool, []*relation.ReactionModel, error) {
+	reactions, err := db.GetMsgReactions(ctx, conversationID, msg.Seq)
+	if err != nil {
+		return false, nil, err
+	}
+	for _, r := range reactions {
+		if r.UserID == reaction.UserID && r.Emoji == reaction.Emoji {
+			return false, reactions, nil
+		}
+	}
+	if err := db.BatchInsertBlock(ctx, conversationID, []any{reaction}, updateKeyReaction, msg.Seq); err != nil {
+		return false, nil, err
+	}
+	reactions, err = db.refreshMsgReactions(ctx, conversationID, msg)
+	if err != nil {
+		return false, nil, err
+	}
+	return true, reactions, nil
+}
+
+func (db *commonMsgDatabase) RemoveMsgReaction(ctx context.Context, conversationID string, msg *sdkws.MsgData, reaction *relation.ReactionModel) (bool, []*relation.ReactionModel, error) {
+	docID := db.msgTable.GetDocID(conversationID, msg.Seq)
+	res, err := db.msgDocDatabase.PullField(ctx, docID, db.msgTable.GetMsgIndex(msg.Seq), "reactions", reaction)
+	if err != nil {
+		return false, nil, err
+	}
+	if res.ModifiedCount == 0 {
+		reactions, err := db.GetMsgReactions(ctx, conversationID, msg.Seq)
+		if err != nil {
+			return false, nil, err
+		}
+		return false, reactions, nil
+	}
+	reactions, err := db.refreshMsgReactions(ctx, conversationID, msg)
+	if err != nil {
+		return false, nil, err
+	}
+	return true, reactions, nil
+}
+
+// refreshMsgReactions reloads the reactions of msg and writes msg with the new reaction summary to the cache.
+func (db *commonMsgDatabase) refreshMsgReactions(ctx context.Context, conversationID string, msg *sdkws.MsgData) ([]*relation.ReactionModel, error) {
+	reactions, err := db.GetMsgReactions(ctx, conversationID, msg.Seq)
+	if err != nil {
+		return nil, err
+	}
+	msg.AttachedInfo = msgprocessor.SetReactionSummary(msg.AttachedInfo, relation.ReactionEmojis(reactions))
+	if _, err := db.msg.SetMessageToCache(ctx, conversationID, []*sdkws.MsgData{msg}); err != nil {
+		log.ZWarn(ctx, "set reacted msg to cache failed", err, "conversationID", conversationID, "seq", msg.Seq)
+		return reactions, db.msg.DeleteMessages(ctx, conversationID, []int64{msg.Seq})
+	}
+	return reactions, nil
+}
+
+func (db *commonMsgDatabase) GetMsgReactions(ctx context.Context, conversationID string, seq int64) ([]*relation.ReactionModel, error) {
+	return db.msgDocDatabase.GetReactions(ctx, db.msgTable.GetDocID(conversationID, seq), db.msgTable.GetMsgIndex(seq))
+}
+
+func (db *commonMsgDatabase) AppendMsgs(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error {
+	if len(msgs) == 0 {
+		return errs.ErrArgs.WrapMsg("msgs is empty")
+	}
+	if int64(len(msgs)) > db.msgTable.GetSingleGocMsgNum() {
+		return errs.New("message count exceeds limit", "limit", db.msgTable.GetSingleGocMsgNum()).Wrap()
+	}
+	maxSeq, err := db.seq.IncrMaxSeq(ctx, conversationID, int64(len(msgs)))
+	if err != nil {
+		return err
+	}
+	userSeqMap := make(map[string]int64)
+	for i, msg := range msgs {
+		msg.Seq = maxSeq - int64(len(msgs)-1-i)
+		userSeqMap[msg.SendID] = msg.Seq
+	}
+	if err := db.BatchInsertChat2DB(ctx, conversationID, msgs, msgs[0].Seq-1); err != nil {
+		return err
+	}
+	if _, err := db.msg.SetMessageToCache(ctx, conversationID, msgs); err != nil {
+		log.ZWarn(ctx, "set appended msgs to cache failed", err, "conversationID", conversationID)
+	}
+	if err := db.seq.SetHasReadSeqs(ctx, conversationID, userSeqMap); err != nil {
+		log.ZWarn(ctx, "SetHasReadSeqs error", err, "userSeqMap", userSeqMap, "conversationID", conversationID)
+	}
+	return nil
+}
+
+func (db *commonMsgDatabase) SetMsgThread(ctx context.Context, conversationID string, msg *sdkws.MsgData, thread *relation.MsgThreadModel) error {
+	if err := db.BatchInsertBlock(ctx, conversationID, []any{thread}, updateKeyThread, msg.Seq); err != nil {
+		return err
+	}
+	msg.AttachedInfo = msgprocessor.SetThreadSummary(msg.AttachedInfo, convert.MsgThreadDB2Summary(thread))
+	if _, err := db.msg.SetMessageToCache(ctx, conversationID, []*sdkws.MsgData{msg}); err != nil {
+		log.ZWarn(ctx, "set thread root msg to cache failed", err, "conversationID", conversationID, "seq", msg.Seq)
+		return db.msg.DeleteMessages(ctx, conversationID, []int64{msg.Seq})
+	}
+	return nil
+}
+
 func (db *commonMsgDatabase) MarkSingleChatMsgsAsRead(ctx context.Context, userID string, conversationID string, totalSeqs []int64) error {
 	for docID, seqs := range db.msgTable.GetDocIDSeqsMap(conversationID, totalSeqs) {
 		var indexes []int64
@@ -358,11 +531,6 @@ func (db *commonMsgDatabase) DelUserDeleteMsgsList(ctx context.Context, conversa
 }
 
 func (db *commonMsgDatabase) BatchInsertChat2Cache(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) (seq int64, isNew bool, err error) {
-	currentMaxSeq, err := db.seq.GetMaxSeq(ctx, conversationID)
-	if err != nil && errs.Unwrap(err) != redis.Nil {
-		log.ZError(ctx, "db.seq.GetMaxSeq", err)
-		return 0, false, err
-	}
 	lenList := len(msgs)
 	if int64(lenList) > db.msgTable.GetSingleGocMsgNum() {
 		return 0, false, errs.New("message count exceeds limit", "limit", db.msgTable.GetSingleGocMsgNum()).Wrap()
@@ -370,14 +538,17 @@ func (db *commonMsgDatabase) BatchInsertChat2Cache(ctx context.Context, conversa
 	if lenList < 1 {
 		return 0, false, errs.New("no messages to insert", "minCount", 1).Wrap()
 	}
-	if errs.Unwrap(err) == redis.Nil {
-		isNew = true
+	currentMaxSeq, err := db.seq.IncrMaxSeq(ctx, conversationID, int64(lenList))
+	if err != nil {
+		log.ZError(ctx, "db.seq.IncrMaxSeq", err, "conversationID", conversationID)
+		prommetrics.SeqSetFailedCounter.Inc()
+		return 0, false, err
 	}
-	lastMaxSeq := currentMaxSeq
+	lastMaxSeq := currentMaxSeq - int64(lenList)
+	isNew = lastMaxSeq == 0
 	userSeqMap := make(map[string]int64)
-	for _, m := range msgs {
-		currentMaxSeq++
-		m.Seq = currentMaxSeq
+	for i, m := range msgs {
+		m.Seq = lastMaxSeq + int64(i+1)
 		userSeqMap[m.SendID] = m.Seq
 	}
 
@@ -389,12 +560,6 @@ func (db *commonMsgDatabase) BatchInsertChat2Cache(ctx context.Context, conversa
 		prommetrics.MsgInsertRedisSuccessCounter.Inc()
 	}
 
-	err = db.seq.SetMaxSeq(ctx, conversationID, currentMaxSeq)
-	if err != nil {
-		log.ZError(ctx, "db.seq.SetMaxSeq error", err, "conversationID", conversationID)
-		prommetrics.SeqSetFailedCounter.Inc()
-	}
-
 	err = db.seq.SetHasReadSeqs(ctx, conversationID, userSeqMap)
 	if err != nil {
 		log.ZError(ctx, "SetHasReadSeqs error", err, "userSeqMap", userSeqMap, "conversationID", conversationID)
@@ -690,7 +855,9 @@ func (db *commonMsgDatabase) GetMsgBySeqs(ctx context.Context, userID string, co
 func (db *commonMsgDatabase) DeleteConversationMsgsAndSetMinSeq(ctx context.Context, conversationID string, remainTime int64) error {
 	var delStruct delMsgRecursionStruct
 	var skip int64
-	minSeq, err := db.deleteMsgRecursion(ctx, conversationID, skip, &delStruct, remainTime)
+	minSeq, err := db.deleteMsgRecursion(ctx, conversationID, skip, &delStruct, func(msg *relation.MsgDataModel) bool {
+		return timeutil.GetCurrentTimestampByMill() > msg.SendTime+(remainTime*1000)
+	})
 	if err != nil {
 		return err
 	}
@@ -707,6 +874,33 @@ func (db *commonMsgDatabase) DeleteConversationMsgsAndSetMinSeq(ctx context.Cont
 	return db.seq.SetMinSeq(ctx, conversationID, minSeq)
 }
 
+func (db *commonMsgDatabase) DeleteConversationMsgsBeforeLastAndSetMinSeq(ctx context.Context, conversationID string, remainCount int64) error {
+	maxSeq, err := db.seq.GetMaxSeq(ctx, conversationID)
+	if err != nil {
+		if errs.Unwrap(err) == redis.Nil {
+			return nil
+		}
+		return err
+	}
+	// keep seq (maxSeq-remainCount, maxSeq]
+	lastDelSeq := maxSeq - remainCount
+	if lastDelSeq <= 0 {
+		return nil
+	}
+	var delStruct delMsgRecursionStruct
+	minSeq, err := db.deleteMsgRecursion(ctx, conversationID, 0, &delStruct, func(msg *relation.MsgDataModel) bool {
+		return msg.Seq <= lastDelSeq
+	})
+	if err != nil {
+		return err
+	}
+	log.ZDebug(ctx, "DeleteConversationMsgsBeforeLastAndSetMinSeq", "conversationID", conversationID, "maxSeq", maxSeq, "minSeq", minSeq)
+	if minSeq <= 1 {
+		return nil
+	}
+	return db.seq.SetMinSeq(ctx, conversationID, minSeq)
+}
+
 func (db *commonMsgDatabase) UserMsgsDestruct(ctx context.Context, userID string, conversationID string, destructTime int64, lastMsgDestructTime time.Time) (seqs []int64, err error) {
 	var index int64
 	for {
@@ -776,7 +970,8 @@ func (d *delMsgRecursionStruct) getSetMinSeq() int64 {
 // seq 70
 // set minSeq 21
 // recursion deletes the list and returns the set minimum seq.
-func (db *commonMsgDatabase) deleteMsgRecursion(ctx context.Context, conversationID string, index int64, delStruct *delMsgRecursionStruct, remainTime int64) (int64, error) {
+// expired reports whether a message should be deleted, it must be monotonic in seq.
+func (db *commonMsgDatabase) deleteMsgRecursion(ctx context.Context, conversationID string, index int64, delStruct *delMsgRecursionStruct, expired func(msg *relation.MsgDataModel) bool) (int64, error) {
 	// find from oldest list
 	msgDocModel, err := db.msgDocDatabase.GetMsgDocModelByIndex(ctx, conversationID, index, 1)
 	if err != nil || msgDocModel.DocID == "" {
@@ -798,7 +993,7 @@ func (db *commonMsgDatabase) deleteMsgRecursion(ctx context.Context, conversatio
 	if int64(len(msgDocModel.Msg)) > db.msgTable.GetSingleGocMsgNum() {
 		log.ZWarn(ctx, "msgs too large", nil, "lenth", len(msgDocModel.Msg), "docID:", msgDocModel.DocID)
 	}
-	if msgDocModel.IsFull() && msgDocModel.Msg[len(msgDocModel.Msg)-1].Msg.SendTime+(remainTime*1000) < timeutil.GetCurrentTimestampByMill() {
+	if msgDocModel.IsFull() && expired(msgDocModel.Msg[len(msgDocModel.Msg)-1].Msg) {
 		log.ZDebug(ctx, "doc is full and all msg is expired", "docID", msgDocModel.DocID)
 		delStruct.delDocIDs = append(delStruct.delDocIDs, msgDocModel.DocID)
 		delStruct.minSeq = msgDocModel.Msg[len(msgDocModel.Msg)-1].Msg.Seq
@@ -806,7 +1001,7 @@ func (db *commonMsgDatabase) deleteMsgRecursion(ctx context.Context, conversatio
 		var delMsgIndexs []int
 		for i, MsgInfoModel := range msgDocModel.Msg {
 			if MsgInfoModel != nil && MsgInfoModel.Msg != nil {
-				if timeutil.GetCurrentTimestampByMill() > MsgInfoModel.Msg.SendTime+(remainTime*1000) {
+				if expired(MsgInfoModel.Msg) {
 					delMsgIndexs = append(delMsgIndexs, i)
 				}
 			}
@@ -818,7 +1013,7 @@ func (db *commonMsgDatabase) deleteMsgRecursion(ctx context.Context, conversatio
 			delStruct.minSeq = int64(msgDocModel.Msg[delMsgIndexs[len(delMsgIndexs)-1]].Msg.Seq)
 		}
 	}
-	seq, err := db.deleteMsgRecursion(ctx, conversationID, index+1, delStruct, remainTime)
+	seq, err := db.deleteMsgRecursion(ctx, conversationID, index+1, delStruct, expired)
 	return seq, err
 }
 
@@ -0,0 +1,131 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/aetim/pkg/msgprocessor"
+	"github.com/Meikwei/go-tools/log"
+	"github.com/Meikwei/go-tools/utils/datautil"
+	"github.com/Meikwei/protocol/constant"
+	"github.com/Meikwei/protocol/sdkws"
+)
+
+// MsgDestructDatabase 消息自毁计时。
+type MsgDestructDatabase interface {
+	// CreateMsgDestructs 为设置了自毁计时的消息创建对所有人的计时，消息写入mongo后调用
+	CreateMsgDestructs(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error
+	// StartOnRead userID阅读了会话中seq在(fromSeq, toSeq]内和seqs中的消息，开始阅读后计时的消息的计时。
+	// 单聊消息对所有人的计时提前到阅读后TTL到期，群聊消息为阅读者创建自己的计时
+	StartOnRead(ctx context.Context, conversationID string, userID string, fromSeq int64, toSeq int64, seqs []int64) error
+	// ClaimMsgDestruct 领取一条到期的计时，没有到期的计时时返回mongo.ErrNoDocuments
+	ClaimMsgDestruct(ctx context.Context, lease time.Duration) (*relation.MsgDestructModel, error)
+	// DeleteMsgDestruct 删除已处理的计时，对所有人的计时连同消息的其他计时一起删除
+	DeleteMsgDestruct(ctx context.Context, destruct *relation.MsgDestructModel) error
+}
+
+func NewMsgDestructDatabase(destructDB relation.MsgDestructInterface) MsgDestructDatabase {
+	return &msgDestructDatabase{destructDB: destructDB}
+}
+
+type msgDestructDatabase struct {
+	destructDB relation.MsgDestructInterface
+}
+
+func (m *msgDestructDatabase) CreateMsgDestructs(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error {
+	var destructs []*relation.MsgDestructModel
+	for _, msg := range msgs {
+		selfDestruct, err := msgprocessor.GetSelfDestruct(msg.AttachedInfo)
+		if err != nil {
+			log.ZWarn(ctx, "invalid self destruct", err, "conversationID", conversationID, "seq", msg.Seq)
+			continue
+		}
+		if selfDestruct == nil || selfDestruct.TTL <= 0 || selfDestruct.ExpireTime <= 0 {
+			continue
+		}
+		destructs = append(destructs, &relation.MsgDestructModel{
+			ConversationID: conversationID,
+			Seq:            msg.Seq,
+			SendID:         msg.SendID,
+			SessionType:    msg.SessionType,
+			TTL:            selfDestruct.TTL,
+			StartOnRead:    selfDestruct.StartOnRead,
+			ExpireTime:     time.UnixMilli(selfDestruct.ExpireTime),
+		})
+	}
+	return m.destructDB.Create(ctx, destructs)
+}
+
+func (m *msgDestructDatabase) StartOnRead(ctx context.Context, conversationID string, userID string, fromSeq int64, toSeq int64, seqs []int64) error {
+	lower, upper := fromSeq, toSeq
+	for _, seq := range seqs {
+		lower = min(lower, seq-1)
+		upper = max(upper, seq)
+	}
+	if lower >= upper {
+		return nil
+	}
+	destructs, err := m.destructDB.FindStartOnRead(ctx, conversationID, lower, upper)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	var (
+		userDestructs []*relation.MsgDestructModel
+		advanceSeqs   = make(map[int64][]int64) // ttl -> seqs
+	)
+	for _, destruct := range destructs {
+		if destruct.SendID == userID {
+			continue
+		}
+		if !(destruct.Seq > fromSeq && destruct.Seq <= toSeq) && !datautil.Contain(destruct.Seq, seqs...) {
+			continue
+		}
+		if destruct.SessionType == constant.SingleChatType {
+			advanceSeqs[destruct.TTL] = append(advanceSeqs[destruct.TTL], destruct.Seq)
+			continue
+		}
+		userDestructs = append(userDestructs, &relation.MsgDestructModel{
+			ConversationID: conversationID,
+			Seq:            destruct.Seq,
+			UserID:         userID,
+			SendID:         destruct.SendID,
+			SessionType:    destruct.SessionType,
+			TTL:            destruct.TTL,
+			StartOnRead:    true,
+			ExpireTime:     now.Add(time.Duration(destruct.TTL) * time.Second),
+		})
+	}
+	for ttl, seqs := range advanceSeqs {
+		if err := m.destructDB.Advance(ctx, conversationID, seqs, now.Add(time.Duration(ttl)*time.Second)); err != nil {
+			return err
+		}
+	}
+	return m.destructDB.Create(ctx, userDestructs)
+}
+
+func (m *msgDestructDatabase) ClaimMsgDestruct(ctx context.Context, lease time.Duration) (*relation.MsgDestructModel, error) {
+	return m.destructDB.Claim(ctx, time.Now(), lease)
+}
+
+func (m *msgDestructDatabase) DeleteMsgDestruct(ctx context.Context, destruct *relation.MsgDestructModel) error {
+	if destruct.UserID == "" {
+		return m.destructDB.DeleteBySeqs(ctx, destruct.ConversationID, []int64{destruct.Seq})
+	}
+	return m.destructDB.Delete(ctx, destruct.ConversationID, destruct.Seq, destruct.UserID)
+}
@@ -0,0 +1,55 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+)
+
+// MsgExportDatabase 消息导出任务的存储。
+type MsgExportDatabase interface {
+	CreateExportJob(ctx context.Context, job *relation.MsgExportJobModel) error
+	TakeExportJob(ctx context.Context, jobID string) (*relation.MsgExportJobModel, error)
+	UpdateExportJob(ctx context.Context, jobID string, args map[string]any) error
+	// FailStaleExportJobs 将超过timeout没有进展的未结束任务置为失败
+	FailStaleExportJobs(ctx context.Context, timeout time.Duration, errMsg string) (int64, error)
+}
+
+type msgExportDatabase struct {
+	exportDB relation.MsgExportJobInterface
+}
+
+func NewMsgExportDatabase(exportDB relation.MsgExportJobInterface) MsgExportDatabase {
+	return &msgExportDatabase{exportDB: exportDB}
+}
+
+func (m *msgExportDatabase) CreateExportJob(ctx context.Context, job *relation.MsgExportJobModel) error {
+	return m.exportDB.Create(ctx, job)
+}
+
+func (m *msgExportDatabase) TakeExportJob(ctx context.Context, jobID string) (*relation.MsgExportJobModel, error) {
+	return m.exportDB.Take(ctx, jobID)
+}
+
+func (m *msgExportDatabase) UpdateExportJob(ctx context.Context, jobID string, args map[string]any) error {
+	return m.exportDB.Update(ctx, jobID, args)
+}
+
+func (m *msgExportDatabase) FailStaleExportJobs(ctx context.Context, timeout time.Duration, errMsg string) (int64, error) {
+	return m.exportDB.FailStale(ctx, time.Now().Add(-timeout), errMsg)
+}
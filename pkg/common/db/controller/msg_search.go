@@ -0,0 +1,93 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/aetim/pkg/msgprocessor"
+	"github.com/Meikwei/go-tools/db/pagination"
+	"github.com/Meikwei/protocol/sdkws"
+)
+
+// MsgSearchDatabase 消息全文检索索引。
+type MsgSearchDatabase interface {
+	// IndexMsgs 为可检索的消息建立索引，不可检索的消息被忽略
+	IndexMsgs(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error
+	// ReindexMsg 消息内容变更后重建索引，变更后不可检索时删除索引
+	ReindexMsg(ctx context.Context, conversationID string, msg *sdkws.MsgData) error
+	// DeleteMsgs 删除消息的索引，用于撤回和删除
+	DeleteMsgs(ctx context.Context, conversationID string, seqs []int64) error
+	// DeleteMsgsBefore 删除会话中seq小于minSeq的索引
+	DeleteMsgsBefore(ctx context.Context, conversationID string, minSeq int64) error
+	// SearchMsgs 查询包含关键词全部分词的消息，minSeqs为会话ID到可见最小seq的映射
+	SearchMsgs(ctx context.Context, minSeqs map[string]int64, keyword string, pagination pagination.Pagination) (int64, []*relation.MsgSearchIndexModel, error)
+}
+
+type msgSearchDatabase struct {
+	searchDB relation.MsgSearchIndexInterface
+}
+
+func NewMsgSearchDatabase(searchDB relation.MsgSearchIndexInterface) MsgSearchDatabase {
+	return &msgSearchDatabase{searchDB: searchDB}
+}
+
+func (m *msgSearchDatabase) IndexMsgs(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error {
+	indexes := make([]*relation.MsgSearchIndexModel, 0, len(msgs))
+	for _, msg := range msgs {
+		if index := newMsgSearchIndex(conversationID, msg); index != nil {
+			indexes = append(indexes, index)
+		}
+	}
+	return m.searchDB.Upsert(ctx, indexes)
+}
+
+func (m *msgSearchDatabase) ReindexMsg(ctx context.Context, conversationID string, msg *sdkws.MsgData) error {
+	index := newMsgSearchIndex(conversationID, msg)
+	if index == nil {
+		return m.searchDB.Delete(ctx, conversationID, []int64{msg.Seq})
+	}
+	return m.searchDB.Upsert(ctx, []*relation.MsgSearchIndexModel{index})
+}
+
+func (m *msgSearchDatabase) DeleteMsgs(ctx context.Context, conversationID string, seqs []int64) error {
+	return m.searchDB.Delete(ctx, conversationID, seqs)
+}
+
+func (m *msgSearchDatabase) DeleteMsgsBefore(ctx context.Context, conversationID string, minSeq int64) error {
+	return m.searchDB.DeleteBefore(ctx, conversationID, minSeq)
+}
+
+func (m *msgSearchDatabase) SearchMsgs(ctx context.Context, minSeqs map[string]int64, keyword string, pagination pagination.Pagination) (int64, []*relation.MsgSearchIndexModel, error) {
+	return m.searchDB.Search(ctx, minSeqs, msgprocessor.QueryTokens(keyword), pagination)
+}
+
+func newMsgSearchIndex(conversationID string, msg *sdkws.MsgData) *relation.MsgSearchIndexModel {
+	if msg == nil || msg.Seq <= 0 {
+		return nil
+	}
+	tokens := msgprocessor.IndexTokens(msgprocessor.SearchableText(msg.ContentType, msg.Content))
+	if len(tokens) == 0 {
+		return nil
+	}
+	return &relation.MsgSearchIndexModel{
+		ConversationID: conversationID,
+		Seq:            msg.Seq,
+		SendID:         msg.SendID,
+		SendTime:       msg.SendTime,
+		Tokens:         tokens,
+	}
+}
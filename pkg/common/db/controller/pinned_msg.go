@@ -0,0 +1,50 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+)
+
+// PinnedMsgDatabase 会话置顶消息的存储。
+type PinnedMsgDatabase interface {
+	// PinMsg 置顶消息，消息已置顶时返回false，置顶数已达limit时返回mongo.ErrNoDocuments
+	PinMsg(ctx context.Context, conversationID string, pin *relation.PinnedMsgModel, limit int) (bool, error)
+	// UnpinMsg 取消置顶，消息未置顶时返回mongo.ErrNoDocuments
+	UnpinMsg(ctx context.Context, conversationID string, seq int64) error
+	FindPinnedMsgs(ctx context.Context, conversationID string) ([]*relation.PinnedMsgModel, error)
+}
+
+type pinnedMsgDatabase struct {
+	pinnedMsgDB relation.PinnedMsgInterface
+}
+
+func NewPinnedMsgDatabase(pinnedMsgDB relation.PinnedMsgInterface) PinnedMsgDatabase {
+	return &pinnedMsgDatabase{pinnedMsgDB: pinnedMsgDB}
+}
+
+func (p *pinnedMsgDatabase) PinMsg(ctx context.Context, conversationID string, pin *relation.PinnedMsgModel, limit int) (bool, error) {
+	return p.pinnedMsgDB.Pin(ctx, conversationID, pin, limit)
+}
+
+func (p *pinnedMsgDatabase) UnpinMsg(ctx context.Context, conversationID string, seq int64) error {
+	return p.pinnedMsgDB.Unpin(ctx, conversationID, seq)
+}
+
+func (p *pinnedMsgDatabase) FindPinnedMsgs(ctx context.Context, conversationID string) ([]*relation.PinnedMsgModel, error) {
+	return p.pinnedMsgDB.Find(ctx, conversationID)
+}
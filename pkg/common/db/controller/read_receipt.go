@@ -0,0 +1,112 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/go-tools/utils/datautil"
+)
+
+// ReadReceiptDatabase 群消息已读回执。
+type ReadReceiptDatabase interface {
+	// MarkGroupMsgsAsRead 前进成员在群会话中的已读位置，返回之前的已读位置
+	MarkGroupMsgsAsRead(ctx context.Context, conversationID string, userID string, hasReadSeq int64) (int64, error)
+	// GetGroupMsgReaders 查询userIDs中已读到seq的成员，按已读时间排序，最多返回limit个
+	GetGroupMsgReaders(ctx context.Context, conversationID string, seq int64, userIDs []string, limit int) ([]*relation.GroupReadReceiptModel, error)
+	// GetGroupMsgUnreadUserIDs 查询userIDs中未读到seq的成员ID，最多返回limit个
+	GetGroupMsgUnreadUserIDs(ctx context.Context, conversationID string, seq int64, userIDs []string, limit int) ([]string, error)
+	// CountGroupMsgReaders 统计userIDs中已读到seq的成员数
+	CountGroupMsgReaders(ctx context.Context, conversationID string, seq int64, userIDs []string) (int64, error)
+}
+
+// readReceiptUserBatch bounds the user IDs of one query, large groups are queried in batches.
+const readReceiptUserBatch = 1000
+
+func readReceiptBatches(userIDs []string) [][]string {
+	batches := make([][]string, 0, len(userIDs)/readReceiptUserBatch+1)
+	for len(userIDs) > readReceiptUserBatch {
+		batches = append(batches, userIDs[:readReceiptUserBatch])
+		userIDs = userIDs[readReceiptUserBatch:]
+	}
+	if len(userIDs) > 0 {
+		batches = append(batches, userIDs)
+	}
+	return batches
+}
+
+type readReceiptDatabase struct {
+	receiptDB relation.GroupReadReceiptInterface
+}
+
+func NewReadReceiptDatabase(receiptDB relation.GroupReadReceiptInterface) ReadReceiptDatabase {
+	return &readReceiptDatabase{receiptDB: receiptDB}
+}
+
+func (g *readReceiptDatabase) MarkGroupMsgsAsRead(ctx context.Context, conversationID string, userID string, hasReadSeq int64) (int64, error) {
+	return g.receiptDB.MarkRead(ctx, conversationID, userID, hasReadSeq, time.Now())
+}
+
+func (g *readReceiptDatabase) GetGroupMsgReaders(ctx context.Context, conversationID string, seq int64, userIDs []string, limit int) ([]*relation.GroupReadReceiptModel, error) {
+	var readers []*relation.GroupReadReceiptModel
+	for _, batch := range readReceiptBatches(userIDs) {
+		res, err := g.receiptDB.FindReaders(ctx, conversationID, seq, batch, int64(limit))
+		if err != nil {
+			return nil, err
+		}
+		readers = append(readers, res...)
+	}
+	sort.SliceStable(readers, func(i, j int) bool { return readers[i].ReadTime.Before(readers[j].ReadTime) })
+	if len(readers) > limit {
+		readers = readers[:limit]
+	}
+	return readers, nil
+}
+
+func (g *readReceiptDatabase) GetGroupMsgUnreadUserIDs(ctx context.Context, conversationID string, seq int64, userIDs []string, limit int) ([]string, error) {
+	unreadUserIDs := make([]string, 0, limit)
+	for _, batch := range readReceiptBatches(userIDs) {
+		readerIDs, err := g.receiptDB.FindReaderIDs(ctx, conversationID, seq, batch)
+		if err != nil {
+			return nil, err
+		}
+		read := datautil.SliceSet(readerIDs)
+		for _, userID := range batch {
+			if _, ok := read[userID]; ok {
+				continue
+			}
+			if len(unreadUserIDs) == limit {
+				return unreadUserIDs, nil
+			}
+			unreadUserIDs = append(unreadUserIDs, userID)
+		}
+	}
+	return unreadUserIDs, nil
+}
+
+func (g *readReceiptDatabase) CountGroupMsgReaders(ctx context.Context, conversationID string, seq int64, userIDs []string) (int64, error) {
+	var count int64
+	for _, batch := range readReceiptBatches(userIDs) {
+		n, err := g.receiptDB.CountReaders(ctx, conversationID, seq, batch)
+		if err != nil {
+			return 0, err
+		}
+		count += n
+	}
+	return count, nil
+}
@@ -0,0 +1,144 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/Meikwei/aetim/pkg/common/config"
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/aetim/pkg/util/conversationutil"
+	"github.com/Meikwei/go-tools/utils/datautil"
+)
+
+// 生效保留策略的来源
+const (
+	RetentionSourceDefault      = "default"
+	RetentionSourceGroup        = "group"
+	RetentionSourceConversation = "conversation"
+)
+
+// EffectiveRetention 会话最终生效的保留策略及其来源
+type EffectiveRetention struct {
+	Retention *relation.RetentionModel
+	Source    string
+}
+
+// RetentionDatabase 会话、群组的消息保留策略。
+// 生效顺序为会话策略、群策略(仅群会话)、租户默认策略。
+type RetentionDatabase interface {
+	// SetConversationRetention 设置会话的保留策略，retention为nil时清除，会话不存在时返回mongo.ErrNoDocuments
+	SetConversationRetention(ctx context.Context, conversationID string, retention *relation.RetentionModel) error
+	// SetGroupRetention 设置群的保留策略，retention为nil时清除，群不存在时返回mongo.ErrNoDocuments
+	SetGroupRetention(ctx context.Context, groupID string, retention *relation.RetentionModel) error
+	// GetRetention 返回会话和所属群各自设置的策略(未设置为nil)以及最终生效的策略
+	GetRetention(ctx context.Context, conversationID string) (conversation, group *relation.RetentionModel, effective *EffectiveRetention, err error)
+	// FindEffectiveRetentions 批量解析会话最终生效的保留策略
+	FindEffectiveRetentions(ctx context.Context, conversationIDs []string) (map[string]*EffectiveRetention, error)
+}
+
+type retentionDatabase struct {
+	conversationDB   relation.ConversationModelInterface
+	groupDB          relation.GroupModelInterface
+	defaultRetention *relation.RetentionModel
+}
+
+func NewRetentionDatabase(conversationDB relation.ConversationModelInterface, groupDB relation.GroupModelInterface, defaultRetention *relation.RetentionModel) RetentionDatabase {
+	return &retentionDatabase{conversationDB: conversationDB, groupDB: groupDB, defaultRetention: defaultRetention}
+}
+
+// DefaultRetention 由共享配置生成租户默认的保留策略
+func DefaultRetention(conf *config.Share) *relation.RetentionModel {
+	switch {
+	case conf.Retention.Days > 0:
+		return &relation.RetentionModel{Mode: relation.RetentionDays, Days: int32(conf.Retention.Days)}
+	case conf.Retention.Count > 0:
+		return &relation.RetentionModel{Mode: relation.RetentionCount, Count: conf.Retention.Count}
+	default:
+		return &relation.RetentionModel{Mode: relation.RetentionForever}
+	}
+}
+
+func (r *retentionDatabase) SetConversationRetention(ctx context.Context, conversationID string, retention *relation.RetentionModel) error {
+	return r.conversationDB.SetRetention(ctx, conversationID, retention)
+}
+
+func (r *retentionDatabase) SetGroupRetention(ctx context.Context, groupID string, retention *relation.RetentionModel) error {
+	return r.groupDB.SetRetention(ctx, groupID, retention)
+}
+
+func (r *retentionDatabase) GetRetention(ctx context.Context, conversationID string) (*relation.RetentionModel, *relation.RetentionModel, *EffectiveRetention, error) {
+	conversations, groups, err := r.findRetentions(ctx, []string{conversationID})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	conversation := conversations[conversationID]
+	var group *relation.RetentionModel
+	if groupID, ok := conversationutil.GetGroupIDByConversationID(conversationID); ok {
+		group = groups[groupID]
+	}
+	return conversation, group, r.resolve(conversation, group), nil
+}
+
+func (r *retentionDatabase) FindEffectiveRetentions(ctx context.Context, conversationIDs []string) (map[string]*EffectiveRetention, error) {
+	conversations, groups, err := r.findRetentions(ctx, conversationIDs)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]*EffectiveRetention, len(conversationIDs))
+	for _, conversationID := range conversationIDs {
+		var group *relation.RetentionModel
+		if groupID, ok := conversationutil.GetGroupIDByConversationID(conversationID); ok {
+			group = groups[groupID]
+		}
+		res[conversationID] = r.resolve(conversations[conversationID], group)
+	}
+	return res, nil
+}
+
+// findRetentions 返回会话和群组上设置的策略，分别以conversationID和groupID为key
+func (r *retentionDatabase) findRetentions(ctx context.Context, conversationIDs []string) (map[string]*relation.RetentionModel, map[string]*relation.RetentionModel, error) {
+	conversationModels, err := r.conversationDB.FindRetention(ctx, conversationIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	conversations := make(map[string]*relation.RetentionModel, len(conversationModels))
+	for _, conversation := range conversationModels {
+		conversations[conversation.ConversationID] = conversation.Retention
+	}
+	groupIDs := datautil.Filter(conversationIDs, conversationutil.GetGroupIDByConversationID)
+	groups := make(map[string]*relation.RetentionModel)
+	if len(groupIDs) == 0 {
+		return conversations, groups, nil
+	}
+	groupModels, err := r.groupDB.FindRetention(ctx, groupIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, group := range groupModels {
+		groups[group.GroupID] = group.Retention
+	}
+	return conversations, groups, nil
+}
+
+func (r *retentionDatabase) resolve(conversation, group *relation.RetentionModel) *EffectiveRetention {
+	if conversation != nil && conversation.Mode != relation.RetentionInherit {
+		return &EffectiveRetention{Retention: conversation, Source: RetentionSourceConversation}
+	}
+	if group != nil && group.Mode != relation.RetentionInherit {
+		return &EffectiveRetention{Retention: group, Source: RetentionSourceGroup}
+	}
+	return &EffectiveRetention{Retention: r.defaultRetention, Source: RetentionSourceDefault}
+}
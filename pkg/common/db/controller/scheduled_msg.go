@@ -0,0 +1,80 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/go-tools/db/pagination"
+)
+
+// ScheduledMsgDatabase 定时消息的存储，状态变更方法在前置条件不满足时返回mongo.ErrNoDocuments。
+type ScheduledMsgDatabase interface {
+	CreateScheduledMsg(ctx context.Context, msg *relation.ScheduledMsgModel) error
+	TakeScheduledMsg(ctx context.Context, scheduleID string) (*relation.ScheduledMsgModel, error)
+	FindScheduledMsgs(ctx context.Context, sendID string, status []int32, pagination pagination.Pagination) (int64, []*relation.ScheduledMsgModel, error)
+	CancelScheduledMsg(ctx context.Context, scheduleID string) error
+	RescheduleMsg(ctx context.Context, scheduleID string, sendTime time.Time) error
+	ClaimScheduledMsg(ctx context.Context, now time.Time, owner string, lease time.Duration) (*relation.ScheduledMsgModel, error)
+	MarkScheduledMsgSent(ctx context.Context, scheduleID string, owner string, now time.Time) error
+	ReleaseScheduledMsg(ctx context.Context, scheduleID string, owner string, errMsg string) error
+	FinishScheduledMsg(ctx context.Context, scheduleID string, owner string, status int32, errMsg string) error
+}
+
+type scheduledMsgDatabase struct {
+	scheduledMsgDB relation.ScheduledMsgInterface
+}
+
+func NewScheduledMsgDatabase(scheduledMsgDB relation.ScheduledMsgInterface) ScheduledMsgDatabase {
+	return &scheduledMsgDatabase{scheduledMsgDB: scheduledMsgDB}
+}
+
+func (s *scheduledMsgDatabase) CreateScheduledMsg(ctx context.Context, msg *relation.ScheduledMsgModel) error {
+	return s.scheduledMsgDB.Create(ctx, msg)
+}
+
+func (s *scheduledMsgDatabase) TakeScheduledMsg(ctx context.Context, scheduleID string) (*relation.ScheduledMsgModel, error) {
+	return s.scheduledMsgDB.Take(ctx, scheduleID)
+}
+
+func (s *scheduledMsgDatabase) FindScheduledMsgs(ctx context.Context, sendID string, status []int32, pagination pagination.Pagination) (int64, []*relation.ScheduledMsgModel, error) {
+	return s.scheduledMsgDB.FindBySendID(ctx, sendID, status, pagination)
+}
+
+func (s *scheduledMsgDatabase) CancelScheduledMsg(ctx context.Context, scheduleID string) error {
+	return s.scheduledMsgDB.Cancel(ctx, scheduleID)
+}
+
+func (s *scheduledMsgDatabase) RescheduleMsg(ctx context.Context, scheduleID string, sendTime time.Time) error {
+	return s.scheduledMsgDB.Reschedule(ctx, scheduleID, sendTime)
+}
+
+func (s *scheduledMsgDatabase) ClaimScheduledMsg(ctx context.Context, now time.Time, owner string, lease time.Duration) (*relation.ScheduledMsgModel, error) {
+	return s.scheduledMsgDB.Claim(ctx, now, owner, lease)
+}
+
+func (s *scheduledMsgDatabase) MarkScheduledMsgSent(ctx context.Context, scheduleID string, owner string, now time.Time) error {
+	return s.scheduledMsgDB.MarkSent(ctx, scheduleID, owner, now)
+}
+
+func (s *scheduledMsgDatabase) ReleaseScheduledMsg(ctx context.Context, scheduleID string, owner string, errMsg string) error {
+	return s.scheduledMsgDB.Release(ctx, scheduleID, owner, errMsg)
+}
+
+func (s *scheduledMsgDatabase) FinishScheduledMsg(ctx context.Context, scheduleID string, owner string, status int32, errMsg string) error {
+	return s.scheduledMsgDB.Finish(ctx, scheduleID, owner, status, errMsg)
+}
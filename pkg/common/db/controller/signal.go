@@ -0,0 +1,90 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/go-tools/db/pagination"
+)
+
+// SignalDatabase 音视频通话信令的存储，状态变更方法在前置条件不满足时返回mongo.ErrNoDocuments。
+type SignalDatabase interface {
+	CreateSignal(ctx context.Context, signal *relation.SignalModel) error
+	TakeSignal(ctx context.Context, roomID string) (*relation.SignalModel, error)
+	FindSignals(ctx context.Context, roomIDs []string) ([]*relation.SignalModel, error)
+	TakeActiveSignalByGroupID(ctx context.Context, groupID string) (*relation.SignalModel, error)
+	FindRingingSignals(ctx context.Context, userID string) ([]*relation.SignalModel, error)
+	FindBusyUserIDs(ctx context.Context, userIDs []string) ([]string, error)
+	UpdateSignalMember(ctx context.Context, roomID string, userID string, from []int32, status int32, roomFrom []int32, roomStatus int32) (*relation.SignalModel, error)
+	FinishSignal(ctx context.Context, roomID string, from []int32, maxActive int, status int32, ringingStatus int32) (*relation.SignalModel, error)
+	TimeoutSignal(ctx context.Context, deadline time.Time) (*relation.SignalModel, error)
+	SearchSignals(ctx context.Context, sessionType int32, sendID string, recvID string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*relation.SignalModel, error)
+	DeleteSignals(ctx context.Context, roomIDs []string) error
+}
+
+type signalDatabase struct {
+	signalDB relation.SignalInterface
+}
+
+func NewSignalDatabase(signalDB relation.SignalInterface) SignalDatabase {
+	return &signalDatabase{signalDB: signalDB}
+}
+
+func (s *signalDatabase) CreateSignal(ctx context.Context, signal *relation.SignalModel) error {
+	return s.signalDB.Create(ctx, signal)
+}
+
+func (s *signalDatabase) TakeSignal(ctx context.Context, roomID string) (*relation.SignalModel, error) {
+	return s.signalDB.Take(ctx, roomID)
+}
+
+func (s *signalDatabase) FindSignals(ctx context.Context, roomIDs []string) ([]*relation.SignalModel, error) {
+	return s.signalDB.Find(ctx, roomIDs)
+}
+
+func (s *signalDatabase) TakeActiveSignalByGroupID(ctx context.Context, groupID string) (*relation.SignalModel, error) {
+	return s.signalDB.TakeActiveByGroupID(ctx, groupID)
+}
+
+func (s *signalDatabase) FindRingingSignals(ctx context.Context, userID string) ([]*relation.SignalModel, error) {
+	return s.signalDB.FindRinging(ctx, userID)
+}
+
+func (s *signalDatabase) FindBusyUserIDs(ctx context.Context, userIDs []string) ([]string, error) {
+	return s.signalDB.FindBusyUserIDs(ctx, userIDs)
+}
+
+func (s *signalDatabase) UpdateSignalMember(ctx context.Context, roomID string, userID string, from []int32, status int32, roomFrom []int32, roomStatus int32) (*relation.SignalModel, error) {
+	return s.signalDB.UpdateMember(ctx, roomID, userID, from, status, roomFrom, roomStatus)
+}
+
+func (s *signalDatabase) FinishSignal(ctx context.Context, roomID string, from []int32, maxActive int, status int32, ringingStatus int32) (*relation.SignalModel, error) {
+	return s.signalDB.Finish(ctx, roomID, from, maxActive, status, ringingStatus)
+}
+
+func (s *signalDatabase) TimeoutSignal(ctx context.Context, deadline time.Time) (*relation.SignalModel, error) {
+	return s.signalDB.TimeoutOne(ctx, deadline)
+}
+
+func (s *signalDatabase) SearchSignals(ctx context.Context, sessionType int32, sendID string, recvID string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*relation.SignalModel, error) {
+	return s.signalDB.Search(ctx, sessionType, sendID, recvID, start, end, pagination)
+}
+
+func (s *signalDatabase) DeleteSignals(ctx context.Context, roomIDs []string) error {
+	return s.signalDB.Delete(ctx, roomIDs)
+}
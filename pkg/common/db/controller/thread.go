@@ -0,0 +1,66 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/go-tools/db/pagination"
+)
+
+// ThreadDatabase 回复线程及其参与者的存储。线程消息和seq由CommonMsgDatabase以ThreadID作为会话ID保存。
+type ThreadDatabase interface {
+	CreateThread(ctx context.Context, thread *relation.ThreadModel) error
+	TakeThread(ctx context.Context, threadID string) (*relation.ThreadModel, error)
+	FindThreads(ctx context.Context, threadIDs []string) ([]*relation.ThreadModel, error)
+	IncrThreadReply(ctx context.Context, threadID string, seq int64, userID string, replyTime time.Time) (*relation.ThreadModel, error)
+	SubscribeThread(ctx context.Context, threadID string, userID string, subscribed bool) error
+	FindSubscribedThreads(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*relation.ThreadMemberModel, error)
+}
+
+type threadDatabase struct {
+	threadDB       relation.ThreadInterface
+	threadMemberDB relation.ThreadMemberInterface
+}
+
+func NewThreadDatabase(threadDB relation.ThreadInterface, threadMemberDB relation.ThreadMemberInterface) ThreadDatabase {
+	return &threadDatabase{threadDB: threadDB, threadMemberDB: threadMemberDB}
+}
+
+func (t *threadDatabase) CreateThread(ctx context.Context, thread *relation.ThreadModel) error {
+	return t.threadDB.Create(ctx, thread)
+}
+
+func (t *threadDatabase) TakeThread(ctx context.Context, threadID string) (*relation.ThreadModel, error) {
+	return t.threadDB.Take(ctx, threadID)
+}
+
+func (t *threadDatabase) FindThreads(ctx context.Context, threadIDs []string) ([]*relation.ThreadModel, error) {
+	return t.threadDB.Find(ctx, threadIDs)
+}
+
+func (t *threadDatabase) IncrThreadReply(ctx context.Context, threadID string, seq int64, userID string, replyTime time.Time) (*relation.ThreadModel, error) {
+	return t.threadDB.IncrReply(ctx, threadID, seq, userID, replyTime)
+}
+
+func (t *threadDatabase) SubscribeThread(ctx context.Context, threadID string, userID string, subscribed bool) error {
+	return t.threadMemberDB.Subscribe(ctx, threadID, userID, subscribed, time.Now())
+}
+
+func (t *threadDatabase) FindSubscribedThreads(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*relation.ThreadMemberModel, error) {
+	return t.threadMemberDB.FindSubscribed(ctx, userID, pagination)
+}
@@ -0,0 +1,91 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/Meikwei/aetim/pkg/common/db/cache"
+	"github.com/Meikwei/protocol/sdkws"
+)
+
+// UnreadCountDatabase 用户的未读消息数，即各会话maxSeq与hasReadSeq之差，总数不包括免打扰的会话。
+// 计数不存在时更新被忽略，GetUnreadCount返回redis.Nil，由调用方重新计算后InitUnreadCount。
+type UnreadCountDatabase interface {
+	// AddUnreadMsgs 会话中新存储了msgs（按seq升序）后更新会话成员的未读数。
+	// 发送者的已读位置已被置为其发送的最后一条消息，未读数为之后其他人发送的消息数
+	AddUnreadMsgs(ctx context.Context, conversationID string, userIDs []string, msgs []*sdkws.MsgData) error
+	// SetUnreadCount 用户已读位置变化后设置会话的未读数
+	SetUnreadCount(ctx context.Context, userID string, conversationID string, count int64) error
+	InitUnreadCount(ctx context.Context, userID string, counts map[string]int64, mutedConversationIDs []string) error
+	// GetUnreadCount 返回未读总数和每个会话（包括免打扰的会话）的未读数
+	GetUnreadCount(ctx context.Context, userID string) (int64, map[string]int64, error)
+	// GetUnreadTotals 返回计数存在的用户的未读总数
+	GetUnreadTotals(ctx context.Context, userIDs []string) (map[string]int64, error)
+	// DelUnreadCounts 删除用户的计数，用于会话的接收选项变化后重新计算
+	DelUnreadCounts(ctx context.Context, userIDs []string) error
+}
+
+type unreadCountDatabase struct {
+	cache cache.UnreadCountCache
+}
+
+func NewUnreadCountDatabase(cache cache.UnreadCountCache) UnreadCountDatabase {
+	return &unreadCountDatabase{cache: cache}
+}
+
+func (u *unreadCountDatabase) AddUnreadMsgs(ctx context.Context, conversationID string, userIDs []string, msgs []*sdkws.MsgData) error {
+	incr, set := unreadMsgCounts(userIDs, msgs)
+	if err := u.cache.IncrUnreadCounts(ctx, conversationID, incr); err != nil {
+		return err
+	}
+	return u.cache.SetUnreadCounts(ctx, conversationID, set)
+}
+
+// unreadMsgCounts returns the increments of the users who sent none of msgs, and the unread counts of the senders.
+func unreadMsgCounts(userIDs []string, msgs []*sdkws.MsgData) (incr map[string]int64, set map[string]int64) {
+	// 每个发送者最后一条消息之后的消息数
+	set = make(map[string]int64)
+	for i, msg := range msgs {
+		set[msg.SendID] = int64(len(msgs) - i - 1)
+	}
+	incr = make(map[string]int64, len(userIDs))
+	for _, userID := range userIDs {
+		if _, ok := set[userID]; !ok {
+			incr[userID] = int64(len(msgs))
+		}
+	}
+	return incr, set
+}
+
+func (u *unreadCountDatabase) SetUnreadCount(ctx context.Context, userID string, conversationID string, count int64) error {
+	return u.cache.SetUnreadCounts(ctx, conversationID, map[string]int64{userID: max(count, 0)})
+}
+
+func (u *unreadCountDatabase) InitUnreadCount(ctx context.Context, userID string, counts map[string]int64, mutedConversationIDs []string) error {
+	return u.cache.InitUnreadCount(ctx, userID, counts, mutedConversationIDs)
+}
+
+func (u *unreadCountDatabase) GetUnreadCount(ctx context.Context, userID string) (int64, map[string]int64, error) {
+	return u.cache.GetUnreadCount(ctx, userID)
+}
+
+func (u *unreadCountDatabase) GetUnreadTotals(ctx context.Context, userIDs []string) (map[string]int64, error) {
+	return u.cache.GetUnreadTotals(ctx, userIDs)
+}
+
+func (u *unreadCountDatabase) DelUnreadCounts(ctx context.Context, userIDs []string) error {
+	return u.cache.DelUnreadCounts(ctx, userIDs)
+}
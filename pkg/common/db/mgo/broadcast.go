@@ -0,0 +1,173 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/go-tools/db/mongoutil"
+	"github.com/Meikwei/go-tools/db/pagination"
+	"github.com/Meikwei/go-tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewBroadcastMongo(db *mongo.Database) (relation.BroadcastInterface, error) {
+	jobColl := db.Collection("broadcast_job")
+	_, err := jobColl.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "job_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	failureColl := db.Collection("broadcast_failure")
+	_, err = failureColl.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "job_id", Value: 1},
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	sentColl := db.Collection("broadcast_sent")
+	_, err = sentColl.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "job_id", Value: 1},
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &BroadcastMgo{jobColl: jobColl, failureColl: failureColl, sentColl: sentColl}, nil
+}
+
+type BroadcastMgo struct {
+	jobColl     *mongo.Collection
+	failureColl *mongo.Collection
+	sentColl    *mongo.Collection
+}
+
+func (b *BroadcastMgo) Create(ctx context.Context, job *relation.BroadcastJobModel) error {
+	return mongoutil.InsertMany(ctx, b.jobColl, []*relation.BroadcastJobModel{job})
+}
+
+func (b *BroadcastMgo) Take(ctx context.Context, jobID string) (*relation.BroadcastJobModel, error) {
+	return mongoutil.FindOne[*relation.BroadcastJobModel](ctx, b.jobColl, bson.M{"job_id": jobID})
+}
+
+func (b *BroadcastMgo) Find(ctx context.Context, status []int32, pagination pagination.Pagination) (int64, []*relation.BroadcastJobModel, error) {
+	filter := bson.M{}
+	if len(status) > 0 {
+		filter["status"] = bson.M{"$in": status}
+	}
+	return mongoutil.FindPage[*relation.BroadcastJobModel](ctx, b.jobColl, filter, pagination, options.Find().SetSort(bson.M{"create_time": -1}))
+}
+
+// updateStatus moves a job in one of the from states to status.
+func (b *BroadcastMgo) updateStatus(ctx context.Context, jobID string, from []int32, set bson.M) error {
+	filter := bson.M{"job_id": jobID, "status": bson.M{"$in": from}}
+	set["update_time"] = time.Now()
+	return mongoutil.UpdateOne(ctx, b.jobColl, filter, bson.M{"$set": set}, true)
+}
+
+func (b *BroadcastMgo) Pause(ctx context.Context, jobID string) error {
+	from := []int32{relation.BroadcastPending, relation.BroadcastRunning}
+	return b.updateStatus(ctx, jobID, from, bson.M{"status": relation.BroadcastPaused, "owner": ""})
+}
+
+func (b *BroadcastMgo) Resume(ctx context.Context, jobID string) error {
+	return b.updateStatus(ctx, jobID, []int32{relation.BroadcastPaused}, bson.M{"status": relation.BroadcastPending})
+}
+
+func (b *BroadcastMgo) Cancel(ctx context.Context, jobID string) error {
+	from := []int32{relation.BroadcastPending, relation.BroadcastRunning, relation.BroadcastPaused}
+	return b.updateStatus(ctx, jobID, from, bson.M{"status": relation.BroadcastCanceled, "owner": "", "finish_time": time.Now()})
+}
+
+func (b *BroadcastMgo) Claim(ctx context.Context, now time.Time, owner string, lease time.Duration) (*relation.BroadcastJobModel, error) {
+	filter := bson.M{"$or": bson.A{
+		bson.M{"status": relation.BroadcastPending},
+		bson.M{"status": relation.BroadcastRunning, "lease_until": bson.M{"$lte": now}},
+	}}
+	update := bson.M{"$set": bson.M{"status": relation.BroadcastRunning, "owner": owner, "lease_until": now.Add(lease), "update_time": now}}
+	opts := options.FindOneAndUpdate().SetSort(bson.M{"create_time": 1}).SetReturnDocument(options.After)
+	return mongoutil.FindOneAndUpdate[*relation.BroadcastJobModel](ctx, b.jobColl, filter, update, opts)
+}
+
+func (b *BroadcastMgo) Progress(ctx context.Context, jobID string, owner string, cursor string, sent int64, failed int64, leaseUntil time.Time) error {
+	filter := bson.M{"job_id": jobID, "owner": owner, "status": relation.BroadcastRunning}
+	update := bson.M{
+		"$set": bson.M{"cursor": cursor, "lease_until": leaseUntil, "update_time": time.Now()},
+		"$inc": bson.M{"sent_count": sent, "failed_count": failed},
+	}
+	return mongoutil.UpdateOne(ctx, b.jobColl, filter, update, true)
+}
+
+func (b *BroadcastMgo) Finish(ctx context.Context, jobID string, owner string, status int32, errMsg string) error {
+	filter := bson.M{"job_id": jobID, "owner": owner, "status": relation.BroadcastRunning}
+	now := time.Now()
+	set := bson.M{"status": status, "owner": "", "error": errMsg, "update_time": now, "finish_time": now}
+	return mongoutil.UpdateOne(ctx, b.jobColl, filter, bson.M{"$set": set}, true)
+}
+
+func (b *BroadcastMgo) AddFailures(ctx context.Context, failures []*relation.BroadcastFailureModel) error {
+	if len(failures) == 0 {
+		return nil
+	}
+	models := make([]mongo.WriteModel, 0, len(failures))
+	for _, failure := range failures {
+		filter := bson.M{"job_id": failure.JobID, "user_id": failure.UserID}
+		models = append(models, mongo.NewReplaceOneModel().SetFilter(filter).SetReplacement(failure).SetUpsert(true))
+	}
+	_, err := b.failureColl.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	return errs.Wrap(err)
+}
+
+func (b *BroadcastMgo) FindFailures(ctx context.Context, jobID string, pagination pagination.Pagination) (int64, []*relation.BroadcastFailureModel, error) {
+	return mongoutil.FindPage[*relation.BroadcastFailureModel](ctx, b.failureColl, bson.M{"job_id": jobID}, pagination, options.Find().SetSort(bson.M{"user_id": 1}))
+}
+
+func (b *BroadcastMgo) MarkSent(ctx context.Context, jobID string, userID string) (bool, error) {
+	_, err := b.sentColl.InsertOne(ctx, &relation.BroadcastSentModel{JobID: jobID, UserID: userID, CreateTime: time.Now()})
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, errs.Wrap(err)
+	}
+	return true, nil
+}
+
+func (b *BroadcastMgo) DeleteSent(ctx context.Context, jobID string) error {
+	return mongoutil.DeleteMany(ctx, b.sentColl, bson.M{"job_id": jobID})
+}
@@ -168,3 +168,27 @@ func (c *ConversationMgo) GetConversationNotReceiveMessageUserIDs(ctx context.Co
 		options.Find().SetProjection(bson.M{"_id": 0, "owner_user_id": 1}),
 	)
 }
+
+func (c *ConversationMgo) SetRetention(ctx context.Context, conversationID string, retention *relation.RetentionModel) error {
+	update := bson.M{"$unset": bson.M{"retention": ""}}
+	if retention != nil {
+		update = bson.M{"$set": bson.M{"retention": retention}}
+	}
+	res, err := mongoutil.UpdateMany(ctx, c.coll, bson.M{"conversation_id": conversationID}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errs.Wrap(mongo.ErrNoDocuments)
+	}
+	return nil
+}
+
+func (c *ConversationMgo) FindRetention(ctx context.Context, conversationIDs []string) ([]*relation.ConversationModel, error) {
+	return mongoutil.Find[*relation.ConversationModel](
+		ctx,
+		c.coll,
+		bson.M{"conversation_id": bson.M{"$in": conversationIDs}, "retention": bson.M{"$exists": true}},
+		options.Find().SetProjection(bson.M{"_id": 0, "conversation_id": 1, "retention": 1}),
+	)
+}
@@ -125,3 +125,20 @@ func (g *GroupMgo) CountRangeEverydayTotal(ctx context.Context, start time.Time,
 	}
 	return res, nil
 }
+
+func (g *GroupMgo) SetRetention(ctx context.Context, groupID string, retention *relation.RetentionModel) error {
+	update := bson.M{"$unset": bson.M{"retention": ""}}
+	if retention != nil {
+		update = bson.M{"$set": bson.M{"retention": retention}}
+	}
+	return mongoutil.UpdateOne(ctx, g.coll, bson.M{"group_id": groupID}, update, true)
+}
+
+func (g *GroupMgo) FindRetention(ctx context.Context, groupIDs []string) ([]*relation.GroupModel, error) {
+	return mongoutil.Find[*relation.GroupModel](
+		ctx,
+		g.coll,
+		bson.M{"group_id": bson.M{"$in": groupIDs}, "retention": bson.M{"$exists": true}},
+		options.Find().SetProjection(bson.M{"_id": 0, "group_id": 1, "retention": 1}),
+	)
+}
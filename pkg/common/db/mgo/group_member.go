@@ -100,6 +100,41 @@ func (g *GroupMemberMgo) TakeGroupMemberNum(ctx context.Context, groupID string)
 	return mongoutil.Count(ctx, g.coll, bson.M{"group_id": groupID})
 }
 
+func (g *GroupMemberMgo) FindUserIDsAfter(ctx context.Context, groupIDs []string, afterUserID string, limit int64) ([]string, error) {
+	filter := bson.M{"group_id": bson.M{"$in": groupIDs}, "user_id": bson.M{"$gt": afterUserID}}
+	opts := options.Find().SetSort(bson.M{"user_id": 1}).SetLimit(limit).SetProjection(bson.M{"_id": 0, "user_id": 1})
+	userIDs, err := mongoutil.Find[string](ctx, g.coll, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	// sorted by user_id, so a user in several of the groups appears in adjacent records
+	res := userIDs[:0]
+	for _, userID := range userIDs {
+		if len(res) == 0 || userID != res[len(res)-1] {
+			res = append(res, userID)
+		}
+	}
+	return res, nil
+}
+
+func (g *GroupMemberMgo) CountDistinctUserID(ctx context.Context, groupIDs []string) (int64, error) {
+	pipeline := bson.A{
+		bson.M{"$match": bson.M{"group_id": bson.M{"$in": groupIDs}}},
+		bson.M{"$group": bson.M{"_id": "$user_id"}},
+		bson.M{"$count": "count"},
+	}
+	res, err := mongoutil.Aggregate[struct {
+		Count int64 `bson:"count"`
+	}](ctx, g.coll, pipeline)
+	if err != nil {
+		return 0, err
+	}
+	if len(res) == 0 {
+		return 0, nil
+	}
+	return res[0].Count, nil
+}
+
 func (g *GroupMemberMgo) FindUserManagedGroupID(ctx context.Context, userID string) (groupIDs []string, err error) {
 	filter := bson.M{
 		"user_id": userID,
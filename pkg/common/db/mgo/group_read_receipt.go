@@ -0,0 +1,98 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/go-tools/db/mongoutil"
+	"github.com/Meikwei/go-tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewGroupReadReceiptMongo(db *mongo.Database) (relation.GroupReadReceiptInterface, error) {
+	coll := db.Collection("group_read_receipt")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "conversation_id", Value: 1},
+				{Key: "user_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "conversation_id", Value: 1},
+				{Key: "has_read_seq", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &GroupReadReceiptMgo{coll: coll}, nil
+}
+
+type GroupReadReceiptMgo struct {
+	coll *mongo.Collection
+}
+
+func (g *GroupReadReceiptMgo) MarkRead(ctx context.Context, conversationID string, userID string, hasReadSeq int64, readTime time.Time) (int64, error) {
+	prevSeq := bson.M{"$ifNull": bson.A{"$has_read_seq", int64(0)}}
+	isForward := bson.M{"$gt": bson.A{hasReadSeq, prevSeq}}
+	update := bson.A{
+		bson.M{"$set": bson.M{
+			"has_read_seq": bson.M{"$max": bson.A{prevSeq, hasReadSeq}},
+			"read_time":    bson.M{"$cond": bson.A{isForward, readTime, "$read_time"}},
+		}},
+	}
+	filter := bson.M{"conversation_id": conversationID, "user_id": userID}
+	opt := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.Before)
+	prev, err := mongoutil.FindOneAndUpdate[*relation.GroupReadReceiptModel](ctx, g.coll, filter, update, opt)
+	if err != nil && mongo.IsDuplicateKeyError(err) {
+		// 并发首次写入时另一方已插入，重试即转为更新
+		prev, err = mongoutil.FindOneAndUpdate[*relation.GroupReadReceiptModel](ctx, g.coll, filter, update, opt)
+	}
+	if err != nil {
+		if errs.Unwrap(err) == mongo.ErrNoDocuments {
+			// 首次记录时没有之前的文档
+			return 0, nil
+		}
+		return 0, err
+	}
+	return prev.HasReadSeq, nil
+}
+
+func (g *GroupReadReceiptMgo) readersFilter(conversationID string, seq int64, userIDs []string) bson.M {
+	return bson.M{"conversation_id": conversationID, "user_id": bson.M{"$in": userIDs}, "has_read_seq": bson.M{"$gte": seq}}
+}
+
+func (g *GroupReadReceiptMgo) FindReaders(ctx context.Context, conversationID string, seq int64, userIDs []string, limit int64) ([]*relation.GroupReadReceiptModel, error) {
+	opts := options.Find().SetSort(bson.M{"read_time": 1}).SetLimit(limit)
+	return mongoutil.Find[*relation.GroupReadReceiptModel](ctx, g.coll, g.readersFilter(conversationID, seq, userIDs), opts)
+}
+
+func (g *GroupReadReceiptMgo) FindReaderIDs(ctx context.Context, conversationID string, seq int64, userIDs []string) ([]string, error) {
+	opts := options.Find().SetProjection(bson.M{"_id": 0, "user_id": 1})
+	return mongoutil.Find[string](ctx, g.coll, g.readersFilter(conversationID, seq, userIDs), opts)
+}
+
+func (g *GroupReadReceiptMgo) CountReaders(ctx context.Context, conversationID string, seq int64, userIDs []string) (int64, error) {
+	return mongoutil.Count(ctx, g.coll, g.readersFilter(conversationID, seq, userIDs))
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Meikwei/aetim/pkg/common/convert"
 	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/aetim/pkg/msgprocessor"
 	"github.com/Meikwei/go-tools/db/mongoutil"
 	"github.com/Meikwei/go-tools/errs"
 	"github.com/Meikwei/go-tools/log"
@@ -76,6 +78,47 @@ func (m *MsgMgo) PushUnique(ctx context.Context, docID string, index int64, key
 	return mongoutil.UpdateOneResult(ctx, m.coll, filter, update)
 }
 
+func (m *MsgMgo) PullField(ctx context.Context, docID string, index int64, key string, value any) (*mongo.UpdateResult, error) {
+	filter := bson.M{"doc_id": docID}
+	update := bson.M{
+		"$pull": bson.M{
+			fmt.Sprintf("msgs.%d.%s", index, key): value,
+		},
+	}
+	return mongoutil.UpdateOneResult(ctx, m.coll, filter, update)
+}
+
+func (m *MsgMgo) GetReactions(ctx context.Context, docID string, index int64) ([]*relation.ReactionModel, error) {
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.D{
+			{Key: "doc_id", Value: docID},
+		}}},
+		bson.D{{Key: "$project", Value: bson.D{
+			{Key: "_id", Value: 0},
+			{Key: "reactions", Value: bson.D{
+				{Key: "$let", Value: bson.D{
+					{Key: "vars", Value: bson.D{
+						{Key: "currentMsg", Value: bson.D{
+							{Key: "$arrayElemAt", Value: bson.A{"$msgs", index}},
+						}},
+					}},
+					{Key: "in", Value: "$$currentMsg.reactions"},
+				}},
+			}},
+		}}},
+	}
+	res, err := mongoutil.Aggregate[*struct {
+		Reactions []*relation.ReactionModel `bson:"reactions"`
+	}](ctx, m.coll, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, nil
+	}
+	return res[0].Reactions, nil
+}
+
 func (m *MsgMgo) UpdateMsgContent(ctx context.Context, docID string, index int64, msg []byte) error {
 	filter := bson.M{"doc_id": docID}
 	update := bson.M{"$set": bson.M{fmt.Sprintf("msgs.%d.msg", index): msg}}
@@ -171,6 +214,14 @@ func (m *MsgMgo) GetMsgBySeqIndexIn1Doc(ctx context.Context, userID, docID strin
 			}
 			msg.Msg.ContentType = constant.MsgRevokeNotification
 			msg.Msg.Content = string(content)
+		} else if len(msg.Edits) > 0 {
+			msg.Msg.Content = msg.Edits[len(msg.Edits)-1].Content
+		}
+		if len(msg.Reactions) > 0 {
+			msg.Msg.AttachedInfo = msgprocessor.SetReactionSummary(msg.Msg.AttachedInfo, relation.ReactionEmojis(msg.Reactions))
+		}
+		if msg.Thread != nil {
+			msg.Msg.AttachedInfo = msgprocessor.SetThreadSummary(msg.Msg.AttachedInfo, convert.MsgThreadDB2Summary(msg.Thread))
 		}
 		msgs = append(msgs, msg)
 	}
@@ -347,6 +398,14 @@ func (m *MsgMgo) SearchMessage(ctx context.Context, req *msg.SearchMessageReq) (
 			}
 			msgInfo.Msg.ContentType = constant.MsgRevokeNotification
 			msgInfo.Msg.Content = string(content)
+		} else if len(msgInfo.Edits) > 0 {
+			msgInfo.Msg.Content = msgInfo.Edits[len(msgInfo.Edits)-1].Content
+		}
+		if len(msgInfo.Reactions) > 0 {
+			msgInfo.Msg.AttachedInfo = msgprocessor.SetReactionSummary(msgInfo.Msg.AttachedInfo, relation.ReactionEmojis(msgInfo.Reactions))
+		}
+		if msgInfo.Thread != nil {
+			msgInfo.Msg.AttachedInfo = msgprocessor.SetThreadSummary(msgInfo.Msg.AttachedInfo, convert.MsgThreadDB2Summary(msgInfo.Thread))
 		}
 		msgs = append(msgs, msgInfo)
 	}
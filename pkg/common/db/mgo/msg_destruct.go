@@ -0,0 +1,109 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/go-tools/db/mongoutil"
+	"github.com/Meikwei/go-tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewMsgDestructMongo(db *mongo.Database) (relation.MsgDestructInterface, error) {
+	coll := db.Collection("msg_destruct")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "conversation_id", Value: 1},
+				{Key: "seq", Value: 1},
+				{Key: "user_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "expire_time", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &MsgDestructMgo{coll: coll}, nil
+}
+
+type MsgDestructMgo struct {
+	coll *mongo.Collection
+}
+
+func (m *MsgDestructMgo) Create(ctx context.Context, destructs []*relation.MsgDestructModel) error {
+	if len(destructs) == 0 {
+		return nil
+	}
+	models := make([]mongo.WriteModel, 0, len(destructs))
+	for _, destruct := range destructs {
+		filter := bson.M{"conversation_id": destruct.ConversationID, "seq": destruct.Seq, "user_id": destruct.UserID}
+		models = append(models, mongo.NewUpdateOneModel().SetFilter(filter).SetUpdate(bson.M{"$setOnInsert": destruct}).SetUpsert(true))
+	}
+	_, err := m.coll.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+func (m *MsgDestructMgo) FindStartOnRead(ctx context.Context, conversationID string, fromSeq int64, toSeq int64) ([]*relation.MsgDestructModel, error) {
+	filter := bson.M{
+		"conversation_id": conversationID,
+		"seq":             bson.M{"$gt": fromSeq, "$lte": toSeq},
+		"user_id":         "",
+		"start_on_read":   true,
+	}
+	return mongoutil.Find[*relation.MsgDestructModel](ctx, m.coll, filter)
+}
+
+func (m *MsgDestructMgo) Advance(ctx context.Context, conversationID string, seqs []int64, expireTime time.Time) error {
+	if len(seqs) == 0 {
+		return nil
+	}
+	filter := bson.M{"conversation_id": conversationID, "seq": bson.M{"$in": seqs}, "user_id": ""}
+	_, err := mongoutil.UpdateMany(ctx, m.coll, filter, bson.M{"$min": bson.M{"expire_time": expireTime}})
+	return err
+}
+
+func (m *MsgDestructMgo) Claim(ctx context.Context, now time.Time, lease time.Duration) (*relation.MsgDestructModel, error) {
+	update := bson.M{
+		"$set": bson.M{"expire_time": now.Add(lease)},
+		"$inc": bson.M{"attempts": 1},
+	}
+	opts := options.FindOneAndUpdate().SetSort(bson.M{"expire_time": 1}).SetReturnDocument(options.After)
+	return mongoutil.FindOneAndUpdate[*relation.MsgDestructModel](ctx, m.coll, bson.M{"expire_time": bson.M{"$lte": now}}, update, opts)
+}
+
+func (m *MsgDestructMgo) Delete(ctx context.Context, conversationID string, seq int64, userID string) error {
+	return mongoutil.DeleteOne(ctx, m.coll, bson.M{"conversation_id": conversationID, "seq": seq, "user_id": userID})
+}
+
+func (m *MsgDestructMgo) DeleteBySeqs(ctx context.Context, conversationID string, seqs []int64) error {
+	if len(seqs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, m.coll, bson.M{"conversation_id": conversationID, "seq": bson.M{"$in": seqs}})
+}
@@ -0,0 +1,81 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/go-tools/db/mongoutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewMsgExportJobMongo(db *mongo.Database) (relation.MsgExportJobInterface, error) {
+	coll := db.Collection("msg_export_job")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "job_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "update_time", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &MsgExportJobMgo{coll: coll}, nil
+}
+
+type MsgExportJobMgo struct {
+	coll *mongo.Collection
+}
+
+func (m *MsgExportJobMgo) Create(ctx context.Context, job *relation.MsgExportJobModel) error {
+	return mongoutil.InsertMany(ctx, m.coll, []*relation.MsgExportJobModel{job})
+}
+
+func (m *MsgExportJobMgo) Take(ctx context.Context, jobID string) (*relation.MsgExportJobModel, error) {
+	return mongoutil.FindOne[*relation.MsgExportJobModel](ctx, m.coll, bson.M{"job_id": jobID})
+}
+
+func (m *MsgExportJobMgo) Update(ctx context.Context, jobID string, args map[string]any) error {
+	set := bson.M{"update_time": time.Now()}
+	for k, v := range args {
+		set[k] = v
+	}
+	return mongoutil.UpdateOne(ctx, m.coll, bson.M{"job_id": jobID}, bson.M{"$set": set}, true)
+}
+
+func (m *MsgExportJobMgo) FailStale(ctx context.Context, before time.Time, errMsg string) (int64, error) {
+	filter := bson.M{
+		"status":      bson.M{"$in": []int32{relation.MsgExportPending, relation.MsgExportRunning}},
+		"update_time": bson.M{"$lt": before},
+	}
+	update := bson.M{"$set": bson.M{"status": relation.MsgExportFailed, "error": errMsg, "update_time": time.Now()}}
+	res, err := mongoutil.UpdateMany(ctx, m.coll, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
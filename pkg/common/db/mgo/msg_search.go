@@ -0,0 +1,100 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/go-tools/db/mongoutil"
+	"github.com/Meikwei/go-tools/db/pagination"
+	"github.com/Meikwei/go-tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewMsgSearchIndexMongo(db *mongo.Database) (relation.MsgSearchIndexInterface, error) {
+	coll := db.Collection("msg_search_index")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "conversation_id", Value: 1},
+				{Key: "seq", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "conversation_id", Value: 1},
+				{Key: "tokens", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &MsgSearchIndexMgo{coll: coll}, nil
+}
+
+type MsgSearchIndexMgo struct {
+	coll *mongo.Collection
+}
+
+func (m *MsgSearchIndexMgo) Upsert(ctx context.Context, indexes []*relation.MsgSearchIndexModel) error {
+	if len(indexes) == 0 {
+		return nil
+	}
+	models := make([]mongo.WriteModel, 0, len(indexes))
+	for _, index := range indexes {
+		models = append(models, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"conversation_id": index.ConversationID, "seq": index.Seq}).
+			SetReplacement(index).
+			SetUpsert(true))
+	}
+	if _, err := m.coll.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false)); err != nil {
+		return errs.WrapMsg(err, "upsert msg search index", "conversationID", indexes[0].ConversationID)
+	}
+	return nil
+}
+
+func (m *MsgSearchIndexMgo) Delete(ctx context.Context, conversationID string, seqs []int64) error {
+	if len(seqs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, m.coll, bson.M{"conversation_id": conversationID, "seq": bson.M{"$in": seqs}})
+}
+
+func (m *MsgSearchIndexMgo) DeleteBefore(ctx context.Context, conversationID string, minSeq int64) error {
+	return mongoutil.DeleteMany(ctx, m.coll, bson.M{"conversation_id": conversationID, "seq": bson.M{"$lt": minSeq}})
+}
+
+func (m *MsgSearchIndexMgo) Search(ctx context.Context, minSeqs map[string]int64, tokens []string, pagination pagination.Pagination) (int64, []*relation.MsgSearchIndexModel, error) {
+	if len(minSeqs) == 0 || len(tokens) == 0 {
+		return 0, nil, nil
+	}
+	// 大部分会话的最小seq相同，按最小seq分组以减少$or的分支数
+	groups := make(map[int64][]string)
+	for conversationID, minSeq := range minSeqs {
+		groups[minSeq] = append(groups[minSeq], conversationID)
+	}
+	or := make(bson.A, 0, len(groups))
+	for minSeq, conversationIDs := range groups {
+		or = append(or, bson.M{"conversation_id": bson.M{"$in": conversationIDs}, "seq": bson.M{"$gte": minSeq}})
+	}
+	filter := bson.M{"$or": or, "tokens": bson.M{"$all": tokens}}
+	opts := options.Find().SetSort(bson.D{{Key: "send_time", Value: -1}, {Key: "seq", Value: -1}}).SetProjection(bson.M{"tokens": 0})
+	return mongoutil.FindPage[*relation.MsgSearchIndexModel](ctx, m.coll, filter, pagination, opts)
+}
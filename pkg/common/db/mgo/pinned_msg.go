@@ -0,0 +1,93 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/go-tools/db/mongoutil"
+	"github.com/Meikwei/go-tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewPinnedMsgMongo(db *mongo.Database) (relation.PinnedMsgInterface, error) {
+	coll := db.Collection("pinned_msg")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "conversation_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &PinnedMsgMgo{coll: coll}, nil
+}
+
+type PinnedMsgMgo struct {
+	coll *mongo.Collection
+}
+
+func (p *PinnedMsgMgo) Pin(ctx context.Context, conversationID string, pin *relation.PinnedMsgModel, limit int) (bool, error) {
+	if limit <= 0 {
+		return false, errs.Wrap(mongo.ErrNoDocuments)
+	}
+	// 先确保会话的记录存在，再用带条件的$push保证不重复且不超过上限
+	err := mongoutil.UpdateOne(ctx, p.coll, bson.M{"conversation_id": conversationID},
+		bson.M{"$setOnInsert": bson.M{"pins": bson.A{}}}, false, options.Update().SetUpsert(true))
+	if err != nil && !mongo.IsDuplicateKeyError(errs.Unwrap(err)) {
+		return false, err
+	}
+	filter := bson.M{
+		"conversation_id":               conversationID,
+		"pins.seq":                      bson.M{"$ne": pin.Seq},
+		fmt.Sprintf("pins.%d", limit-1): bson.M{"$exists": false},
+	}
+	res, err := mongoutil.UpdateOneResult(ctx, p.coll, filter, bson.M{"$push": bson.M{"pins": pin}})
+	if err != nil {
+		return false, err
+	}
+	if res.MatchedCount > 0 {
+		return true, nil
+	}
+	pinned, err := mongoutil.Exist(ctx, p.coll, bson.M{"conversation_id": conversationID, "pins.seq": pin.Seq})
+	if err != nil {
+		return false, err
+	}
+	if pinned {
+		return false, nil
+	}
+	return false, errs.Wrap(mongo.ErrNoDocuments)
+}
+
+func (p *PinnedMsgMgo) Unpin(ctx context.Context, conversationID string, seq int64) error {
+	filter := bson.M{"conversation_id": conversationID, "pins.seq": seq}
+	return mongoutil.UpdateOne(ctx, p.coll, filter, bson.M{"$pull": bson.M{"pins": bson.M{"seq": seq}}}, true)
+}
+
+func (p *PinnedMsgMgo) Find(ctx context.Context, conversationID string) ([]*relation.PinnedMsgModel, error) {
+	pins, err := mongoutil.FindOne[*relation.ConversationPinsModel](ctx, p.coll, bson.M{"conversation_id": conversationID})
+	if err != nil {
+		if errs.Unwrap(err) == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return pins.Pins, nil
+}
@@ -0,0 +1,127 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/go-tools/db/mongoutil"
+	"github.com/Meikwei/go-tools/db/pagination"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewScheduledMsgMongo(db *mongo.Database) (relation.ScheduledMsgInterface, error) {
+	coll := db.Collection("scheduled_msg")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "schedule_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "send_id", Value: 1},
+				{Key: "send_time", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "send_time", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ScheduledMsgMgo{coll: coll}, nil
+}
+
+type ScheduledMsgMgo struct {
+	coll *mongo.Collection
+}
+
+func (s *ScheduledMsgMgo) Create(ctx context.Context, msg *relation.ScheduledMsgModel) error {
+	return mongoutil.InsertMany(ctx, s.coll, []*relation.ScheduledMsgModel{msg})
+}
+
+func (s *ScheduledMsgMgo) Take(ctx context.Context, scheduleID string) (*relation.ScheduledMsgModel, error) {
+	return mongoutil.FindOne[*relation.ScheduledMsgModel](ctx, s.coll, bson.M{"schedule_id": scheduleID})
+}
+
+func (s *ScheduledMsgMgo) FindBySendID(ctx context.Context, sendID string, status []int32, pagination pagination.Pagination) (int64, []*relation.ScheduledMsgModel, error) {
+	filter := bson.M{"send_id": sendID}
+	if len(status) > 0 {
+		filter["status"] = bson.M{"$in": status}
+	}
+	return mongoutil.FindPage[*relation.ScheduledMsgModel](ctx, s.coll, filter, pagination, options.Find().SetSort(bson.M{"send_time": 1}))
+}
+
+// updatePending updates a message that is still waiting to be sent.
+func (s *ScheduledMsgMgo) updatePending(ctx context.Context, scheduleID string, set bson.M) error {
+	filter := bson.M{"schedule_id": scheduleID, "status": relation.ScheduledMsgPending}
+	return mongoutil.UpdateOne(ctx, s.coll, filter, bson.M{"$set": set}, true)
+}
+
+func (s *ScheduledMsgMgo) Cancel(ctx context.Context, scheduleID string) error {
+	return s.updatePending(ctx, scheduleID, bson.M{"status": relation.ScheduledMsgCanceled})
+}
+
+func (s *ScheduledMsgMgo) Reschedule(ctx context.Context, scheduleID string, sendTime time.Time) error {
+	return s.updatePending(ctx, scheduleID, bson.M{"send_time": sendTime})
+}
+
+func (s *ScheduledMsgMgo) Claim(ctx context.Context, now time.Time, owner string, lease time.Duration) (*relation.ScheduledMsgModel, error) {
+	filter := bson.M{"$or": bson.A{
+		bson.M{"status": relation.ScheduledMsgPending, "send_time": bson.M{"$lte": now}},
+		bson.M{"status": relation.ScheduledMsgDispatching, "lease_until": bson.M{"$lte": now}},
+	}}
+	update := bson.M{
+		"$set": bson.M{"status": relation.ScheduledMsgDispatching, "owner": owner, "lease_until": now.Add(lease)},
+		"$inc": bson.M{"attempts": 1},
+	}
+	opts := options.FindOneAndUpdate().SetSort(bson.M{"send_time": 1}).SetReturnDocument(options.After)
+	return mongoutil.FindOneAndUpdate[*relation.ScheduledMsgModel](ctx, s.coll, filter, update, opts)
+}
+
+func (s *ScheduledMsgMgo) MarkSent(ctx context.Context, scheduleID string, owner string, now time.Time) error {
+	filter := bson.M{
+		"schedule_id": scheduleID,
+		"owner":       owner,
+		"status":      relation.ScheduledMsgDispatching,
+		"lease_until": bson.M{"$gt": now},
+	}
+	set := bson.M{"status": relation.ScheduledMsgSent, "error": "", "dispatch_time": now}
+	return mongoutil.UpdateOne(ctx, s.coll, filter, bson.M{"$set": set}, true)
+}
+
+// updateSent updates a message that owner marked as sent before its send failed.
+func (s *ScheduledMsgMgo) updateSent(ctx context.Context, scheduleID string, owner string, set bson.M) error {
+	filter := bson.M{"schedule_id": scheduleID, "owner": owner, "status": relation.ScheduledMsgSent}
+	return mongoutil.UpdateOne(ctx, s.coll, filter, bson.M{"$set": set}, true)
+}
+
+func (s *ScheduledMsgMgo) Release(ctx context.Context, scheduleID string, owner string, errMsg string) error {
+	return s.updateSent(ctx, scheduleID, owner, bson.M{"status": relation.ScheduledMsgPending, "owner": "", "error": errMsg})
+}
+
+func (s *ScheduledMsgMgo) Finish(ctx context.Context, scheduleID string, owner string, status int32, errMsg string) error {
+	return s.updateSent(ctx, scheduleID, owner, bson.M{"status": status, "error": errMsg, "dispatch_time": time.Now()})
+}
@@ -0,0 +1,102 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/go-tools/db/mongoutil"
+	"github.com/Meikwei/go-tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewSeqMongo(db *mongo.Database) (relation.SeqInterface, error) {
+	coll := db.Collection("seq")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "conversation_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &SeqMongo{coll: coll}, nil
+}
+
+type SeqMongo struct {
+	coll *mongo.Collection
+}
+
+func (s *SeqMongo) Malloc(ctx context.Context, conversationID string, size int64, floor int64) (int64, error) {
+	// max_seq = max(max_seq, floor) + size, returning the document before the update
+	base := bson.M{"$max": bson.A{bson.M{"$ifNull": bson.A{"$max_seq", 0}}, floor}}
+	update := []bson.M{
+		{"$set": bson.M{
+			"conversation_id": conversationID,
+			"max_seq":         bson.M{"$add": bson.A{base, size}},
+			"min_seq":         bson.M{"$ifNull": bson.A{"$min_seq", 0}},
+		}},
+	}
+	opt := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.Before).SetProjection(bson.M{"_id": 0, "max_seq": 1})
+	for i := 0; ; i++ {
+		before, err := mongoutil.FindOneAndUpdate[*relation.SeqModel](ctx, s.coll, bson.M{"conversation_id": conversationID}, update, opt)
+		if err == nil {
+			return max(before.MaxSeq, floor), nil
+		}
+		switch {
+		case errs.Unwrap(err) == mongo.ErrNoDocuments:
+			// upserted, there was no record before
+			return floor, nil
+		case i == 0 && mongo.IsDuplicateKeyError(err):
+			// concurrent upsert of the same conversation, the retry updates the inserted record
+			continue
+		default:
+			return 0, err
+		}
+	}
+}
+
+func (s *SeqMongo) GetMaxSeqs(ctx context.Context, conversationIDs []string) (map[string]int64, error) {
+	return s.getSeqs(ctx, conversationIDs, "max_seq", func(seq *relation.SeqModel) int64 { return seq.MaxSeq })
+}
+
+func (s *SeqMongo) SetMinSeq(ctx context.Context, conversationID string, minSeq int64) error {
+	return mongoutil.UpdateOne(ctx, s.coll, bson.M{"conversation_id": conversationID},
+		bson.M{"$set": bson.M{"min_seq": minSeq}, "$setOnInsert": bson.M{"max_seq": 0}}, false, options.Update().SetUpsert(true))
+}
+
+func (s *SeqMongo) GetMinSeqs(ctx context.Context, conversationIDs []string) (map[string]int64, error) {
+	return s.getSeqs(ctx, conversationIDs, "min_seq", func(seq *relation.SeqModel) int64 { return seq.MinSeq })
+}
+
+func (s *SeqMongo) getSeqs(ctx context.Context, conversationIDs []string, field string, get func(seq *relation.SeqModel) int64) (map[string]int64, error) {
+	if len(conversationIDs) == 0 {
+		return map[string]int64{}, nil
+	}
+	seqs, err := mongoutil.Find[*relation.SeqModel](ctx, s.coll, bson.M{"conversation_id": bson.M{"$in": conversationIDs}},
+		options.Find().SetProjection(bson.M{"_id": 0, "conversation_id": 1, field: 1}))
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]int64, len(seqs))
+	for _, seq := range seqs {
+		res[seq.ConversationID] = get(seq)
+	}
+	return res, nil
+}
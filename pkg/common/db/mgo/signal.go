@@ -0,0 +1,238 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/go-tools/db/mongoutil"
+	"github.com/Meikwei/go-tools/db/pagination"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewSignalMongo(db *mongo.Database) (relation.SignalInterface, error) {
+	coll := db.Collection("signal")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "room_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "members.user_id", Value: 1},
+				{Key: "status", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "group_id", Value: 1},
+				{Key: "status", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "timeout_time", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "initiate_time", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &SignalMgo{coll: coll}, nil
+}
+
+type SignalMgo struct {
+	coll *mongo.Collection
+}
+
+var (
+	signalActiveStatus = []int32{relation.SignalStatusInviting, relation.SignalStatusOngoing}
+	signalActiveMember = []int32{relation.SignalMemberRinging, relation.SignalMemberAccepted}
+)
+
+func (s *SignalMgo) Create(ctx context.Context, signal *relation.SignalModel) error {
+	return mongoutil.InsertMany(ctx, s.coll, []*relation.SignalModel{signal})
+}
+
+func (s *SignalMgo) Take(ctx context.Context, roomID string) (*relation.SignalModel, error) {
+	return mongoutil.FindOne[*relation.SignalModel](ctx, s.coll, bson.M{"room_id": roomID})
+}
+
+func (s *SignalMgo) Find(ctx context.Context, roomIDs []string) ([]*relation.SignalModel, error) {
+	return mongoutil.Find[*relation.SignalModel](ctx, s.coll, bson.M{"room_id": bson.M{"$in": roomIDs}})
+}
+
+func (s *SignalMgo) TakeActiveByGroupID(ctx context.Context, groupID string) (*relation.SignalModel, error) {
+	filter := bson.M{"group_id": groupID, "status": bson.M{"$in": signalActiveStatus}}
+	return mongoutil.FindOne[*relation.SignalModel](ctx, s.coll, filter, options.FindOne().SetSort(bson.M{"initiate_time": -1}))
+}
+
+func (s *SignalMgo) FindRinging(ctx context.Context, userID string) ([]*relation.SignalModel, error) {
+	filter := bson.M{
+		"status":  bson.M{"$in": signalActiveStatus},
+		"members": bson.M{"$elemMatch": bson.M{"user_id": userID, "status": relation.SignalMemberRinging}},
+	}
+	return mongoutil.Find[*relation.SignalModel](ctx, s.coll, filter, options.Find().SetSort(bson.M{"initiate_time": -1}))
+}
+
+func (s *SignalMgo) FindBusyUserIDs(ctx context.Context, userIDs []string) ([]string, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	filter := bson.M{
+		"status":  bson.M{"$in": signalActiveStatus},
+		"members": bson.M{"$elemMatch": bson.M{"user_id": bson.M{"$in": userIDs}, "status": bson.M{"$in": signalActiveMember}}},
+	}
+	signals, err := mongoutil.Find[*relation.SignalModel](ctx, s.coll, filter, options.Find().SetProjection(bson.M{"members": 1}))
+	if err != nil {
+		return nil, err
+	}
+	query := make(map[string]struct{}, len(userIDs))
+	for _, userID := range userIDs {
+		query[userID] = struct{}{}
+	}
+	busy := make(map[string]struct{})
+	for _, signal := range signals {
+		for _, member := range signal.Members {
+			if _, ok := query[member.UserID]; !ok {
+				continue
+			}
+			if member.Status == relation.SignalMemberRinging || member.Status == relation.SignalMemberAccepted {
+				busy[member.UserID] = struct{}{}
+			}
+		}
+	}
+	res := make([]string, 0, len(busy))
+	for _, userID := range userIDs {
+		if _, ok := busy[userID]; ok {
+			res = append(res, userID)
+			delete(busy, userID)
+		}
+	}
+	return res, nil
+}
+
+// setMembers 生成按条件改写成员状态的聚合表达式，cond中可通过$$m引用当前成员。
+func setMembers(cond bson.M, status int32, now time.Time) bson.M {
+	return bson.M{"$map": bson.M{
+		"input": "$members",
+		"as":    "m",
+		"in": bson.M{"$cond": bson.A{
+			cond,
+			bson.M{"$mergeObjects": bson.A{"$$m", bson.M{"status": status, "handle_time": now}}},
+			"$$m",
+		}},
+	}}
+}
+
+func (s *SignalMgo) findOneAndUpdate(ctx context.Context, filter bson.M, pipeline bson.A) (*relation.SignalModel, error) {
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.Before)
+	return mongoutil.FindOneAndUpdate[*relation.SignalModel](ctx, s.coll, filter, pipeline, opts)
+}
+
+func (s *SignalMgo) UpdateMember(ctx context.Context, roomID string, userID string, from []int32, status int32, roomFrom []int32, roomStatus int32) (*relation.SignalModel, error) {
+	now := time.Now()
+	filter := bson.M{
+		"room_id": roomID,
+		"status":  bson.M{"$in": signalActiveStatus},
+		"members": bson.M{"$elemMatch": bson.M{"user_id": userID, "status": bson.M{"$in": from}}},
+	}
+	cond := bson.M{"$and": bson.A{
+		bson.M{"$eq": bson.A{"$$m.user_id", userID}},
+		bson.M{"$in": bson.A{"$$m.status", from}},
+	}}
+	if roomFrom == nil {
+		roomFrom = []int32{}
+	}
+	pipeline := bson.A{bson.M{"$set": bson.M{
+		"members": setMembers(cond, status, now),
+		"status":  bson.M{"$cond": bson.A{bson.M{"$in": bson.A{"$status", roomFrom}}, roomStatus, "$status"}},
+	}}}
+	return s.findOneAndUpdate(ctx, filter, pipeline)
+}
+
+func (s *SignalMgo) Finish(ctx context.Context, roomID string, from []int32, maxActive int, status int32, ringingStatus int32) (*relation.SignalModel, error) {
+	now := time.Now()
+	filter := bson.M{"room_id": roomID, "status": bson.M{"$in": from}}
+	if maxActive >= 0 {
+		filter["$expr"] = bson.M{"$lte": bson.A{
+			bson.M{"$size": bson.M{"$filter": bson.M{
+				"input": "$members",
+				"as":    "m",
+				"cond":  bson.M{"$in": bson.A{"$$m.status", signalActiveMember}},
+			}}},
+			maxActive,
+		}}
+	}
+	members := setMembers(bson.M{"$eq": bson.A{"$$m.status", relation.SignalMemberRinging}}, ringingStatus, now)
+	pipeline := bson.A{
+		bson.M{"$set": bson.M{"members": members, "status": status, "end_time": now}},
+		bson.M{"$set": bson.M{"members": setMembers(bson.M{"$eq": bson.A{"$$m.status", relation.SignalMemberAccepted}}, relation.SignalMemberHungUp, now)}},
+	}
+	return s.findOneAndUpdate(ctx, filter, pipeline)
+}
+
+func (s *SignalMgo) TimeoutOne(ctx context.Context, deadline time.Time) (*relation.SignalModel, error) {
+	filter := bson.M{
+		"status":         bson.M{"$in": signalActiveStatus},
+		"timeout_time":   bson.M{"$lte": deadline},
+		"members.status": relation.SignalMemberRinging,
+	}
+	members := setMembers(bson.M{"$eq": bson.A{"$$m.status", relation.SignalMemberRinging}}, relation.SignalMemberTimeout, time.Now())
+	return s.findOneAndUpdate(ctx, filter, bson.A{bson.M{"$set": bson.M{"members": members}}})
+}
+
+func (s *SignalMgo) Search(ctx context.Context, sessionType int32, sendID string, recvID string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*relation.SignalModel, error) {
+	filter := bson.M{}
+	if sessionType != 0 {
+		filter["session_type"] = sessionType
+	}
+	if sendID != "" {
+		filter["inviter_user_id"] = sendID
+	}
+	if recvID != "" {
+		filter["$or"] = bson.A{bson.M{"group_id": recvID}, bson.M{"members.user_id": recvID, "inviter_user_id": bson.M{"$ne": recvID}}}
+	}
+	initiateTime := bson.M{}
+	if !start.IsZero() {
+		initiateTime["$gte"] = start
+	}
+	if !end.IsZero() {
+		initiateTime["$lte"] = end
+	}
+	if len(initiateTime) > 0 {
+		filter["initiate_time"] = initiateTime
+	}
+	return mongoutil.FindPage[*relation.SignalModel](ctx, s.coll, filter, pagination, options.Find().SetSort(bson.M{"initiate_time": -1}))
+}
+
+func (s *SignalMgo) Delete(ctx context.Context, roomIDs []string) error {
+	if len(roomIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, s.coll, bson.M{"room_id": bson.M{"$in": roomIDs}})
+}
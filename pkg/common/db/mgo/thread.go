@@ -0,0 +1,72 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/go-tools/db/mongoutil"
+	"github.com/Meikwei/go-tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewThreadMongo(db *mongo.Database) (relation.ThreadInterface, error) {
+	coll := db.Collection("thread")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "thread_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &ThreadMgo{coll: coll}, nil
+}
+
+type ThreadMgo struct {
+	coll *mongo.Collection
+}
+
+func (t *ThreadMgo) Create(ctx context.Context, thread *relation.ThreadModel) error {
+	return mongoutil.InsertMany(ctx, t.coll, []*relation.ThreadModel{thread})
+}
+
+func (t *ThreadMgo) Take(ctx context.Context, threadID string) (*relation.ThreadModel, error) {
+	return mongoutil.FindOne[*relation.ThreadModel](ctx, t.coll, bson.M{"thread_id": threadID})
+}
+
+func (t *ThreadMgo) Find(ctx context.Context, threadIDs []string) ([]*relation.ThreadModel, error) {
+	return mongoutil.Find[*relation.ThreadModel](ctx, t.coll, bson.M{"thread_id": bson.M{"$in": threadIDs}})
+}
+
+func (t *ThreadMgo) IncrReply(ctx context.Context, threadID string, seq int64, userID string, replyTime time.Time) (*relation.ThreadModel, error) {
+	// 并发回复时只保留seq最大的回复作为最后一条回复
+	isLast := bson.M{"$gt": bson.A{seq, "$last_reply_seq"}}
+	update := bson.A{
+		bson.M{"$set": bson.M{
+			"reply_count":        bson.M{"$add": bson.A{"$reply_count", 1}},
+			"last_reply_seq":     bson.M{"$max": bson.A{"$last_reply_seq", seq}},
+			"last_reply_user_id": bson.M{"$cond": bson.A{isLast, userID, "$last_reply_user_id"}},
+			"last_reply_time":    bson.M{"$cond": bson.A{isLast, replyTime, "$last_reply_time"}},
+		}},
+	}
+	opt := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	return mongoutil.FindOneAndUpdate[*relation.ThreadModel](ctx, t.coll, bson.M{"thread_id": threadID}, update, opt)
+}
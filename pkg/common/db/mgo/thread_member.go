@@ -0,0 +1,67 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/Meikwei/aetim/pkg/common/db/table/relation"
+	"github.com/Meikwei/go-tools/db/mongoutil"
+	"github.com/Meikwei/go-tools/db/pagination"
+	"github.com/Meikwei/go-tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewThreadMemberMongo(db *mongo.Database) (relation.ThreadMemberInterface, error) {
+	coll := db.Collection("thread_member")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "thread_id", Value: 1},
+				{Key: "user_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "subscribed", Value: 1},
+				{Key: "update_time", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &ThreadMemberMgo{coll: coll}, nil
+}
+
+type ThreadMemberMgo struct {
+	coll *mongo.Collection
+}
+
+func (t *ThreadMemberMgo) Subscribe(ctx context.Context, threadID string, userID string, subscribed bool, updateTime time.Time) error {
+	filter := bson.M{"thread_id": threadID, "user_id": userID}
+	update := bson.M{"$set": bson.M{"subscribed": subscribed, "update_time": updateTime}}
+	return mongoutil.UpdateOne(ctx, t.coll, filter, update, false, options.Update().SetUpsert(true))
+}
+
+func (t *ThreadMemberMgo) FindSubscribed(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*relation.ThreadMemberModel, error) {
+	filter := bson.M{"user_id": userID, "subscribed": true}
+	return mongoutil.FindPage[*relation.ThreadMemberModel](ctx, t.coll, filter, pagination, options.Find().SetSort(bson.M{"update_time": -1}))
+}
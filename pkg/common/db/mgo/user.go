@@ -114,6 +114,39 @@ func (u *UserMgo) GetAllUserID(ctx context.Context, pagination pagination.Pagina
 	return mongoutil.FindPage[string](ctx, u.coll, bson.M{}, pagination, options.Find().SetProjection(bson.M{"_id": 0, "user_id": 1}))
 }
 
+// userFilter converts filter to a query on the user collection.
+func userFilter(filter *relation.UserFilter) bson.M {
+	query := bson.M{}
+	if filter == nil {
+		return query
+	}
+	createTime := bson.M{}
+	if !filter.CreateTimeBegin.IsZero() {
+		createTime["$gte"] = filter.CreateTimeBegin
+	}
+	if !filter.CreateTimeEnd.IsZero() {
+		createTime["$lt"] = filter.CreateTimeEnd
+	}
+	if len(createTime) > 0 {
+		query["create_time"] = createTime
+	}
+	if len(filter.AppMangerLevels) > 0 {
+		query["app_manger_level"] = bson.M{"$in": filter.AppMangerLevels}
+	}
+	return query
+}
+
+func (u *UserMgo) FindUserIDsAfter(ctx context.Context, filter *relation.UserFilter, afterUserID string, limit int64) ([]string, error) {
+	query := userFilter(filter)
+	query["user_id"] = bson.M{"$gt": afterUserID}
+	opts := options.Find().SetSort(bson.M{"user_id": 1}).SetLimit(limit).SetProjection(bson.M{"_id": 0, "user_id": 1})
+	return mongoutil.Find[string](ctx, u.coll, query, opts)
+}
+
+func (u *UserMgo) CountUsers(ctx context.Context, filter *relation.UserFilter) (int64, error) {
+	return mongoutil.Count(ctx, u.coll, userFilter(filter))
+}
+
 func (u *UserMgo) Exist(ctx context.Context, userID string) (exist bool, err error) {
 	return mongoutil.Exist(ctx, u.coll, bson.M{"user_id": userID})
 }
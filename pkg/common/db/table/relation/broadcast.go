@@ -0,0 +1,119 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+
+	"github.com/Meikwei/go-tools/db/pagination"
+)
+
+// 广播任务状态
+const (
+	BroadcastPending   = 1 // 等待msg rpc实例领取，包括继续执行的暂停任务
+	BroadcastRunning   = 2 // 已被msg rpc实例领取，正在发送
+	BroadcastPaused    = 3 // 管理员暂停，继续后从cursor之后发送
+	BroadcastCanceled  = 4 // 管理员取消
+	BroadcastSucceeded = 5 // 全部接收者处理完成，包括发送失败的接收者
+	BroadcastFailed    = 6 // 遍历接收者失败
+)
+
+// 广播目标类型
+const (
+	BroadcastTargetAll    = 1 // 全部用户
+	BroadcastTargetUsers  = 2 // 指定的用户
+	BroadcastTargetGroups = 3 // 指定群的成员
+	BroadcastTargetFilter = 4 // 按用户属性筛选
+)
+
+// BroadcastJobModel 向一批用户逐个发送同一条消息的任务，接收者按user_id升序处理。
+type BroadcastJobModel struct {
+	JobID           string    `bson:"job_id"`
+	OpUserID        string    `bson:"op_user_id"`
+	SendID          string    `bson:"send_id"`
+	MsgData         []byte    `bson:"msg_data"` // proto编码的sdkws.MsgData模板
+	TargetType      int32     `bson:"target_type"`
+	UserIDs         []string  `bson:"user_ids"` // 已排序去重
+	GroupIDs        []string  `bson:"group_ids"`
+	CreateTimeBegin time.Time `bson:"create_time_begin"`
+	CreateTimeEnd   time.Time `bson:"create_time_end"`
+	AppMangerLevels []int32   `bson:"app_manger_levels"`
+	Rate            int32     `bson:"rate"` // 每秒发送的消息数
+	Status          int32     `bson:"status"`
+	Owner           string    `bson:"owner"`       // 领取该任务的msg rpc实例
+	LeaseUntil      time.Time `bson:"lease_until"` // 领取的有效期，过期后可被重新领取
+	Cursor          string    `bson:"cursor"`      // 已处理的最后一个接收者
+	TotalCount      int64     `bson:"total_count"`
+	SentCount       int64     `bson:"sent_count"`
+	FailedCount     int64     `bson:"failed_count"`
+	Error           string    `bson:"error"`
+	CreateTime      time.Time `bson:"create_time"`
+	UpdateTime      time.Time `bson:"update_time"`
+	FinishTime      time.Time `bson:"finish_time"`
+}
+
+// UserFilter 返回BroadcastTargetFilter任务的用户筛选条件。
+func (b *BroadcastJobModel) UserFilter() *UserFilter {
+	return &UserFilter{
+		CreateTimeBegin: b.CreateTimeBegin,
+		CreateTimeEnd:   b.CreateTimeEnd,
+		AppMangerLevels: b.AppMangerLevels,
+	}
+}
+
+// BroadcastFailureModel 广播任务中发送失败的接收者。
+type BroadcastFailureModel struct {
+	JobID      string    `bson:"job_id"`
+	UserID     string    `bson:"user_id"`
+	Error      string    `bson:"error"`
+	CreateTime time.Time `bson:"create_time"`
+}
+
+// BroadcastSentModel 广播任务中已开始发送的接收者。任务中断后从cursor继续时跳过这些接收者，任务结束后删除。
+type BroadcastSentModel struct {
+	JobID      string    `bson:"job_id"`
+	UserID     string    `bson:"user_id"`
+	CreateTime time.Time `bson:"create_time"`
+}
+
+// BroadcastInterface 广播任务的存储接口。
+// 状态变更都是带前置条件的原子更新，前置条件不满足时返回mongo.ErrNoDocuments。
+type BroadcastInterface interface {
+	Create(ctx context.Context, job *BroadcastJobModel) error
+	Take(ctx context.Context, jobID string) (*BroadcastJobModel, error)
+	// Find 按创建时间倒序分页查询，status为空表示全部状态
+	Find(ctx context.Context, status []int32, pagination pagination.Pagination) (int64, []*BroadcastJobModel, error)
+	// Pause 暂停等待领取或正在发送的任务
+	Pause(ctx context.Context, jobID string) error
+	// Resume 将暂停的任务放回等待领取状态
+	Resume(ctx context.Context, jobID string) error
+	// Cancel 取消未结束的任务
+	Cancel(ctx context.Context, jobID string) error
+	// Claim 领取一个等待中的任务，或领取有效期已过仍在发送的任务，返回领取后的记录
+	Claim(ctx context.Context, now time.Time, owner string, lease time.Duration) (*BroadcastJobModel, error)
+	// Progress 记录owner处理到的接收者和本批的发送结果，同时续期，任务已被暂停或取消时返回mongo.ErrNoDocuments
+	Progress(ctx context.Context, jobID string, owner string, cursor string, sent int64, failed int64, leaseUntil time.Time) error
+	// Finish 将owner正在发送的任务置为完成或失败
+	Finish(ctx context.Context, jobID string, owner string, status int32, errMsg string) error
+	// AddFailures 记录发送失败的接收者，重复的接收者覆盖原记录
+	AddFailures(ctx context.Context, failures []*BroadcastFailureModel) error
+	// FindFailures 按接收者ID升序分页查询发送失败的接收者
+	FindFailures(ctx context.Context, jobID string, pagination pagination.Pagination) (int64, []*BroadcastFailureModel, error)
+	// MarkSent 在发送前记录接收者，接收者已记录过时返回false
+	MarkSent(ctx context.Context, jobID string, userID string) (bool, error)
+	// DeleteSent 删除任务记录的全部接收者
+	DeleteSent(ctx context.Context, jobID string) error
+}
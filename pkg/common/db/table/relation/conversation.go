@@ -40,6 +40,8 @@ type ConversationModel struct {
 	IsMsgDestruct         bool      `bson:"is_msg_destruct"`
 	MsgDestructTime       int64     `bson:"msg_destruct_time"`
 	LatestMsgDestructTime time.Time `bson:"latest_msg_destruct_time"`
+
+	Retention *RetentionModel `bson:"retention,omitempty" json:"-"` // 会话消息保留策略，只由SetRetention维护，不进入缓存
 }
 
 type ConversationModelInterface interface {
@@ -61,4 +63,8 @@ type ConversationModelInterface interface {
 	GetConversationsByConversationID(ctx context.Context, conversationIDs []string) ([]*ConversationModel, error)
 	GetConversationIDsNeedDestruct(ctx context.Context) ([]*ConversationModel, error)
 	GetConversationNotReceiveMessageUserIDs(ctx context.Context, conversationID string) ([]string, error)
+	// SetRetention 设置会话的消息保留策略，retention为nil时清除，会话不存在时返回mongo.ErrNoDocuments
+	SetRetention(ctx context.Context, conversationID string, retention *RetentionModel) error
+	// FindRetention 返回设置了保留策略的会话，每个会话可能返回多行
+	FindRetention(ctx context.Context, conversationIDs []string) ([]*ConversationModel, error)
 }
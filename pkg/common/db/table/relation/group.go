@@ -37,6 +37,8 @@ type GroupModel struct {
 	ApplyMemberFriend      int32     `bson:"apply_member_friend"`
 	NotificationUpdateTime time.Time `bson:"notification_update_time"`
 	NotificationUserID     string    `bson:"notification_user_id"`
+
+	Retention *RetentionModel `bson:"retention,omitempty" json:"-"` // 群消息保留策略，只由SetRetention维护，不进入缓存
 }
 
 type GroupModelInterface interface {
@@ -50,4 +52,8 @@ type GroupModelInterface interface {
 	CountTotal(ctx context.Context, before *time.Time) (count int64, err error)
 	// Get Group total quantity every day
 	CountRangeEverydayTotal(ctx context.Context, start time.Time, end time.Time) (map[string]int64, error)
+	// SetRetention 设置群的消息保留策略，retention为nil时清除，群不存在时返回mongo.ErrNoDocuments
+	SetRetention(ctx context.Context, groupID string, retention *RetentionModel) error
+	// FindRetention 返回设置了保留策略的群
+	FindRetention(ctx context.Context, groupIDs []string) ([]*GroupModel, error)
 }
@@ -51,6 +51,11 @@ type GroupMemberModelInterface interface {
 	// FindJoinUserID(ctx context.Context, groupIDs []string) (groupUsers map[string][]string, err error)
 	FindUserJoinedGroupID(ctx context.Context, userID string) (groupIDs []string, err error)
 	TakeGroupMemberNum(ctx context.Context, groupID string) (count int64, err error)
+	// FindUserIDsAfter 按user_id升序返回groupIDs中大于afterUserID的成员，已去重，结果为空表示没有更多成员。
+	// 同一用户在多个群中时按记录计入limit，所以返回的数量可能小于limit
+	FindUserIDsAfter(ctx context.Context, groupIDs []string, afterUserID string, limit int64) (userIDs []string, err error)
+	// CountDistinctUserID 统计groupIDs中不重复的成员数
+	CountDistinctUserID(ctx context.Context, groupIDs []string) (count int64, err error)
 	// FindUsersJoinedGroupID(ctx context.Context, userIDs []string) (map[string][]string, error)
 	FindUserManagedGroupID(ctx context.Context, userID string) (groupIDs []string, err error)
 	IsUpdateRoleLevel(data map[string]any) bool
@@ -0,0 +1,40 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+)
+
+// GroupReadReceiptModel 群成员在群会话中的已读位置。
+type GroupReadReceiptModel struct {
+	ConversationID string    `bson:"conversation_id"`
+	UserID         string    `bson:"user_id"`
+	HasReadSeq     int64     `bson:"has_read_seq"` // 已读到的消息序列号
+	ReadTime       time.Time `bson:"read_time"`    // 已读位置最后一次前进的时间
+}
+
+// GroupReadReceiptInterface 群消息已读回执的存储接口。
+type GroupReadReceiptInterface interface {
+	// MarkRead 把成员的已读位置前进到hasReadSeq，返回之前的已读位置，已读位置不会后退
+	MarkRead(ctx context.Context, conversationID string, userID string, hasReadSeq int64, readTime time.Time) (int64, error)
+	// FindReaders 查询userIDs中已读到seq的成员，按已读时间排序，最多返回limit个
+	FindReaders(ctx context.Context, conversationID string, seq int64, userIDs []string, limit int64) ([]*GroupReadReceiptModel, error)
+	// FindReaderIDs 查询userIDs中已读到seq的成员ID
+	FindReaderIDs(ctx context.Context, conversationID string, seq int64, userIDs []string) ([]string, error)
+	// CountReaders 统计userIDs中已读到seq的成员数
+	CountReaders(ctx context.Context, conversationID string, seq int64, userIDs []string) (int64, error)
+}
@@ -47,6 +47,38 @@ type RevokeModel struct {
 	Time     int64  `bson:"time"`
 }
 
+// EditModel is one edit of a message, the latest edit is the content seen by users.
+type EditModel struct {
+	UserID      string `bson:"user_id"`
+	PrevContent string `bson:"prev_content"`
+	Content     string `bson:"content"`
+	Time        int64  `bson:"time"`
+}
+
+// ReactionModel is a reaction of a user to a message, a user reacts with each emoji at most once.
+type ReactionModel struct {
+	UserID string `bson:"user_id"`
+	Emoji  string `bson:"emoji"`
+}
+
+// ReactionEmojis returns the emoji of each reaction in order.
+func ReactionEmojis(reactions []*ReactionModel) []string {
+	emojis := make([]string, 0, len(reactions))
+	for _, reaction := range reactions {
+		emojis = append(emojis, reaction.Emoji)
+	}
+	return emojis
+}
+
+// MsgThreadModel is the reply metadata of the thread replying to a message.
+type MsgThreadModel struct {
+	ThreadID        string `bson:"thread_id"`
+	ReplyCount      int64  `bson:"reply_count"`
+	LastReplySeq    int64  `bson:"last_reply_seq"`
+	LastReplyUserID string `bson:"last_reply_user_id"`
+	LastReplyTime   int64  `bson:"last_reply_time"`
+}
+
 type OfflinePushModel struct {
 	Title         string `bson:"title"`
 	Desc          string `bson:"desc"`
@@ -81,10 +113,13 @@ type MsgDataModel struct {
 }
 
 type MsgInfoModel struct {
-	Msg     *MsgDataModel `bson:"msg"`
-	Revoke  *RevokeModel  `bson:"revoke"`
-	DelList []string      `bson:"del_list"`
-	IsRead  bool          `bson:"is_read"`
+	Msg       *MsgDataModel    `bson:"msg"`
+	Revoke    *RevokeModel     `bson:"revoke"`
+	Edits     []*EditModel     `bson:"edits,omitempty"`
+	Reactions []*ReactionModel `bson:"reactions,omitempty"`
+	Thread    *MsgThreadModel  `bson:"thread,omitempty"`
+	DelList   []string         `bson:"del_list"`
+	IsRead    bool             `bson:"is_read"`
 }
 
 type UserCount struct {
@@ -102,6 +137,8 @@ type MsgDocModelInterface interface {
 	Create(ctx context.Context, model *MsgDocModel) error
 	UpdateMsg(ctx context.Context, docID string, index int64, key string, value any) (*mongo.UpdateResult, error)
 	PushUnique(ctx context.Context, docID string, index int64, key string, value any) (*mongo.UpdateResult, error)
+	PullField(ctx context.Context, docID string, index int64, key string, value any) (*mongo.UpdateResult, error)
+	GetReactions(ctx context.Context, docID string, index int64) ([]*ReactionModel, error)
 	UpdateMsgContent(ctx context.Context, docID string, index int64, msg []byte) error
 	IsExistDocID(ctx context.Context, docID string) (bool, error)
 	FindOneByDocID(ctx context.Context, docID string) (*MsgDocModel, error)
@@ -0,0 +1,49 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+)
+
+// MsgDestructModel 一条消息的自毁计时，到期后由crontask删除消息。
+// UserID为空的计时对所有人物理删除消息；阅读后开始计时的群消息，每个阅读者另有一条自己的计时，到期后只对该用户删除。
+type MsgDestructModel struct {
+	ConversationID string    `bson:"conversation_id"`
+	Seq            int64     `bson:"seq"`
+	UserID         string    `bson:"user_id"`
+	SendID         string    `bson:"send_id"`
+	SessionType    int32     `bson:"session_type"`
+	TTL            int64     `bson:"ttl"` // 消息存活的秒数
+	StartOnRead    bool      `bson:"start_on_read"`
+	ExpireTime     time.Time `bson:"expire_time"` // 到期时间，被领取后推迟到领取的有效期结束
+	Attempts       int32     `bson:"attempts"`
+}
+
+// MsgDestructInterface 消息自毁计时的存储接口。
+type MsgDestructInterface interface {
+	// Create 创建计时，已经存在的计时保持不变，所以只有第一次阅读开始计时
+	Create(ctx context.Context, destructs []*MsgDestructModel) error
+	// FindStartOnRead 查找会话中seq在(fromSeq, toSeq]内、阅读后开始计时的消息的计时
+	FindStartOnRead(ctx context.Context, conversationID string, fromSeq int64, toSeq int64) ([]*MsgDestructModel, error)
+	// Advance 将消息对所有人的计时提前到不晚于expireTime
+	Advance(ctx context.Context, conversationID string, seqs []int64, expireTime time.Time) error
+	// Claim 领取一条到期的计时并将到期时间推迟lease，没有到期的计时时返回mongo.ErrNoDocuments
+	Claim(ctx context.Context, now time.Time, lease time.Duration) (*MsgDestructModel, error)
+	Delete(ctx context.Context, conversationID string, seq int64, userID string) error
+	// DeleteBySeqs 删除消息的所有计时
+	DeleteBySeqs(ctx context.Context, conversationID string, seqs []int64) error
+}
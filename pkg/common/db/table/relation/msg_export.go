@@ -0,0 +1,57 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+)
+
+// 消息导出任务状态
+const (
+	MsgExportPending   = 1 // 排队等待执行
+	MsgExportRunning   = 2 // 正在导出
+	MsgExportSucceeded = 3 // 导出完成，文件已上传
+	MsgExportFailed    = 4 // 导出失败
+)
+
+// MsgExportJobModel 一个会话或用户全部会话的消息导出任务。
+type MsgExportJobModel struct {
+	JobID              string    `bson:"job_id"`
+	OpUserID           string    `bson:"op_user_id"`      // 创建任务的用户，上传的文件归属于该用户
+	UserID             string    `bson:"user_id"`         // 以该用户的视角导出消息
+	ConversationID     string    `bson:"conversation_id"` // 为空时导出用户的全部会话
+	StartTime          int64     `bson:"start_time"`      // 消息发送时间范围，毫秒时间戳
+	EndTime            int64     `bson:"end_time"`
+	Status             int32     `bson:"status"`
+	TotalConversations int64     `bson:"total_conversations"`
+	DoneConversations  int64     `bson:"done_conversations"`
+	MsgCount           int64     `bson:"msg_count"`
+	JSONLName          string    `bson:"jsonl_name"` // 对象存储中JSON Lines文件的名称
+	HTMLName           string    `bson:"html_name"`  // 对象存储中HTML文件的名称
+	Error              string    `bson:"error"`
+	CreateTime         time.Time `bson:"create_time"`
+	UpdateTime         time.Time `bson:"update_time"`
+}
+
+// MsgExportJobInterface 消息导出任务的存储接口。
+type MsgExportJobInterface interface {
+	Create(ctx context.Context, job *MsgExportJobModel) error
+	Take(ctx context.Context, jobID string) (*MsgExportJobModel, error)
+	// Update 更新任务字段，同时刷新update_time
+	Update(ctx context.Context, jobID string, args map[string]any) error
+	// FailStale 将update_time早于before仍未结束的任务置为失败，返回更新的数量
+	FailStale(ctx context.Context, before time.Time, errMsg string) (int64, error)
+}
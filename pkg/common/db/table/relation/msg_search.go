@@ -0,0 +1,42 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+
+	"github.com/Meikwei/go-tools/db/pagination"
+)
+
+// MsgSearchIndexModel 一条消息在全文检索索引中的记录，按会话存储分词结果。
+type MsgSearchIndexModel struct {
+	ConversationID string   `bson:"conversation_id"`
+	Seq            int64    `bson:"seq"`
+	SendID         string   `bson:"send_id"`
+	SendTime       int64    `bson:"send_time"`
+	Tokens         []string `bson:"tokens"` // 消息文本的分词，查询时要求包含全部关键词分词
+}
+
+// MsgSearchIndexInterface 消息全文检索索引的存储接口。
+type MsgSearchIndexInterface interface {
+	// Upsert 写入或覆盖消息的索引记录
+	Upsert(ctx context.Context, indexes []*MsgSearchIndexModel) error
+	// Delete 删除会话中指定消息的索引记录
+	Delete(ctx context.Context, conversationID string, seqs []int64) error
+	// DeleteBefore 删除会话中seq小于minSeq的索引记录
+	DeleteBefore(ctx context.Context, conversationID string, minSeq int64) error
+	// Search 在会话中查询包含全部tokens的消息，minSeqs为会话ID到可见最小seq的映射，按发送时间倒序分页
+	Search(ctx context.Context, minSeqs map[string]int64, tokens []string, pagination pagination.Pagination) (int64, []*MsgSearchIndexModel, error)
+}
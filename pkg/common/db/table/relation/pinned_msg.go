@@ -0,0 +1,44 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+)
+
+// PinnedMsgModel 会话中置顶的一条消息
+type PinnedMsgModel struct {
+	Seq         int64     `bson:"seq"`
+	ClientMsgID string    `bson:"client_msg_id"`
+	PinUserID   string    `bson:"pin_user_id"`
+	PinTime     time.Time `bson:"pin_time"`
+}
+
+// ConversationPinsModel 一个会话的置顶消息列表，按置顶时间排序
+type ConversationPinsModel struct {
+	ConversationID string            `bson:"conversation_id"`
+	Pins           []*PinnedMsgModel `bson:"pins"`
+}
+
+// PinnedMsgInterface 置顶消息的存储接口。
+type PinnedMsgInterface interface {
+	// Pin 置顶消息，消息已置顶时返回false，置顶数已达limit时返回mongo.ErrNoDocuments
+	Pin(ctx context.Context, conversationID string, pin *PinnedMsgModel, limit int) (bool, error)
+	// Unpin 取消置顶，消息未置顶时返回mongo.ErrNoDocuments
+	Unpin(ctx context.Context, conversationID string, seq int64) error
+	// Find 返回会话的置顶消息，没有时返回空
+	Find(ctx context.Context, conversationID string) ([]*PinnedMsgModel, error)
+}
@@ -0,0 +1,34 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import "time"
+
+// 消息保留方式
+const (
+	RetentionInherit = 0 // 未设置，沿用上一级策略
+	RetentionForever = 1 // 永久保留
+	RetentionDays    = 2 // 保留最近Days天的消息
+	RetentionCount   = 3 // 保留最近Count条消息
+)
+
+// RetentionModel 消息保留策略，存储在会话或群组上
+type RetentionModel struct {
+	Mode           int32     `bson:"mode"`
+	Days           int32     `bson:"days"`
+	Count          int64     `bson:"count"`
+	OperatorUserID string    `bson:"operator_user_id"`
+	UpdateTime     time.Time `bson:"update_time"`
+}
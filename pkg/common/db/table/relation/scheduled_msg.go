@@ -0,0 +1,71 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+
+	"github.com/Meikwei/go-tools/db/pagination"
+)
+
+// 定时消息状态
+const (
+	ScheduledMsgPending     = 1 // 等待发送
+	ScheduledMsgDispatching = 2 // 已被crontask实例领取，正在发送
+	ScheduledMsgSent        = 3 // 已发送，调用SendMsg前置为该状态，发送失败时改回等待发送或发送失败
+	ScheduledMsgCanceled    = 4 // 发送者取消
+	ScheduledMsgFailed      = 5 // 发送时校验失败或多次重试失败
+)
+
+// ScheduledMsgModel 一条定时发送的消息，到期后由crontask交给SendMsg发送。
+type ScheduledMsgModel struct {
+	ScheduleID   string    `bson:"schedule_id"`
+	SendID       string    `bson:"send_id"`
+	RecvID       string    `bson:"recv_id"`
+	GroupID      string    `bson:"group_id"`
+	SessionType  int32     `bson:"session_type"`
+	ClientMsgID  string    `bson:"client_msg_id"`
+	MsgData      []byte    `bson:"msg_data"` // proto编码的sdkws.MsgData
+	SendTime     time.Time `bson:"send_time"`
+	Status       int32     `bson:"status"`
+	Owner        string    `bson:"owner"`       // 领取该消息的crontask实例
+	LeaseUntil   time.Time `bson:"lease_until"` // 领取的有效期，过期后可被重新领取
+	Attempts     int32     `bson:"attempts"`
+	Error        string    `bson:"error"`
+	CreateTime   time.Time `bson:"create_time"`
+	DispatchTime time.Time `bson:"dispatch_time"`
+}
+
+// ScheduledMsgInterface 定时消息的存储接口。
+// 状态变更都是带前置条件的原子更新，前置条件不满足时返回mongo.ErrNoDocuments。
+type ScheduledMsgInterface interface {
+	Create(ctx context.Context, msg *ScheduledMsgModel) error
+	Take(ctx context.Context, scheduleID string) (*ScheduledMsgModel, error)
+	FindBySendID(ctx context.Context, sendID string, status []int32, pagination pagination.Pagination) (int64, []*ScheduledMsgModel, error)
+	// Cancel 取消等待发送的消息
+	Cancel(ctx context.Context, scheduleID string) error
+	// Reschedule 修改等待发送的消息的发送时间
+	Reschedule(ctx context.Context, scheduleID string, sendTime time.Time) error
+	// Claim 领取一条到期的消息，或领取有效期已过仍未完成的消息，返回领取后的记录
+	Claim(ctx context.Context, now time.Time, owner string, lease time.Duration) (*ScheduledMsgModel, error)
+	// MarkSent 在发送前将owner领取且领取仍在有效期内的消息置为已发送，
+	// 此后领取过期也不会被其他实例重新领取，保证消息只被一个实例发送
+	MarkSent(ctx context.Context, scheduleID string, owner string, now time.Time) error
+	// Release 将owner置为已发送但发送失败的消息放回等待发送状态，用于稍后重试
+	Release(ctx context.Context, scheduleID string, owner string, errMsg string) error
+	// Finish 将owner置为已发送但发送失败的消息置为发送失败
+	Finish(ctx context.Context, scheduleID string, owner string, status int32, errMsg string) error
+}
@@ -0,0 +1,33 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+)
+
+// SensitiveWordModel 敏感词及其处理方式。
+type SensitiveWordModel struct {
+	Word       string    `bson:"word"`
+	Policy     string    `bson:"policy"` // 处理方式：reject、mask 或 senderOnly，为空时使用配置的默认处理方式
+	CreateTime time.Time `bson:"create_time"`
+}
+
+// SensitiveWordInterface 敏感词的存储接口。
+type SensitiveWordInterface interface {
+	// FindAll 返回全部敏感词
+	FindAll(ctx context.Context) ([]*SensitiveWordModel, error)
+}
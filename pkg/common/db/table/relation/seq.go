@@ -0,0 +1,35 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import "context"
+
+// SeqModel 会话seq的持久化记录，redis中的seq只是它的缓存
+type SeqModel struct {
+	ConversationID string `bson:"conversation_id"`
+	MaxSeq         int64  `bson:"max_seq"` // 已经租出的seq上限，不超过它的seq都不会再分配
+	MinSeq         int64  `bson:"min_seq"`
+}
+
+// SeqInterface 会话seq的存储接口。
+type SeqInterface interface {
+	// Malloc 将会话的seq上限提高到至少floor后再租出size个seq，返回租出前的上限，租出的seq为(返回值, 返回值+size]
+	Malloc(ctx context.Context, conversationID string, size int64, floor int64) (int64, error)
+	// GetMaxSeqs 返回会话已租出的seq上限，没有记录的会话不在结果中
+	GetMaxSeqs(ctx context.Context, conversationIDs []string) (map[string]int64, error)
+	SetMinSeq(ctx context.Context, conversationID string, minSeq int64) error
+	// GetMinSeqs 返回会话的最小seq，没有记录的会话不在结果中
+	GetMinSeqs(ctx context.Context, conversationIDs []string) (map[string]int64, error)
+}
@@ -0,0 +1,117 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+
+	"github.com/Meikwei/go-tools/db/pagination"
+)
+
+// 通话房间状态
+const (
+	SignalStatusInviting = 1 // 振铃中，尚无被邀请者接听
+	SignalStatusOngoing  = 2 // 通话中
+	SignalStatusCanceled = 3 // 邀请者取消
+	SignalStatusRejected = 4 // 所有被邀请者拒绝
+	SignalStatusTimeout  = 5 // 振铃超时无人接听
+	SignalStatusEnded    = 6 // 通话正常结束
+)
+
+// 通话成员状态
+const (
+	SignalMemberRinging  = 1 // 振铃中
+	SignalMemberAccepted = 2 // 已接听
+	SignalMemberRejected = 3 // 已拒绝
+	SignalMemberTimeout  = 4 // 振铃超时
+	SignalMemberCanceled = 5 // 邀请被取消
+	SignalMemberHungUp   = 6 // 已挂断
+)
+
+type SignalMemberModel struct {
+	UserID     string    `bson:"user_id"`
+	Status     int32     `bson:"status"`
+	HandleTime time.Time `bson:"handle_time"`
+}
+
+// SignalModel 一次音视频通话邀请及其通话记录，邀请者同样作为成员保存在Members中。
+type SignalModel struct {
+	RoomID        string               `bson:"room_id"`
+	InviterUserID string               `bson:"inviter_user_id"`
+	GroupID       string               `bson:"group_id"`
+	SessionType   int32                `bson:"session_type"`
+	MediaType     string               `bson:"media_type"`
+	PlatformID    int32                `bson:"platform_id"`
+	CustomData    string               `bson:"custom_data"`
+	Timeout       int32                `bson:"timeout"`
+	PushTitle     string               `bson:"push_title"`
+	PushDesc      string               `bson:"push_desc"`
+	PushEx        string               `bson:"push_ex"`
+	Members       []*SignalMemberModel `bson:"members"`
+	Status        int32                `bson:"status"`
+	InitiateTime  time.Time            `bson:"initiate_time"`
+	TimeoutTime   time.Time            `bson:"timeout_time"`
+	EndTime       time.Time            `bson:"end_time"`
+}
+
+// InviteeUserIDs 返回除邀请者外的所有成员。
+func (s *SignalModel) InviteeUserIDs() []string {
+	userIDs := make([]string, 0, len(s.Members))
+	for _, member := range s.Members {
+		if member.UserID != s.InviterUserID {
+			userIDs = append(userIDs, member.UserID)
+		}
+	}
+	return userIDs
+}
+
+// MemberUserIDs 返回状态属于status的成员。
+func (s *SignalModel) MemberUserIDs(status ...int32) []string {
+	userIDs := make([]string, 0, len(s.Members))
+	for _, member := range s.Members {
+		for _, v := range status {
+			if member.Status == v {
+				userIDs = append(userIDs, member.UserID)
+				break
+			}
+		}
+	}
+	return userIDs
+}
+
+// SignalInterface 通话记录的存储接口。
+// 所有状态变更都是带前置条件的原子更新，前置条件不满足时返回mongo.ErrNoDocuments，
+// 成功时返回更新前的记录，便于调用方确定本次变更影响了哪些成员。
+type SignalInterface interface {
+	Create(ctx context.Context, signal *SignalModel) error
+	Take(ctx context.Context, roomID string) (*SignalModel, error)
+	Find(ctx context.Context, roomIDs []string) ([]*SignalModel, error)
+	// TakeActiveByGroupID 获取群内振铃中或通话中的房间
+	TakeActiveByGroupID(ctx context.Context, groupID string) (*SignalModel, error)
+	// FindRinging 获取用户正在振铃的邀请
+	FindRinging(ctx context.Context, userID string) ([]*SignalModel, error)
+	// FindBusyUserIDs 返回userIDs中正在振铃或通话中的用户
+	FindBusyUserIDs(ctx context.Context, userIDs []string) ([]string, error)
+	// UpdateMember 将成员从from状态切换为status，房间处于roomFrom状态时同时切换为roomStatus
+	UpdateMember(ctx context.Context, roomID string, userID string, from []int32, status int32, roomFrom []int32, roomStatus int32) (*SignalModel, error)
+	// Finish 当房间处于from状态且活跃成员不超过maxActive（小于0表示不限制）时结束房间，
+	// 振铃中的成员置为ringingStatus，已接听的成员置为挂断
+	Finish(ctx context.Context, roomID string, from []int32, maxActive int, status int32, ringingStatus int32) (*SignalModel, error)
+	// TimeoutOne 将一个振铃超时房间中仍在振铃的成员置为超时
+	TimeoutOne(ctx context.Context, deadline time.Time) (*SignalModel, error)
+	Search(ctx context.Context, sessionType int32, sendID string, recvID string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*SignalModel, error)
+	Delete(ctx context.Context, roomIDs []string) error
+}
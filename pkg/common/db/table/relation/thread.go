@@ -0,0 +1,46 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+)
+
+// ThreadModel 消息的回复线程。线程消息以ThreadID作为会话ID存储，拥有独立的seq。
+type ThreadModel struct {
+	ThreadID        string    `bson:"thread_id"`
+	ConversationID  string    `bson:"conversation_id"`    // 根消息所在的会话
+	RootSeq         int64     `bson:"root_seq"`           // 根消息的seq
+	RootClientMsgID string    `bson:"root_client_msg_id"` // 根消息的客户端消息ID
+	SessionType     int32     `bson:"session_type"`       // 根消息的会话类型
+	GroupID         string    `bson:"group_id"`
+	CreatorUserID   string    `bson:"creator_user_id"`
+	ReplyCount      int64     `bson:"reply_count"`
+	LastReplySeq    int64     `bson:"last_reply_seq"`
+	LastReplyUserID string    `bson:"last_reply_user_id"`
+	LastReplyTime   time.Time `bson:"last_reply_time"`
+	CreateTime      time.Time `bson:"create_time"`
+}
+
+// ThreadInterface 回复线程的存储接口。
+type ThreadInterface interface {
+	// Create 创建线程，线程已存在时返回重复键错误
+	Create(ctx context.Context, thread *ThreadModel) error
+	Take(ctx context.Context, threadID string) (*ThreadModel, error)
+	Find(ctx context.Context, threadIDs []string) ([]*ThreadModel, error)
+	// IncrReply 增加回复数并记录最后一条回复，返回更新后的线程
+	IncrReply(ctx context.Context, threadID string, seq int64, userID string, replyTime time.Time) (*ThreadModel, error)
+}
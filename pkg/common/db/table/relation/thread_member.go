@@ -0,0 +1,38 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+
+	"github.com/Meikwei/go-tools/db/pagination"
+)
+
+// ThreadMemberModel 线程的参与者，创建、回复或手动关注线程的用户。
+type ThreadMemberModel struct {
+	ThreadID   string    `bson:"thread_id"`
+	UserID     string    `bson:"user_id"`
+	Subscribed bool      `bson:"subscribed"`  // 是否关注，只有关注的线程会出现在用户的线程列表中
+	UpdateTime time.Time `bson:"update_time"` // 最后一次参与的时间
+}
+
+// ThreadMemberInterface 线程参与者的存储接口。
+type ThreadMemberInterface interface {
+	// Subscribe 设置用户是否关注线程，记录不存在时创建
+	Subscribe(ctx context.Context, threadID string, userID string, subscribed bool, updateTime time.Time) error
+	// FindSubscribed 分页查询用户关注的线程，按最后参与时间倒序
+	FindSubscribed(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*ThreadMemberModel, error)
+}
@@ -34,6 +34,13 @@ func (u UserModel) GetEx() string {
 	return u.Ex
 }
 
+// UserFilter 按用户属性筛选用户，零值的字段不参与筛选。
+type UserFilter struct {
+	CreateTimeBegin time.Time // 注册时间下限（含）
+	CreateTimeEnd   time.Time // 注册时间上限（不含）
+	AppMangerLevels []int32
+}
+
 type UserModelInterface interface {
 	Create(ctx context.Context, users []*UserModel) (err error)
 	UpdateByMap(ctx context.Context, userID string, args map[string]any) (err error)
@@ -47,6 +54,10 @@ type UserModelInterface interface {
 	Exist(ctx context.Context, userID string) (exist bool, err error)
 	GetAllUserID(ctx context.Context, pagination pagination.Pagination) (count int64, userIDs []string, err error)
 	GetUserGlobalRecvMsgOpt(ctx context.Context, userID string) (opt int, err error)
+	// FindUserIDsAfter 按user_id升序返回大于afterUserID的至多limit个用户，filter为nil表示全部用户
+	FindUserIDsAfter(ctx context.Context, filter *UserFilter, afterUserID string, limit int64) (userIDs []string, err error)
+	// CountUsers 统计满足filter的用户数，filter为nil表示全部用户
+	CountUsers(ctx context.Context, filter *UserFilter) (count int64, err error)
 	// Get user total quantity
 	CountTotal(ctx context.Context, before *time.Time) (count int64, err error)
 	// Get user total quantity every day
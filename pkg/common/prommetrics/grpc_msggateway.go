@@ -23,4 +23,20 @@ var (
 		Name: "online_user_num",
 		Help: "The number of online user num",
 	})
+	MsgGatewayRateLimitedCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "msg_gateway_rate_limited_total",
+		Help: "The number of requests rejected by the gateway rate limits, by request and escalation",
+	}, []string{"req", "action"})
+	MsgGatewayWriteQueueGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "msg_gateway_write_queue_depth",
+		Help: "The number of pushes waiting in the write queues of all connections",
+	})
+	MsgGatewayWriteQueueOverflowCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "msg_gateway_write_queue_overflow_total",
+		Help: "The number of pushes that found the write queue full, by overflow policy",
+	}, []string{"policy"})
+	MsgGatewaySlowConsumerEvictedCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "msg_gateway_slow_consumer_evicted_total",
+		Help: "The number of connections closed because their write queue overflowed",
+	})
 )
@@ -34,7 +34,8 @@ func NewGrpcPromObj(cusMetrics []prometheus.Collector) (*prometheus.Registry, *g
 func GetGrpcCusMetrics(registerName string, share *config2.Share) []prometheus.Collector {
 	switch registerName {
 	case share.RpcRegisterName.MessageGateway:
-		return []prometheus.Collector{OnlineUserGauge}
+		return []prometheus.Collector{OnlineUserGauge, MsgGatewayRateLimitedCounter, MsgGatewayWriteQueueGauge,
+			MsgGatewayWriteQueueOverflowCounter, MsgGatewaySlowConsumerEvictedCounter}
 	case share.RpcRegisterName.Msg:
 		return []prometheus.Collector{SingleChatMsgProcessSuccessCounter, SingleChatMsgProcessFailedCounter, GroupChatMsgProcessSuccessCounter, GroupChatMsgProcessFailedCounter}
 	case "Transfer":
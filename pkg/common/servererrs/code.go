@@ -74,6 +74,11 @@ const (
 	MutedInGroup          = 1402 // Member muted in the group
 	MutedGroup            = 1403 // Group is muted
 	MsgAlreadyRevoke      = 1404 // Message already revoked
+	MsgEditTimeout        = 1405 // Message can no longer be edited
+	MsgNotEditable        = 1406 // Message type does not support editing
+	MsgPinLimit           = 1407 // Too many pinned messages in the conversation
+	MsgSensitiveWord      = 1408 // Message contains sensitive words
+	MsgSending            = 1409 // The same client message is still being sent
 
 	// Token error codes.
 	TokenExpiredError     = 1501
@@ -89,7 +94,15 @@ const (
 	ConnArgsErr          = 1602
 	PushMsgErr           = 1603
 	IOSBackgroundPushErr = 1604
+	ConnRateLimited      = 1605 // Too many requests on the connection
+	ConnMuted            = 1606 // Connection is muted after repeated rate limit violations
+	ConnDraining         = 1607 // Gateway node is draining and does not accept connections
 
 	// S3 error codes.
 	FileUploadedExpiredError = 1701 // Upload expired
+
+	// Signaling error codes.
+	SignalBusyLine      = 1801 // User is already in a call
+	SignalRoomExisted   = 1802 // Group already has a call in progress
+	SignalStateMismatch = 1803 // Call is not in a state that allows the operation
 )
@@ -57,12 +57,24 @@ var (
 	ErrMutedInGroup     = errs.NewCodeError(MutedInGroup, "MutedInGroup")
 	ErrMutedGroup       = errs.NewCodeError(MutedGroup, "MutedGroup")
 	ErrMsgAlreadyRevoke = errs.NewCodeError(MsgAlreadyRevoke, "MsgAlreadyRevoke")
+	ErrMsgEditTimeout   = errs.NewCodeError(MsgEditTimeout, "MsgEditTimeout")
+	ErrMsgNotEditable   = errs.NewCodeError(MsgNotEditable, "MsgNotEditable")
+	ErrMsgPinLimit      = errs.NewCodeError(MsgPinLimit, "MsgPinLimit")
+	ErrMsgSensitiveWord = errs.NewCodeError(MsgSensitiveWord, "MsgSensitiveWord")
+	ErrMsgSending       = errs.NewCodeError(MsgSending, "MsgSending")
 
 	ErrConnOverMaxNumLimit = errs.NewCodeError(ConnOverMaxNumLimit, "ConnOverMaxNumLimit")
 
 	ErrConnArgsErr          = errs.NewCodeError(ConnArgsErr, "args err, need token, sendID, platformID")
 	ErrPushMsgErr           = errs.NewCodeError(PushMsgErr, "push msg err")
 	ErrIOSBackgroundPushErr = errs.NewCodeError(IOSBackgroundPushErr, "ios background push err")
+	ErrConnRateLimited      = errs.NewCodeError(ConnRateLimited, "ConnRateLimited")
+	ErrConnMuted            = errs.NewCodeError(ConnMuted, "ConnMuted")
+	ErrConnDraining         = errs.NewCodeError(ConnDraining, "ConnDraining")
 
 	ErrFileUploadedExpired = errs.NewCodeError(FileUploadedExpiredError, "FileUploadedExpiredError")
+
+	ErrSignalBusyLine      = errs.NewCodeError(SignalBusyLine, "SignalBusyLine")
+	ErrSignalRoomExisted   = errs.NewCodeError(SignalRoomExisted, "SignalRoomExisted")
+	ErrSignalStateMismatch = errs.NewCodeError(SignalStateMismatch, "SignalStateMismatch")
 )
@@ -0,0 +1,85 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgprocessor
+
+import (
+	"encoding/json"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/Meikwei/protocol/constant"
+)
+
+// objectPathPrefix is the api route that redirects to the object storage, see ThirdApi.ObjectRedirect.
+const objectPathPrefix = "/object/"
+
+// MediaURLs returns the distinct URLs referenced by the content of a media message (picture, voice, video, file),
+// i.e. the string values of any key ending with "url", sorted. Other content types return nil.
+func MediaURLs(contentType int32, content []byte) []string {
+	switch contentType {
+	case constant.Picture, constant.Voice, constant.Video, constant.File:
+	default:
+		return nil
+	}
+	var elem any
+	if err := json.Unmarshal(content, &elem); err != nil {
+		return nil
+	}
+	set := make(map[string]struct{})
+	collectURLs(elem, set)
+	if len(set) == 0 {
+		return nil
+	}
+	urls := make([]string, 0, len(set))
+	for u := range set {
+		urls = append(urls, u)
+	}
+	sort.Strings(urls)
+	return urls
+}
+
+func collectURLs(elem any, set map[string]struct{}) {
+	switch v := elem.(type) {
+	case map[string]any:
+		for key, val := range v {
+			if s, ok := val.(string); ok {
+				if s != "" && strings.HasSuffix(strings.ToLower(key), "url") {
+					set[s] = struct{}{}
+				}
+				continue
+			}
+			collectURLs(val, set)
+		}
+	case []any:
+		for _, val := range v {
+			collectURLs(val, set)
+		}
+	}
+}
+
+// ObjectNameFromURL returns the object name of a URL served by the api object redirect, which can be
+// signed through the third service's AccessURL.
+func ObjectNameFromURL(rawURL string) (string, bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", false
+	}
+	_, name, ok := strings.Cut(u.Path, objectPathPrefix)
+	if !ok || name == "" {
+		return "", false
+	}
+	return name, true
+}
@@ -0,0 +1,53 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgprocessor
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Meikwei/protocol/constant"
+)
+
+func TestMediaURLs(t *testing.T) {
+	content := []byte(`{"sourcePath":"/a.png","sourcePicture":{"url":"http://api/object/u1/a.png","width":1},` +
+		`"bigPicture":{"url":"http://api/object/u1/a.png"},"snapshotPicture":{"url":"http://cdn/b.png"}}`)
+	want := []string{"http://api/object/u1/a.png", "http://cdn/b.png"}
+	if got := MediaURLs(constant.Picture, content); !reflect.DeepEqual(got, want) {
+		t.Errorf("MediaURLs() = %v, want %v", got, want)
+	}
+	if got := MediaURLs(constant.Text, []byte(`{"content":"http://x"}`)); got != nil {
+		t.Errorf("MediaURLs(text) = %v, want nil", got)
+	}
+}
+
+func TestObjectNameFromURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		name string
+		ok   bool
+	}{
+		{"http://127.0.0.1:10002/object/u1/msg_picture_1.png", "u1/msg_picture_1.png", true},
+		{"https://im.example.com/api/object/u1/a%20b.txt?x=1", "u1/a b.txt", true},
+		{"https://cdn.example.com/u1/a.png", "", false},
+		{"http://127.0.0.1:10002/object/", "", false},
+	}
+	for _, tt := range tests {
+		name, ok := ObjectNameFromURL(tt.url)
+		if name != tt.name || ok != tt.ok {
+			t.Errorf("ObjectNameFromURL(%q) = %q, %v, want %q, %v", tt.url, name, ok, tt.name, tt.ok)
+		}
+	}
+}
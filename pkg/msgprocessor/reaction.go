@@ -0,0 +1,81 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgprocessor
+
+import "encoding/json"
+
+// ReactionSummaryKey is the key of the reaction summary in the JSON object of MsgData.AttachedInfo.
+const ReactionSummaryKey = "reactionSummary"
+
+// ReactionCount is the number of reactions with one emoji.
+type ReactionCount struct {
+	Emoji string `json:"emoji"`
+	Count int64  `json:"count"`
+}
+
+// CountReactions counts emojis, one per reaction, in the order each emoji first appears.
+func CountReactions(emojis []string) []*ReactionCount {
+	counts := make([]*ReactionCount, 0, len(emojis))
+	index := make(map[string]int, len(emojis))
+	for _, emoji := range emojis {
+		if i, ok := index[emoji]; ok {
+			counts[i].Count++
+			continue
+		}
+		index[emoji] = len(counts)
+		counts = append(counts, &ReactionCount{Emoji: emoji, Count: 1})
+	}
+	return counts
+}
+
+// SetReactionSummary returns attachedInfo with the counts of emojis set under ReactionSummaryKey,
+// or with the key removed when emojis is empty. An attachedInfo that is not a JSON object is
+// returned unchanged.
+func SetReactionSummary(attachedInfo string, emojis []string) string {
+	if len(emojis) == 0 {
+		return setAttachedInfoField(attachedInfo, ReactionSummaryKey, nil)
+	}
+	return setAttachedInfoField(attachedInfo, ReactionSummaryKey, CountReactions(emojis))
+}
+
+// setAttachedInfoField sets key to value in the JSON object of attachedInfo, a nil value removes the key.
+func setAttachedInfoField(attachedInfo string, key string, value any) string {
+	fields := make(map[string]json.RawMessage)
+	if attachedInfo != "" {
+		if err := json.Unmarshal([]byte(attachedInfo), &fields); err != nil || fields == nil {
+			return attachedInfo
+		}
+	}
+	if value == nil {
+		if _, ok := fields[key]; !ok {
+			return attachedInfo
+		}
+		delete(fields, key)
+	} else {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return attachedInfo
+		}
+		fields[key] = data
+	}
+	if len(fields) == 0 {
+		return ""
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return attachedInfo
+	}
+	return string(data)
+}
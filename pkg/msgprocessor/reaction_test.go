@@ -0,0 +1,40 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgprocessor
+
+import "testing"
+
+func TestSetReactionSummary(t *testing.T) {
+	tests := []struct {
+		name         string
+		attachedInfo string
+		emojis       []string
+		want         string
+	}{
+		{"empty", "", nil, ""},
+		{"count in first appearance order", "", []string{"b", "a", "b"}, `{"reactionSummary":[{"emoji":"b","count":2},{"emoji":"a","count":1}]}`},
+		{"keep other fields", `{"isPrivateChat":true}`, []string{"a"}, `{"isPrivateChat":true,"reactionSummary":[{"emoji":"a","count":1}]}`},
+		{"remove summary", `{"isPrivateChat":true,"reactionSummary":[{"emoji":"a","count":1}]}`, nil, `{"isPrivateChat":true}`},
+		{"remove last field", `{"reactionSummary":[{"emoji":"a","count":1}]}`, nil, ""},
+		{"not an object", "plain text", []string{"a"}, "plain text"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SetReactionSummary(tt.attachedInfo, tt.emojis); got != tt.want {
+				t.Errorf("SetReactionSummary() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
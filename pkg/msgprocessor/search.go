@@ -0,0 +1,206 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgprocessor
+
+import (
+	"encoding/json"
+	"sort"
+	"unicode"
+
+	"github.com/Meikwei/protocol/constant"
+)
+
+const (
+	// maxTokenRunes truncates long words so that a pasted blob does not produce huge index keys.
+	maxTokenRunes = 32
+	// MaxMsgTokens is the maximum number of distinct tokens indexed for one message.
+	MaxMsgTokens = 512
+	// MaxQueryTokens is the maximum number of distinct tokens of a search keyword.
+	MaxQueryTokens = 16
+)
+
+// Highlight is a matched range of the searchable text, in runes, End exclusive.
+type Highlight struct {
+	Start int
+	End   int
+}
+
+// SearchableText returns the text of a message that is full-text indexed, or "" if the content type is not searchable.
+func SearchableText(contentType int32, content []byte) string {
+	switch contentType {
+	case constant.Text, constant.AtText, constant.Quote, constant.AdvancedText:
+	default:
+		return ""
+	}
+	var elem struct {
+		Content string `json:"content"`
+		Text    string `json:"text"`
+	}
+	if err := json.Unmarshal(content, &elem); err != nil {
+		return ""
+	}
+	if elem.Content != "" {
+		return elem.Content
+	}
+	return elem.Text
+}
+
+// IndexTokens splits text into the tokens stored in the search index. Words of alphabetic scripts are
+// kept whole, CJK text, which has no word separators, is indexed both as single characters and as
+// overlapping bigrams so that any query of one or more characters can be matched.
+func IndexTokens(text string) []string {
+	return tokenize(text, true, MaxMsgTokens)
+}
+
+// QueryTokens splits a search keyword into tokens that must all be present in a matching message.
+// CJK runs longer than one character are queried by their bigrams only.
+func QueryTokens(keyword string) []string {
+	return tokenize(keyword, false, MaxQueryTokens)
+}
+
+// SearchHighlights returns the ranges of text matching the terms of keyword, sorted and merged.
+func SearchHighlights(text string, keyword string) []Highlight {
+	textRunes := lowerRunes(text)
+	var highlights []Highlight
+	for _, term := range splitTerms(lowerRunes(keyword)) {
+		for i := 0; i+len(term.runes) <= len(textRunes); i++ {
+			if equalRunes(textRunes[i:i+len(term.runes)], term.runes) {
+				highlights = append(highlights, Highlight{Start: i, End: i + len(term.runes)})
+			}
+		}
+	}
+	if len(highlights) == 0 {
+		return nil
+	}
+	sort.Slice(highlights, func(i, j int) bool {
+		return highlights[i].Start < highlights[j].Start
+	})
+	merged := highlights[:1]
+	for _, h := range highlights[1:] {
+		last := &merged[len(merged)-1]
+		if h.Start <= last.End {
+			if h.End > last.End {
+				last.End = h.End
+			}
+			continue
+		}
+		merged = append(merged, h)
+	}
+	return merged
+}
+
+type term struct {
+	runes []rune
+	cjk   bool
+}
+
+func tokenize(text string, index bool, limit int) []string {
+	var tokens []string
+	seen := make(map[string]struct{})
+	add := func(rs []rune) bool {
+		if len(rs) > maxTokenRunes {
+			rs = rs[:maxTokenRunes]
+		}
+		token := string(rs)
+		if _, ok := seen[token]; ok {
+			return true
+		}
+		seen[token] = struct{}{}
+		tokens = append(tokens, token)
+		return len(tokens) < limit
+	}
+	for _, t := range splitTerms(lowerRunes(text)) {
+		if !t.cjk {
+			if !add(t.runes) {
+				return tokens
+			}
+			continue
+		}
+		if index || len(t.runes) == 1 {
+			for i := range t.runes {
+				if !add(t.runes[i : i+1]) {
+					return tokens
+				}
+			}
+		}
+		for i := 0; i+1 < len(t.runes); i++ {
+			if !add(t.runes[i : i+2]) {
+				return tokens
+			}
+		}
+	}
+	return tokens
+}
+
+// splitTerms splits lowercased runes into runs of word characters and runs of CJK characters.
+func splitTerms(rs []rune) []term {
+	var terms []term
+	start := -1
+	var cjk bool
+	for i, r := range rs {
+		switch {
+		case isCJK(r):
+			if start >= 0 && !cjk {
+				terms = append(terms, term{runes: rs[start:i]})
+				start = -1
+			}
+			if start < 0 {
+				start, cjk = i, true
+			}
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if start >= 0 && cjk {
+				terms = append(terms, term{runes: rs[start:i], cjk: true})
+				start = -1
+			}
+			if start < 0 {
+				start, cjk = i, false
+			}
+		default:
+			if start >= 0 {
+				terms = append(terms, term{runes: rs[start:i], cjk: cjk})
+				start = -1
+			}
+		}
+	}
+	if start >= 0 {
+		terms = append(terms, term{runes: rs[start:], cjk: cjk})
+	}
+	return terms
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// lowerRunes lowercases rune by rune so that offsets in the result are offsets in the original text.
+func lowerRunes(s string) []rune {
+	rs := []rune(s)
+	for i, r := range rs {
+		rs[i] = unicode.ToLower(r)
+	}
+	return rs
+}
+
+func equalRunes(a, b []rune) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
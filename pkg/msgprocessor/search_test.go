@@ -0,0 +1,65 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgprocessor
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIndexTokens(t *testing.T) {
+	got := IndexTokens("Hello, 世界和平! hello go2")
+	want := []string{"hello", "世", "界", "和", "平", "世界", "界和", "和平", "go2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("IndexTokens() = %v, want %v", got, want)
+	}
+}
+
+func TestQueryTokens(t *testing.T) {
+	tests := []struct {
+		keyword string
+		want    []string
+	}{
+		{"世界", []string{"世界"}},
+		{"和", []string{"和"}},
+		{"HELLO 和平", []string{"hello", "和平"}},
+		{" ,. ", nil},
+	}
+	for _, tt := range tests {
+		if got := QueryTokens(tt.keyword); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("QueryTokens(%q) = %v, want %v", tt.keyword, got, tt.want)
+		}
+	}
+}
+
+func TestSearchHighlights(t *testing.T) {
+	got := SearchHighlights("Go 世界和平 GO", "go 界和平")
+	want := []Highlight{{Start: 0, End: 2}, {Start: 4, End: 7}, {Start: 8, End: 10}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SearchHighlights() = %v, want %v", got, want)
+	}
+}
+
+func TestSearchableText(t *testing.T) {
+	if got := SearchableText(101, []byte(`{"content":"hi"}`)); got != "hi" {
+		t.Errorf("SearchableText(text) = %q", got)
+	}
+	if got := SearchableText(106, []byte(`{"text":"@a hi"}`)); got != "@a hi" {
+		t.Errorf("SearchableText(atText) = %q", got)
+	}
+	if got := SearchableText(102, []byte(`{"content":"x"}`)); got != "" {
+		t.Errorf("SearchableText(picture) = %q", got)
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgprocessor
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// SelfDestructKey is the key of the self-destruct timer in the JSON object of MsgData.AttachedInfo.
+const SelfDestructKey = "selfDestruct"
+
+// SelfDestruct is the self-destruct timer the sender sets on a message.
+type SelfDestruct struct {
+	// TTL is the number of seconds the message lives.
+	TTL int64 `json:"ttl"`
+	// StartOnRead starts the TTL of each receiver at its first read of the message instead of at send time.
+	StartOnRead bool `json:"startOnRead"`
+	// ExpireTime is set by the server in milliseconds: when the message is removed for everyone, which for
+	// StartOnRead is when a message still left unread is removed.
+	ExpireTime int64 `json:"expireTime,omitempty"`
+}
+
+// GetSelfDestruct returns the self-destruct timer in attachedInfo, or nil if the message has none.
+// An attachedInfo that is not a JSON object has no timer.
+func GetSelfDestruct(attachedInfo string) (*SelfDestruct, error) {
+	if !strings.Contains(attachedInfo, SelfDestructKey) {
+		return nil, nil
+	}
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal([]byte(attachedInfo), &fields); err != nil {
+		return nil, nil
+	}
+	data, ok := fields[SelfDestructKey]
+	if !ok || string(data) == "null" {
+		return nil, nil
+	}
+	var selfDestruct SelfDestruct
+	if err := json.Unmarshal(data, &selfDestruct); err != nil {
+		return nil, err
+	}
+	return &selfDestruct, nil
+}
+
+// SetSelfDestruct returns attachedInfo with selfDestruct set under SelfDestructKey. An attachedInfo
+// that is not a JSON object is returned unchanged.
+func SetSelfDestruct(attachedInfo string, selfDestruct *SelfDestruct) string {
+	if selfDestruct == nil {
+		return setAttachedInfoField(attachedInfo, SelfDestructKey, nil)
+	}
+	return setAttachedInfoField(attachedInfo, SelfDestructKey, selfDestruct)
+}
@@ -0,0 +1,55 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgprocessor
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetSelfDestruct(t *testing.T) {
+	tests := []struct {
+		name         string
+		attachedInfo string
+		want         *SelfDestruct
+		wantErr      bool
+	}{
+		{"empty", "", nil, false},
+		{"not an object", "selfDestruct", nil, false},
+		{"other fields", `{"isPrivateChat":true}`, nil, false},
+		{"null", `{"selfDestruct":null}`, nil, false},
+		{"from send", `{"selfDestruct":{"ttl":30}}`, &SelfDestruct{TTL: 30}, false},
+		{"from read", `{"selfDestruct":{"ttl":30,"startOnRead":true,"expireTime":1000}}`, &SelfDestruct{TTL: 30, StartOnRead: true, ExpireTime: 1000}, false},
+		{"invalid", `{"selfDestruct":"30"}`, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetSelfDestruct(tt.attachedInfo)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetSelfDestruct() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetSelfDestruct() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetSelfDestruct(t *testing.T) {
+	got := SetSelfDestruct(`{"isPrivateChat":true,"selfDestruct":{"ttl":30}}`, &SelfDestruct{TTL: 30, ExpireTime: 31000})
+	if want := `{"isPrivateChat":true,"selfDestruct":{"ttl":30,"startOnRead":false,"expireTime":31000}}`; got != want {
+		t.Errorf("SetSelfDestruct() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgprocessor
+
+import (
+	"github.com/Meikwei/protocol/msg"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// sendMsgRespSeqNumber is the field number of the seq of msg.SendMsgResp. The generated message has no seq field,
+// so the seq is carried as an unknown field: it survives the msg rpc, and clients whose SendMsgResp declares
+// `int64 seq = 4` read it from the gateway response.
+const sendMsgRespSeqNumber protowire.Number = 4
+
+// SetSendMsgRespSeq sets the seq of the sent message in resp.
+func SetSendMsgRespSeq(resp *msg.SendMsgResp, seq int64) {
+	m := resp.ProtoReflect()
+	b := protowire.AppendTag(m.GetUnknown(), sendMsgRespSeqNumber, protowire.VarintType)
+	m.SetUnknown(protowire.AppendVarint(b, uint64(seq)))
+}
+
+// GetSendMsgRespSeq returns the seq set by SetSendMsgRespSeq, or 0 if resp has none.
+func GetSendMsgRespSeq(resp *msg.SendMsgResp) int64 {
+	var seq int64
+	b := resp.ProtoReflect().GetUnknown()
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return 0
+		}
+		b = b[n:]
+		if num == sendMsgRespSeqNumber && typ == protowire.VarintType {
+			v, n := protowire.ConsumeVarint(b)
+			if n < 0 {
+				return 0
+			}
+			seq = int64(v)
+			b = b[n:]
+			continue
+		}
+		n = protowire.ConsumeFieldValue(num, typ, b)
+		if n < 0 {
+			return 0
+		}
+		b = b[n:]
+	}
+	return seq
+}
@@ -0,0 +1,44 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgprocessor
+
+import (
+	"testing"
+
+	"github.com/Meikwei/protocol/msg"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSendMsgRespSeq(t *testing.T) {
+	resp := &msg.SendMsgResp{ServerMsgID: "server", ClientMsgID: "client", SendTime: 1}
+	if seq := GetSendMsgRespSeq(resp); seq != 0 {
+		t.Fatalf("GetSendMsgRespSeq() = %d, want 0", seq)
+	}
+	SetSendMsgRespSeq(resp, 42)
+	data, err := proto.Marshal(resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got msg.SendMsgResp
+	if err := proto.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.ServerMsgID != "server" || got.SendTime != 1 {
+		t.Fatalf("fields lost: %v", got.String())
+	}
+	if seq := GetSendMsgRespSeq(&got); seq != 42 {
+		t.Fatalf("GetSendMsgRespSeq() = %d, want 42", seq)
+	}
+}
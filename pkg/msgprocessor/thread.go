@@ -0,0 +1,44 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgprocessor
+
+import "strconv"
+
+// ThreadSummaryKey is the key of the thread summary in the JSON object of MsgData.AttachedInfo.
+const ThreadSummaryKey = "threadSummary"
+
+// ThreadSummary is the reply metadata of a thread carried by its root message.
+type ThreadSummary struct {
+	ThreadID        string `json:"threadID"`
+	ReplyCount      int64  `json:"replyCount"`
+	LastReplySeq    int64  `json:"lastReplySeq"`
+	LastReplyUserID string `json:"lastReplyUserID"`
+	LastReplyTime   int64  `json:"lastReplyTime"`
+}
+
+// GetThreadID returns the conversationID of the thread replying to the message seq of conversationID.
+// Messages of a thread are stored and numbered like those of a conversation with this ID.
+func GetThreadID(conversationID string, seq int64) string {
+	return "th_" + conversationID + "_" + strconv.FormatInt(seq, 10)
+}
+
+// SetThreadSummary returns attachedInfo with summary set under ThreadSummaryKey. An attachedInfo
+// that is not a JSON object is returned unchanged.
+func SetThreadSummary(attachedInfo string, summary *ThreadSummary) string {
+	if summary == nil {
+		return setAttachedInfoField(attachedInfo, ThreadSummaryKey, nil)
+	}
+	return setAttachedInfoField(attachedInfo, ThreadSummaryKey, summary)
+}
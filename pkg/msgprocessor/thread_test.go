@@ -0,0 +1,26 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgprocessor
+
+import "testing"
+
+func TestSetThreadSummary(t *testing.T) {
+	summary := &ThreadSummary{ThreadID: GetThreadID("sg_1", 7), ReplyCount: 2, LastReplySeq: 2, LastReplyUserID: "u1", LastReplyTime: 100}
+	got := SetReactionSummary(SetThreadSummary("", summary), []string{"a"})
+	want := `{"reactionSummary":[{"emoji":"a","count":1}],"threadSummary":{"threadID":"th_sg_1_7","replyCount":2,"lastReplySeq":2,"lastReplyUserID":"u1","lastReplyTime":100}}`
+	if got != want {
+		t.Errorf("SetThreadSummary() = %v, want %v", got, want)
+	}
+}
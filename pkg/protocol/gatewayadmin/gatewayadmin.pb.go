@@ -0,0 +1,826 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v5.27.0-rc1
+// source: gatewayadmin/gatewayadmin.proto
+
+package gatewayadmin
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ConnInfo 网关上的一条长连接
+type ConnInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID       string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`              // 用户ID
+	ConnID       string `protobuf:"bytes,2,opt,name=connID,proto3" json:"connID"`              // 连接ID
+	PlatformID   int32  `protobuf:"varint,3,opt,name=platformID,proto3" json:"platformID"`     // 平台ID
+	Platform     string `protobuf:"bytes,4,opt,name=platform,proto3" json:"platform"`          // 平台名称
+	RemoteAddr   string `protobuf:"bytes,5,opt,name=remoteAddr,proto3" json:"remoteAddr"`      // 客户端地址
+	ConnectTime  int64  `protobuf:"varint,6,opt,name=connectTime,proto3" json:"connectTime"`   // 建立连接的时间，毫秒时间戳
+	IsBackground bool   `protobuf:"varint,7,opt,name=isBackground,proto3" json:"isBackground"` // 是否处于后台
+	Compression  string `protobuf:"bytes,8,opt,name=compression,proto3" json:"compression"`    // 压缩算法，为空表示不压缩
+	Encoding     string `protobuf:"bytes,9,opt,name=encoding,proto3" json:"encoding"`          // 编码协议
+	Transport    string `protobuf:"bytes,10,opt,name=transport,proto3" json:"transport"`       // 传输方式，websocket 或 sse
+	BytesIn      int64  `protobuf:"varint,11,opt,name=bytesIn,proto3" json:"bytesIn"`          // 收到的字节数
+	BytesOut     int64  `protobuf:"varint,12,opt,name=bytesOut,proto3" json:"bytesOut"`        // 发出的字节数
+}
+
+func (x *ConnInfo) Reset() {
+	*x = ConnInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gatewayadmin_gatewayadmin_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnInfo) ProtoMessage() {}
+
+func (x *ConnInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_gatewayadmin_gatewayadmin_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnInfo.ProtoReflect.Descriptor instead.
+func (*ConnInfo) Descriptor() ([]byte, []int) {
+	return file_gatewayadmin_gatewayadmin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConnInfo) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ConnInfo) GetConnID() string {
+	if x != nil {
+		return x.ConnID
+	}
+	return ""
+}
+
+func (x *ConnInfo) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *ConnInfo) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *ConnInfo) GetRemoteAddr() string {
+	if x != nil {
+		return x.RemoteAddr
+	}
+	return ""
+}
+
+func (x *ConnInfo) GetConnectTime() int64 {
+	if x != nil {
+		return x.ConnectTime
+	}
+	return 0
+}
+
+func (x *ConnInfo) GetIsBackground() bool {
+	if x != nil {
+		return x.IsBackground
+	}
+	return false
+}
+
+func (x *ConnInfo) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+func (x *ConnInfo) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *ConnInfo) GetTransport() string {
+	if x != nil {
+		return x.Transport
+	}
+	return ""
+}
+
+func (x *ConnInfo) GetBytesIn() int64 {
+	if x != nil {
+		return x.BytesIn
+	}
+	return 0
+}
+
+func (x *ConnInfo) GetBytesOut() int64 {
+	if x != nil {
+		return x.BytesOut
+	}
+	return 0
+}
+
+// GetUserConnsReq 查询用户在当前网关上的连接
+type GetUserConnsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"` // 用户ID
+}
+
+func (x *GetUserConnsReq) Reset() {
+	*x = GetUserConnsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gatewayadmin_gatewayadmin_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserConnsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserConnsReq) ProtoMessage() {}
+
+func (x *GetUserConnsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_gatewayadmin_gatewayadmin_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserConnsReq.ProtoReflect.Descriptor instead.
+func (*GetUserConnsReq) Descriptor() ([]byte, []int) {
+	return file_gatewayadmin_gatewayadmin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetUserConnsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+// GetUserConnsResp 用户在当前网关上的连接
+type GetUserConnsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Conns []*ConnInfo `protobuf:"bytes,1,rep,name=conns,proto3" json:"conns"` // 连接列表
+}
+
+func (x *GetUserConnsResp) Reset() {
+	*x = GetUserConnsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gatewayadmin_gatewayadmin_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserConnsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserConnsResp) ProtoMessage() {}
+
+func (x *GetUserConnsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_gatewayadmin_gatewayadmin_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserConnsResp.ProtoReflect.Descriptor instead.
+func (*GetUserConnsResp) Descriptor() ([]byte, []int) {
+	return file_gatewayadmin_gatewayadmin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetUserConnsResp) GetConns() []*ConnInfo {
+	if x != nil {
+		return x.Conns
+	}
+	return nil
+}
+
+// KickConnReq 按连接ID踢下线
+type KickConnReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"` // 用户ID
+	ConnID string `protobuf:"bytes,2,opt,name=connID,proto3" json:"connID"` // 连接ID
+}
+
+func (x *KickConnReq) Reset() {
+	*x = KickConnReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gatewayadmin_gatewayadmin_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KickConnReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickConnReq) ProtoMessage() {}
+
+func (x *KickConnReq) ProtoReflect() protoreflect.Message {
+	mi := &file_gatewayadmin_gatewayadmin_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickConnReq.ProtoReflect.Descriptor instead.
+func (*KickConnReq) Descriptor() ([]byte, []int) {
+	return file_gatewayadmin_gatewayadmin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *KickConnReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *KickConnReq) GetConnID() string {
+	if x != nil {
+		return x.ConnID
+	}
+	return ""
+}
+
+// KickConnResp 踢下线的结果
+type KickConnResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kicked bool `protobuf:"varint,1,opt,name=kicked,proto3" json:"kicked"` // 连接是否在当前网关上并已被踢下线
+}
+
+func (x *KickConnResp) Reset() {
+	*x = KickConnResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gatewayadmin_gatewayadmin_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KickConnResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickConnResp) ProtoMessage() {}
+
+func (x *KickConnResp) ProtoReflect() protoreflect.Message {
+	mi := &file_gatewayadmin_gatewayadmin_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickConnResp.ProtoReflect.Descriptor instead.
+func (*KickConnResp) Descriptor() ([]byte, []int) {
+	return file_gatewayadmin_gatewayadmin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *KickConnResp) GetKicked() bool {
+	if x != nil {
+		return x.Kicked
+	}
+	return false
+}
+
+// DrainReq 摘除当前网关节点
+type DrainReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrainReq) Reset() {
+	*x = DrainReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gatewayadmin_gatewayadmin_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrainReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainReq) ProtoMessage() {}
+
+func (x *DrainReq) ProtoReflect() protoreflect.Message {
+	mi := &file_gatewayadmin_gatewayadmin_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainReq.ProtoReflect.Descriptor instead.
+func (*DrainReq) Descriptor() ([]byte, []int) {
+	return file_gatewayadmin_gatewayadmin_proto_rawDescGZIP(), []int{5}
+}
+
+// DrainResp 摘除的结果
+type DrainResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnNum int64 `protobuf:"varint,1,opt,name=connNum,proto3" json:"connNum"` // 开始摘除时节点上的连接数
+	Started bool  `protobuf:"varint,2,opt,name=started,proto3" json:"started"` // 是否由本次调用开始摘除，为false表示节点已在摘除中
+}
+
+func (x *DrainResp) Reset() {
+	*x = DrainResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gatewayadmin_gatewayadmin_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrainResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainResp) ProtoMessage() {}
+
+func (x *DrainResp) ProtoReflect() protoreflect.Message {
+	mi := &file_gatewayadmin_gatewayadmin_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainResp.ProtoReflect.Descriptor instead.
+func (*DrainResp) Descriptor() ([]byte, []int) {
+	return file_gatewayadmin_gatewayadmin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DrainResp) GetConnNum() int64 {
+	if x != nil {
+		return x.ConnNum
+	}
+	return 0
+}
+
+func (x *DrainResp) GetStarted() bool {
+	if x != nil {
+		return x.Started
+	}
+	return false
+}
+
+var File_gatewayadmin_gatewayadmin_proto protoreflect.FileDescriptor
+
+var file_gatewayadmin_gatewayadmin_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x12, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0xee, 0x02, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f,
+	0x6e, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x6e,
+	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1e,
+	0x0a, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x69, 0x73, 0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x42, 0x61, 0x63, 0x6b, 0x67, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69,
+	0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x62, 0x79, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x62, 0x79, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x29, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x22, 0x46, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x32, 0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x05, 0x63, 0x6f, 0x6e, 0x6e, 0x73, 0x22, 0x3d, 0x0a, 0x0b, 0x4b, 0x69, 0x63,
+	0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x44, 0x22, 0x26, 0x0a, 0x0c, 0x4b, 0x69, 0x63, 0x6b,
+	0x43, 0x6f, 0x6e, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x6b, 0x69, 0x63, 0x6b,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6b, 0x69, 0x63, 0x6b, 0x65, 0x64,
+	0x22, 0x0a, 0x0a, 0x08, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x22, 0x3f, 0x0a, 0x09,
+	0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x6e, 0x4e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x6e,
+	0x4e, 0x75, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x32, 0xfe, 0x01,
+	0x0a, 0x0c, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x59,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x12, 0x23,
+	0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x08, 0x4b, 0x69, 0x63,
+	0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x12, 0x1f, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x43,
+	0x6f, 0x6e, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4b, 0x69, 0x63, 0x6b,
+	0x43, 0x6f, 0x6e, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x05, 0x44, 0x72, 0x61, 0x69,
+	0x6e, 0x12, 0x1c, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a,
+	0x1d, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x42, 0x34,
+	0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x65, 0x69,
+	0x6b, 0x77, 0x65, 0x69, 0x2f, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_gatewayadmin_gatewayadmin_proto_rawDescOnce sync.Once
+	file_gatewayadmin_gatewayadmin_proto_rawDescData = file_gatewayadmin_gatewayadmin_proto_rawDesc
+)
+
+func file_gatewayadmin_gatewayadmin_proto_rawDescGZIP() []byte {
+	file_gatewayadmin_gatewayadmin_proto_rawDescOnce.Do(func() {
+		file_gatewayadmin_gatewayadmin_proto_rawDescData = protoimpl.X.CompressGZIP(file_gatewayadmin_gatewayadmin_proto_rawDescData)
+	})
+	return file_gatewayadmin_gatewayadmin_proto_rawDescData
+}
+
+var file_gatewayadmin_gatewayadmin_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_gatewayadmin_gatewayadmin_proto_goTypes = []interface{}{
+	(*ConnInfo)(nil),         // 0: aetim.gatewayadmin.ConnInfo
+	(*GetUserConnsReq)(nil),  // 1: aetim.gatewayadmin.GetUserConnsReq
+	(*GetUserConnsResp)(nil), // 2: aetim.gatewayadmin.GetUserConnsResp
+	(*KickConnReq)(nil),      // 3: aetim.gatewayadmin.KickConnReq
+	(*KickConnResp)(nil),     // 4: aetim.gatewayadmin.KickConnResp
+	(*DrainReq)(nil),         // 5: aetim.gatewayadmin.DrainReq
+	(*DrainResp)(nil),        // 6: aetim.gatewayadmin.DrainResp
+}
+var file_gatewayadmin_gatewayadmin_proto_depIdxs = []int32{
+	0, // 0: aetim.gatewayadmin.GetUserConnsResp.conns:type_name -> aetim.gatewayadmin.ConnInfo
+	1, // 1: aetim.gatewayadmin.gatewayAdmin.GetUserConns:input_type -> aetim.gatewayadmin.GetUserConnsReq
+	3, // 2: aetim.gatewayadmin.gatewayAdmin.KickConn:input_type -> aetim.gatewayadmin.KickConnReq
+	5, // 3: aetim.gatewayadmin.gatewayAdmin.Drain:input_type -> aetim.gatewayadmin.DrainReq
+	2, // 4: aetim.gatewayadmin.gatewayAdmin.GetUserConns:output_type -> aetim.gatewayadmin.GetUserConnsResp
+	4, // 5: aetim.gatewayadmin.gatewayAdmin.KickConn:output_type -> aetim.gatewayadmin.KickConnResp
+	6, // 6: aetim.gatewayadmin.gatewayAdmin.Drain:output_type -> aetim.gatewayadmin.DrainResp
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_gatewayadmin_gatewayadmin_proto_init() }
+func file_gatewayadmin_gatewayadmin_proto_init() {
+	if File_gatewayadmin_gatewayadmin_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_gatewayadmin_gatewayadmin_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gatewayadmin_gatewayadmin_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserConnsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gatewayadmin_gatewayadmin_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserConnsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gatewayadmin_gatewayadmin_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickConnReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gatewayadmin_gatewayadmin_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickConnResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gatewayadmin_gatewayadmin_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrainReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gatewayadmin_gatewayadmin_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrainResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gatewayadmin_gatewayadmin_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_gatewayadmin_gatewayadmin_proto_goTypes,
+		DependencyIndexes: file_gatewayadmin_gatewayadmin_proto_depIdxs,
+		MessageInfos:      file_gatewayadmin_gatewayadmin_proto_msgTypes,
+	}.Build()
+	File_gatewayadmin_gatewayadmin_proto = out.File
+	file_gatewayadmin_gatewayadmin_proto_rawDesc = nil
+	file_gatewayadmin_gatewayadmin_proto_goTypes = nil
+	file_gatewayadmin_gatewayadmin_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// GatewayAdminClient is the client API for GatewayAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GatewayAdminClient interface {
+	GetUserConns(ctx context.Context, in *GetUserConnsReq, opts ...grpc.CallOption) (*GetUserConnsResp, error)
+	KickConn(ctx context.Context, in *KickConnReq, opts ...grpc.CallOption) (*KickConnResp, error)
+	Drain(ctx context.Context, in *DrainReq, opts ...grpc.CallOption) (*DrainResp, error)
+}
+
+type gatewayAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGatewayAdminClient(cc grpc.ClientConnInterface) GatewayAdminClient {
+	return &gatewayAdminClient{cc}
+}
+
+func (c *gatewayAdminClient) GetUserConns(ctx context.Context, in *GetUserConnsReq, opts ...grpc.CallOption) (*GetUserConnsResp, error) {
+	out := new(GetUserConnsResp)
+	err := c.cc.Invoke(ctx, "/aetim.gatewayadmin.gatewayAdmin/GetUserConns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayAdminClient) KickConn(ctx context.Context, in *KickConnReq, opts ...grpc.CallOption) (*KickConnResp, error) {
+	out := new(KickConnResp)
+	err := c.cc.Invoke(ctx, "/aetim.gatewayadmin.gatewayAdmin/KickConn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayAdminClient) Drain(ctx context.Context, in *DrainReq, opts ...grpc.CallOption) (*DrainResp, error) {
+	out := new(DrainResp)
+	err := c.cc.Invoke(ctx, "/aetim.gatewayadmin.gatewayAdmin/Drain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GatewayAdminServer is the server API for GatewayAdmin service.
+type GatewayAdminServer interface {
+	GetUserConns(context.Context, *GetUserConnsReq) (*GetUserConnsResp, error)
+	KickConn(context.Context, *KickConnReq) (*KickConnResp, error)
+	Drain(context.Context, *DrainReq) (*DrainResp, error)
+}
+
+// UnimplementedGatewayAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedGatewayAdminServer struct {
+}
+
+func (*UnimplementedGatewayAdminServer) GetUserConns(context.Context, *GetUserConnsReq) (*GetUserConnsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserConns not implemented")
+}
+func (*UnimplementedGatewayAdminServer) KickConn(context.Context, *KickConnReq) (*KickConnResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickConn not implemented")
+}
+func (*UnimplementedGatewayAdminServer) Drain(context.Context, *DrainReq) (*DrainResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Drain not implemented")
+}
+
+func RegisterGatewayAdminServer(s *grpc.Server, srv GatewayAdminServer) {
+	s.RegisterService(&_GatewayAdmin_serviceDesc, srv)
+}
+
+func _GatewayAdmin_GetUserConns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserConnsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayAdminServer).GetUserConns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.gatewayadmin.gatewayAdmin/GetUserConns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayAdminServer).GetUserConns(ctx, req.(*GetUserConnsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayAdmin_KickConn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickConnReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayAdminServer).KickConn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.gatewayadmin.gatewayAdmin/KickConn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayAdminServer).KickConn(ctx, req.(*KickConnReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayAdmin_Drain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayAdminServer).Drain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.gatewayadmin.gatewayAdmin/Drain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayAdminServer).Drain(ctx, req.(*DrainReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _GatewayAdmin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "aetim.gatewayadmin.gatewayAdmin",
+	HandlerType: (*GatewayAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetUserConns",
+			Handler:    _GatewayAdmin_GetUserConns_Handler,
+		},
+		{
+			MethodName: "KickConn",
+			Handler:    _GatewayAdmin_KickConn_Handler,
+		},
+		{
+			MethodName: "Drain",
+			Handler:    _GatewayAdmin_Drain_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gatewayadmin/gatewayadmin.proto",
+}
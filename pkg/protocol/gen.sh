@@ -0,0 +1,37 @@
+#!/usr/bin/env bash
+# Copyright © 2023 OpenIM. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# Generates the Go code of the protos that extend github.com/Meikwei/protocol,
+# run it from this directory with the same protoc and protoc-gen-go as the protocol repo.
+
+PROTO_NAMES=(
+    "gatewayadmin"
+    "msgext"
+)
+
+for name in "${PROTO_NAMES[@]}"; do
+  protoc -I . -I "$(go list -m -f '{{.Dir}}' github.com/Meikwei/protocol)" \
+    --go_out=plugins=grpc:./${name} --go_opt=module=github.com/Meikwei/aetim/pkg/protocol/${name} ${name}/${name}.proto
+  if [ $? -ne 0 ]; then
+      echo "error processing ${name}.proto"
+      exit $?
+  fi
+done
+
+if [ "$(uname -s)" == "Darwin" ]; then
+    find . -type f -name '*.pb.go' -exec sed -i '' 's/,omitempty"`/\"\`/g' {} +
+else
+    find . -type f -name '*.pb.go' -exec sed -i 's/,omitempty"`/\"\`/g' {} +
+fi
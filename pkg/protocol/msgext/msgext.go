@@ -0,0 +1,488 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgext
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	// MsgEditNotification 消息编辑通知，内容为 MsgEditTips
+	MsgEditNotification = 2103
+	// MsgReactionNotification 消息回应变更通知，内容为 MsgReactionTips
+	MsgReactionNotification = 2104
+	// ThreadReplyNotification 线程收到回复的通知，内容为 ThreadReplyTips
+	ThreadReplyNotification = 2105
+	// MsgPinNotification 置顶消息变更通知，内容为 MsgPinTips
+	MsgPinNotification = 2106
+	// GroupMsgReadNotification 群成员已读位置前进的通知，内容为 GroupMsgReadTips
+	GroupMsgReadNotification = 2107
+)
+
+// MsgReactionTips.Operation
+const (
+	ReactionAdd    = 1 // 添加回应
+	ReactionRemove = 2 // 取消回应
+)
+
+// MsgPinTips.Operation
+const (
+	MsgPin   = 1 // 置顶
+	MsgUnpin = 2 // 取消置顶
+)
+
+// RetentionPolicy.Mode 消息保留方式
+const (
+	RetentionInherit = 0 // 沿用上一级策略
+	RetentionForever = 1 // 永久保留
+	RetentionDays    = 2 // 保留最近days天
+	RetentionCount   = 3 // 保留最近count条
+)
+
+// MsgExportJob.Status 消息导出任务状态
+const (
+	MsgExportPending   = 1 // 排队等待执行
+	MsgExportRunning   = 2 // 正在导出
+	MsgExportSucceeded = 3 // 导出完成
+	MsgExportFailed    = 4 // 导出失败
+)
+
+// BroadcastTarget.Type 广播目标类型
+const (
+	BroadcastTargetAll    = 1 // 全部用户
+	BroadcastTargetUsers  = 2 // 指定的用户
+	BroadcastTargetGroups = 3 // 指定群的成员
+	BroadcastTargetFilter = 4 // 按注册时间、appMangerLevel筛选的用户
+)
+
+// BroadcastJob.Status 广播任务状态
+const (
+	BroadcastPending   = 1 // 排队等待执行
+	BroadcastRunning   = 2 // 正在发送
+	BroadcastPaused    = 3 // 已暂停
+	BroadcastCanceled  = 4 // 已取消
+	BroadcastSucceeded = 5 // 已完成，发送失败的接收者见GetBroadcastFailures
+	BroadcastFailed    = 6 // 遍历接收者失败
+)
+
+// MaxReactionEmojiLen 表情的最大字节数
+const MaxReactionEmojiLen = 64
+
+func (x *EditMsgReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq <= 0 {
+		return errors.New("seq is invalid")
+	}
+	if x.Content == "" {
+		return errors.New("content is empty")
+	}
+	return nil
+}
+
+func (x *GetMsgEditHistoryReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq <= 0 {
+		return errors.New("seq is invalid")
+	}
+	return nil
+}
+
+func (x *ScheduleMsgReq) Check() error {
+	if x.MsgData == nil {
+		return errors.New("msgData is empty")
+	}
+	if err := x.MsgData.Check(); err != nil {
+		return err
+	}
+	if x.SendTime <= 0 {
+		return errors.New("sendTime is invalid")
+	}
+	return nil
+}
+
+func (x *GetScheduledMsgsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errors.New("pageNumber is invalid")
+	}
+	return nil
+}
+
+func (x *CancelScheduledMsgReq) Check() error {
+	if x.ScheduleID == "" {
+		return errors.New("scheduleID is empty")
+	}
+	return nil
+}
+
+func (x *RescheduleMsgReq) Check() error {
+	if x.ScheduleID == "" {
+		return errors.New("scheduleID is empty")
+	}
+	if x.SendTime <= 0 {
+		return errors.New("sendTime is invalid")
+	}
+	return nil
+}
+
+func checkReaction(userID, conversationID string, seq int64, emoji string) error {
+	if userID == "" {
+		return errors.New("userID is empty")
+	}
+	if conversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if seq <= 0 {
+		return errors.New("seq is invalid")
+	}
+	if emoji == "" {
+		return errors.New("emoji is empty")
+	}
+	if len(emoji) > MaxReactionEmojiLen {
+		return errors.New("emoji is too long")
+	}
+	return nil
+}
+
+func (x *AddMsgReactionReq) Check() error {
+	return checkReaction(x.UserID, x.ConversationID, x.Seq, x.Emoji)
+}
+
+func (x *RemoveMsgReactionReq) Check() error {
+	return checkReaction(x.UserID, x.ConversationID, x.Seq, x.Emoji)
+}
+
+func (x *GetMsgReactionsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq <= 0 {
+		return errors.New("seq is invalid")
+	}
+	return nil
+}
+
+func (x *CreateThreadReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq <= 0 {
+		return errors.New("seq is invalid")
+	}
+	return nil
+}
+
+func (x *SendThreadMsgReq) Check() error {
+	if x.ThreadID == "" {
+		return errors.New("threadID is empty")
+	}
+	if x.MsgData == nil {
+		return errors.New("msgData is empty")
+	}
+	return x.MsgData.Check()
+}
+
+func (x *PullThreadMsgsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ThreadID == "" {
+		return errors.New("threadID is empty")
+	}
+	if x.BeginSeq < 0 || x.EndSeq < x.BeginSeq {
+		return errors.New("seq range is invalid")
+	}
+	if x.Num <= 0 {
+		return errors.New("num is invalid")
+	}
+	return nil
+}
+
+func (x *SubscribeThreadReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ThreadID == "" {
+		return errors.New("threadID is empty")
+	}
+	return nil
+}
+
+func (x *MarkThreadAsReadReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ThreadID == "" {
+		return errors.New("threadID is empty")
+	}
+	if x.HasReadSeq < 0 {
+		return errors.New("hasReadSeq is invalid")
+	}
+	return nil
+}
+
+func (x *GetUserThreadsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errors.New("pageNumber is invalid")
+	}
+	return nil
+}
+
+func checkPin(userID, conversationID string, seq int64) error {
+	if userID == "" {
+		return errors.New("userID is empty")
+	}
+	if conversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if seq <= 0 {
+		return errors.New("seq is invalid")
+	}
+	return nil
+}
+
+func (x *PinMsgReq) Check() error {
+	return checkPin(x.UserID, x.ConversationID, x.Seq)
+}
+
+func (x *UnpinMsgReq) Check() error {
+	return checkPin(x.UserID, x.ConversationID, x.Seq)
+}
+
+func (x *GetPinnedMsgsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	return nil
+}
+
+func (x *SearchConversationMsgsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if strings.TrimSpace(x.Keyword) == "" {
+		return errors.New("keyword is empty")
+	}
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errors.New("pageNumber is invalid")
+	}
+	return nil
+}
+
+func (x *GetGroupMsgReadersReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq <= 0 {
+		return errors.New("seq is invalid")
+	}
+	return nil
+}
+
+func checkRetentionTarget(conversationID, groupID string) error {
+	if (conversationID == "") == (groupID == "") {
+		return errors.New("exactly one of conversationID and groupID is required")
+	}
+	return nil
+}
+
+func (x *SetRetentionPolicyReq) Check() error {
+	if err := checkRetentionTarget(x.ConversationID, x.GroupID); err != nil {
+		return err
+	}
+	if x.Policy == nil {
+		return errors.New("policy is empty")
+	}
+	switch x.Policy.Mode {
+	case RetentionInherit, RetentionForever:
+	case RetentionDays:
+		if x.Policy.Days <= 0 {
+			return errors.New("days is invalid")
+		}
+	case RetentionCount:
+		if x.Policy.Count <= 0 {
+			return errors.New("count is invalid")
+		}
+	default:
+		return errors.New("mode is invalid")
+	}
+	return nil
+}
+
+func (x *GetRetentionPolicyReq) Check() error {
+	return checkRetentionTarget(x.ConversationID, x.GroupID)
+}
+
+func (x *CreateMsgExportJobReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.StartTime < 0 || x.EndTime < 0 {
+		return errors.New("time range is invalid")
+	}
+	if x.EndTime > 0 && x.StartTime > x.EndTime {
+		return errors.New("startTime is after endTime")
+	}
+	return nil
+}
+
+func (x *GetMsgExportJobReq) Check() error {
+	if x.JobID == "" {
+		return errors.New("jobID is empty")
+	}
+	return nil
+}
+
+func (x *BroadcastTarget) Check() error {
+	switch x.Type {
+	case BroadcastTargetAll:
+	case BroadcastTargetUsers:
+		if len(x.UserIDs) == 0 {
+			return errors.New("userIDs is empty")
+		}
+	case BroadcastTargetGroups:
+		if len(x.GroupIDs) == 0 {
+			return errors.New("groupIDs is empty")
+		}
+	case BroadcastTargetFilter:
+		if x.CreateTimeBegin < 0 || x.CreateTimeEnd < 0 {
+			return errors.New("createTime range is invalid")
+		}
+		if x.CreateTimeEnd > 0 && x.CreateTimeBegin >= x.CreateTimeEnd {
+			return errors.New("createTimeBegin is not before createTimeEnd")
+		}
+	default:
+		return errors.New("target type is invalid")
+	}
+	return nil
+}
+
+func (x *CreateBroadcastJobReq) Check() error {
+	if x.MsgData == nil {
+		return errors.New("msgData is empty")
+	}
+	if x.MsgData.SendID == "" {
+		return errors.New("sendID is empty")
+	}
+	if x.Target == nil {
+		return errors.New("target is empty")
+	}
+	if x.Rate < 0 {
+		return errors.New("rate is invalid")
+	}
+	return x.Target.Check()
+}
+
+func (x *GetBroadcastJobReq) Check() error {
+	if x.JobID == "" {
+		return errors.New("jobID is empty")
+	}
+	return nil
+}
+
+func (x *GetBroadcastJobsReq) Check() error {
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errors.New("pageNumber is invalid")
+	}
+	return nil
+}
+
+func (x *PauseBroadcastJobReq) Check() error {
+	if x.JobID == "" {
+		return errors.New("jobID is empty")
+	}
+	return nil
+}
+
+func (x *ResumeBroadcastJobReq) Check() error {
+	if x.JobID == "" {
+		return errors.New("jobID is empty")
+	}
+	return nil
+}
+
+func (x *CancelBroadcastJobReq) Check() error {
+	if x.JobID == "" {
+		return errors.New("jobID is empty")
+	}
+	return nil
+}
+
+func (x *GetBroadcastFailuresReq) Check() error {
+	if x.JobID == "" {
+		return errors.New("jobID is empty")
+	}
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errors.New("pageNumber is invalid")
+	}
+	return nil
+}
+
+func (x *GetUnreadCountReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *GetUsersUnreadCountReq) Check() error {
+	if len(x.UserIDs) == 0 {
+		return errors.New("userIDs is empty")
+	}
+	return nil
+}
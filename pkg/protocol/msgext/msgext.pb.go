@@ -0,0 +1,8663 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v5.27.0-rc1
+// source: msgext/msgext.proto
+
+package msgext
+
+import (
+	context "context"
+	sdkws "github.com/Meikwei/protocol/sdkws"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EditMsgReq 编辑消息的请求参数
+type EditMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`                 // 编辑者ID
+	ConversationID string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"` // 会话ID
+	Seq            int64  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq"`                      // 消息序列号
+	Content        string `protobuf:"bytes,4,opt,name=content,proto3" json:"content"`               // 新的消息内容，格式与原消息的 contentType 一致
+}
+
+func (x *EditMsgReq) Reset() {
+	*x = EditMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditMsgReq) ProtoMessage() {}
+
+func (x *EditMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditMsgReq.ProtoReflect.Descriptor instead.
+func (*EditMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EditMsgReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *EditMsgReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *EditMsgReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *EditMsgReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// EditMsgResp 编辑消息的响应结果
+type EditMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EditTime int64 `protobuf:"varint,1,opt,name=editTime,proto3" json:"editTime"` // 编辑时间，毫秒时间戳
+}
+
+func (x *EditMsgResp) Reset() {
+	*x = EditMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditMsgResp) ProtoMessage() {}
+
+func (x *EditMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditMsgResp.ProtoReflect.Descriptor instead.
+func (*EditMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EditMsgResp) GetEditTime() int64 {
+	if x != nil {
+		return x.EditTime
+	}
+	return 0
+}
+
+// MsgEditTips 消息被编辑的通知内容
+type MsgEditTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EditorUserID   string `protobuf:"bytes,1,opt,name=editorUserID,proto3" json:"editorUserID"`     // 编辑者ID
+	ConversationID string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"` // 会话ID
+	Seq            int64  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq"`                      // 消息序列号
+	ClientMsgID    string `protobuf:"bytes,4,opt,name=clientMsgID,proto3" json:"clientMsgID"`       // 客户端消息ID
+	SessionType    int32  `protobuf:"varint,5,opt,name=sessionType,proto3" json:"sessionType"`      // 会话类型
+	ContentType    int32  `protobuf:"varint,6,opt,name=contentType,proto3" json:"contentType"`      // 消息类型
+	Content        string `protobuf:"bytes,7,opt,name=content,proto3" json:"content"`               // 编辑后的消息内容
+	EditTime       int64  `protobuf:"varint,8,opt,name=editTime,proto3" json:"editTime"`            // 编辑时间，毫秒时间戳
+	IsAdminEdit    bool   `protobuf:"varint,9,opt,name=isAdminEdit,proto3" json:"isAdminEdit"`      // 是否由管理员编辑
+}
+
+func (x *MsgEditTips) Reset() {
+	*x = MsgEditTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgEditTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgEditTips) ProtoMessage() {}
+
+func (x *MsgEditTips) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgEditTips.ProtoReflect.Descriptor instead.
+func (*MsgEditTips) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgEditTips) GetEditorUserID() string {
+	if x != nil {
+		return x.EditorUserID
+	}
+	return ""
+}
+
+func (x *MsgEditTips) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *MsgEditTips) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *MsgEditTips) GetClientMsgID() string {
+	if x != nil {
+		return x.ClientMsgID
+	}
+	return ""
+}
+
+func (x *MsgEditTips) GetSessionType() int32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *MsgEditTips) GetContentType() int32 {
+	if x != nil {
+		return x.ContentType
+	}
+	return 0
+}
+
+func (x *MsgEditTips) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MsgEditTips) GetEditTime() int64 {
+	if x != nil {
+		return x.EditTime
+	}
+	return 0
+}
+
+func (x *MsgEditTips) GetIsAdminEdit() bool {
+	if x != nil {
+		return x.IsAdminEdit
+	}
+	return false
+}
+
+// MsgEditRecord 一次编辑的记录
+type MsgEditRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EditorUserID string `protobuf:"bytes,1,opt,name=editorUserID,proto3" json:"editorUserID"` // 编辑者ID
+	PrevContent  string `protobuf:"bytes,2,opt,name=prevContent,proto3" json:"prevContent"`   // 编辑前的内容
+	Content      string `protobuf:"bytes,3,opt,name=content,proto3" json:"content"`           // 编辑后的内容
+	EditTime     int64  `protobuf:"varint,4,opt,name=editTime,proto3" json:"editTime"`        // 编辑时间，毫秒时间戳
+}
+
+func (x *MsgEditRecord) Reset() {
+	*x = MsgEditRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgEditRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgEditRecord) ProtoMessage() {}
+
+func (x *MsgEditRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgEditRecord.ProtoReflect.Descriptor instead.
+func (*MsgEditRecord) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MsgEditRecord) GetEditorUserID() string {
+	if x != nil {
+		return x.EditorUserID
+	}
+	return ""
+}
+
+func (x *MsgEditRecord) GetPrevContent() string {
+	if x != nil {
+		return x.PrevContent
+	}
+	return ""
+}
+
+func (x *MsgEditRecord) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MsgEditRecord) GetEditTime() int64 {
+	if x != nil {
+		return x.EditTime
+	}
+	return 0
+}
+
+// GetMsgEditHistoryReq 查询消息编辑历史的请求参数
+type GetMsgEditHistoryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`                 // 查询者ID
+	ConversationID string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"` // 会话ID
+	Seq            int64  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq"`                      // 消息序列号
+}
+
+func (x *GetMsgEditHistoryReq) Reset() {
+	*x = GetMsgEditHistoryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMsgEditHistoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMsgEditHistoryReq) ProtoMessage() {}
+
+func (x *GetMsgEditHistoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMsgEditHistoryReq.ProtoReflect.Descriptor instead.
+func (*GetMsgEditHistoryReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetMsgEditHistoryReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetMsgEditHistoryReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetMsgEditHistoryReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+// GetMsgEditHistoryResp 消息的编辑历史，按编辑时间升序
+type GetMsgEditHistoryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*MsgEditRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"` // 编辑记录
+}
+
+func (x *GetMsgEditHistoryResp) Reset() {
+	*x = GetMsgEditHistoryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMsgEditHistoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMsgEditHistoryResp) ProtoMessage() {}
+
+func (x *GetMsgEditHistoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMsgEditHistoryResp.ProtoReflect.Descriptor instead.
+func (*GetMsgEditHistoryResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetMsgEditHistoryResp) GetRecords() []*MsgEditRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// ScheduledMsg 一条定时发送的消息
+type ScheduledMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduleID   string         `protobuf:"bytes,1,opt,name=scheduleID,proto3" json:"scheduleID"`      // 定时消息ID
+	MsgData      *sdkws.MsgData `protobuf:"bytes,2,opt,name=msgData,proto3" json:"msgData"`            // 待发送的消息
+	SendTime     int64          `protobuf:"varint,3,opt,name=sendTime,proto3" json:"sendTime"`         // 计划发送时间，毫秒时间戳
+	Status       int32          `protobuf:"varint,4,opt,name=status,proto3" json:"status"`             // 状态：1等待发送 2发送中 3已发送 4已取消 5发送失败
+	Error        string         `protobuf:"bytes,5,opt,name=error,proto3" json:"error"`                // 发送失败的原因
+	CreateTime   int64          `protobuf:"varint,6,opt,name=createTime,proto3" json:"createTime"`     // 创建时间，毫秒时间戳
+	DispatchTime int64          `protobuf:"varint,7,opt,name=dispatchTime,proto3" json:"dispatchTime"` // 实际发送时间，毫秒时间戳
+}
+
+func (x *ScheduledMsg) Reset() {
+	*x = ScheduledMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledMsg) ProtoMessage() {}
+
+func (x *ScheduledMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledMsg.ProtoReflect.Descriptor instead.
+func (*ScheduledMsg) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ScheduledMsg) GetScheduleID() string {
+	if x != nil {
+		return x.ScheduleID
+	}
+	return ""
+}
+
+func (x *ScheduledMsg) GetMsgData() *sdkws.MsgData {
+	if x != nil {
+		return x.MsgData
+	}
+	return nil
+}
+
+func (x *ScheduledMsg) GetSendTime() int64 {
+	if x != nil {
+		return x.SendTime
+	}
+	return 0
+}
+
+func (x *ScheduledMsg) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ScheduledMsg) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ScheduledMsg) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *ScheduledMsg) GetDispatchTime() int64 {
+	if x != nil {
+		return x.DispatchTime
+	}
+	return 0
+}
+
+// ScheduleMsgReq 创建定时消息的请求参数
+type ScheduleMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MsgData  *sdkws.MsgData `protobuf:"bytes,1,opt,name=msgData,proto3" json:"msgData"`    // 待发送的消息，与 SendMsg 的参数相同
+	SendTime int64          `protobuf:"varint,2,opt,name=sendTime,proto3" json:"sendTime"` // 计划发送时间，毫秒时间戳
+}
+
+func (x *ScheduleMsgReq) Reset() {
+	*x = ScheduleMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMsgReq) ProtoMessage() {}
+
+func (x *ScheduleMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMsgReq.ProtoReflect.Descriptor instead.
+func (*ScheduleMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ScheduleMsgReq) GetMsgData() *sdkws.MsgData {
+	if x != nil {
+		return x.MsgData
+	}
+	return nil
+}
+
+func (x *ScheduleMsgReq) GetSendTime() int64 {
+	if x != nil {
+		return x.SendTime
+	}
+	return 0
+}
+
+// ScheduleMsgResp 创建定时消息的响应结果
+type ScheduleMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduleID string `protobuf:"bytes,1,opt,name=scheduleID,proto3" json:"scheduleID"` // 定时消息ID
+}
+
+func (x *ScheduleMsgResp) Reset() {
+	*x = ScheduleMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMsgResp) ProtoMessage() {}
+
+func (x *ScheduleMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMsgResp.ProtoReflect.Descriptor instead.
+func (*ScheduleMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ScheduleMsgResp) GetScheduleID() string {
+	if x != nil {
+		return x.ScheduleID
+	}
+	return ""
+}
+
+// GetScheduledMsgsReq 查询用户的定时消息
+type GetScheduledMsgsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string                   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`         // 发送者ID
+	Status     []int32                  `protobuf:"varint,2,rep,packed,name=status,proto3" json:"status"` // 按状态过滤，为空表示全部
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination"` // 分页参数
+}
+
+func (x *GetScheduledMsgsReq) Reset() {
+	*x = GetScheduledMsgsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScheduledMsgsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduledMsgsReq) ProtoMessage() {}
+
+func (x *GetScheduledMsgsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduledMsgsReq.ProtoReflect.Descriptor instead.
+func (*GetScheduledMsgsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetScheduledMsgsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetScheduledMsgsReq) GetStatus() []int32 {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetScheduledMsgsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// GetScheduledMsgsResp 用户的定时消息，按计划发送时间升序
+type GetScheduledMsgsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total int64           `protobuf:"varint,1,opt,name=total,proto3" json:"total"` // 总数
+	Msgs  []*ScheduledMsg `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs"`    // 定时消息列表
+}
+
+func (x *GetScheduledMsgsResp) Reset() {
+	*x = GetScheduledMsgsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScheduledMsgsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduledMsgsResp) ProtoMessage() {}
+
+func (x *GetScheduledMsgsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduledMsgsResp.ProtoReflect.Descriptor instead.
+func (*GetScheduledMsgsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetScheduledMsgsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetScheduledMsgsResp) GetMsgs() []*ScheduledMsg {
+	if x != nil {
+		return x.Msgs
+	}
+	return nil
+}
+
+// CancelScheduledMsgReq 取消一条等待发送的定时消息
+type CancelScheduledMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduleID string `protobuf:"bytes,1,opt,name=scheduleID,proto3" json:"scheduleID"` // 定时消息ID
+}
+
+func (x *CancelScheduledMsgReq) Reset() {
+	*x = CancelScheduledMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledMsgReq) ProtoMessage() {}
+
+func (x *CancelScheduledMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledMsgReq.ProtoReflect.Descriptor instead.
+func (*CancelScheduledMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CancelScheduledMsgReq) GetScheduleID() string {
+	if x != nil {
+		return x.ScheduleID
+	}
+	return ""
+}
+
+// CancelScheduledMsgResp 取消定时消息的响应结果
+type CancelScheduledMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelScheduledMsgResp) Reset() {
+	*x = CancelScheduledMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledMsgResp) ProtoMessage() {}
+
+func (x *CancelScheduledMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledMsgResp.ProtoReflect.Descriptor instead.
+func (*CancelScheduledMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{12}
+}
+
+// RescheduleMsgReq 修改一条等待发送的定时消息的发送时间
+type RescheduleMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduleID string `protobuf:"bytes,1,opt,name=scheduleID,proto3" json:"scheduleID"` // 定时消息ID
+	SendTime   int64  `protobuf:"varint,2,opt,name=sendTime,proto3" json:"sendTime"`    // 新的计划发送时间，毫秒时间戳
+}
+
+func (x *RescheduleMsgReq) Reset() {
+	*x = RescheduleMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescheduleMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleMsgReq) ProtoMessage() {}
+
+func (x *RescheduleMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleMsgReq.ProtoReflect.Descriptor instead.
+func (*RescheduleMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RescheduleMsgReq) GetScheduleID() string {
+	if x != nil {
+		return x.ScheduleID
+	}
+	return ""
+}
+
+func (x *RescheduleMsgReq) GetSendTime() int64 {
+	if x != nil {
+		return x.SendTime
+	}
+	return 0
+}
+
+// RescheduleMsgResp 修改发送时间的响应结果
+type RescheduleMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RescheduleMsgResp) Reset() {
+	*x = RescheduleMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescheduleMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleMsgResp) ProtoMessage() {}
+
+func (x *RescheduleMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleMsgResp.ProtoReflect.Descriptor instead.
+func (*RescheduleMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{14}
+}
+
+// msgExt 消息服务的扩展接口，与 msg 服务部署在同一进程
+// MsgReaction 一个表情的回应汇总
+type MsgReaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Emoji   string   `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji"`     // 表情
+	Count   int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count"`    // 回应人数
+	UserIDs []string `protobuf:"bytes,3,rep,name=userIDs,proto3" json:"userIDs"` // 回应的用户ID，按回应时间排序
+}
+
+func (x *MsgReaction) Reset() {
+	*x = MsgReaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgReaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgReaction) ProtoMessage() {}
+
+func (x *MsgReaction) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgReaction.ProtoReflect.Descriptor instead.
+func (*MsgReaction) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MsgReaction) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *MsgReaction) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *MsgReaction) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+// AddMsgReactionReq 添加消息回应的请求参数
+type AddMsgReactionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`                 // 回应者ID
+	ConversationID string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"` // 会话ID
+	Seq            int64  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq"`                      // 消息序列号
+	Emoji          string `protobuf:"bytes,4,opt,name=emoji,proto3" json:"emoji"`                   // 表情
+}
+
+func (x *AddMsgReactionReq) Reset() {
+	*x = AddMsgReactionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddMsgReactionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMsgReactionReq) ProtoMessage() {}
+
+func (x *AddMsgReactionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMsgReactionReq.ProtoReflect.Descriptor instead.
+func (*AddMsgReactionReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AddMsgReactionReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *AddMsgReactionReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *AddMsgReactionReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *AddMsgReactionReq) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+// AddMsgReactionResp 添加消息回应的响应结果
+type AddMsgReactionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reactions []*MsgReaction `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions"` // 消息当前的全部回应
+}
+
+func (x *AddMsgReactionResp) Reset() {
+	*x = AddMsgReactionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddMsgReactionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMsgReactionResp) ProtoMessage() {}
+
+func (x *AddMsgReactionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMsgReactionResp.ProtoReflect.Descriptor instead.
+func (*AddMsgReactionResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AddMsgReactionResp) GetReactions() []*MsgReaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+// RemoveMsgReactionReq 取消消息回应的请求参数
+type RemoveMsgReactionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`                 // 回应者ID
+	ConversationID string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"` // 会话ID
+	Seq            int64  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq"`                      // 消息序列号
+	Emoji          string `protobuf:"bytes,4,opt,name=emoji,proto3" json:"emoji"`                   // 表情
+}
+
+func (x *RemoveMsgReactionReq) Reset() {
+	*x = RemoveMsgReactionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveMsgReactionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMsgReactionReq) ProtoMessage() {}
+
+func (x *RemoveMsgReactionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMsgReactionReq.ProtoReflect.Descriptor instead.
+func (*RemoveMsgReactionReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RemoveMsgReactionReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RemoveMsgReactionReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *RemoveMsgReactionReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *RemoveMsgReactionReq) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+// RemoveMsgReactionResp 取消消息回应的响应结果
+type RemoveMsgReactionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reactions []*MsgReaction `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions"` // 消息当前的全部回应
+}
+
+func (x *RemoveMsgReactionResp) Reset() {
+	*x = RemoveMsgReactionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveMsgReactionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMsgReactionResp) ProtoMessage() {}
+
+func (x *RemoveMsgReactionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMsgReactionResp.ProtoReflect.Descriptor instead.
+func (*RemoveMsgReactionResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RemoveMsgReactionResp) GetReactions() []*MsgReaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+// GetMsgReactionsReq 查询消息回应的请求参数
+type GetMsgReactionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`                 // 查询者ID
+	ConversationID string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"` // 会话ID
+	Seq            int64  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq"`                      // 消息序列号
+}
+
+func (x *GetMsgReactionsReq) Reset() {
+	*x = GetMsgReactionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMsgReactionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMsgReactionsReq) ProtoMessage() {}
+
+func (x *GetMsgReactionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMsgReactionsReq.ProtoReflect.Descriptor instead.
+func (*GetMsgReactionsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetMsgReactionsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetMsgReactionsReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetMsgReactionsReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+// GetMsgReactionsResp 查询消息回应的响应结果
+type GetMsgReactionsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reactions []*MsgReaction `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions"` // 消息当前的全部回应
+}
+
+func (x *GetMsgReactionsResp) Reset() {
+	*x = GetMsgReactionsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMsgReactionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMsgReactionsResp) ProtoMessage() {}
+
+func (x *GetMsgReactionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMsgReactionsResp.ProtoReflect.Descriptor instead.
+func (*GetMsgReactionsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetMsgReactionsResp) GetReactions() []*MsgReaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+// MsgReactionTips 消息回应变更的增量通知内容
+type MsgReactionTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpUserID       string `protobuf:"bytes,1,opt,name=opUserID,proto3" json:"opUserID"`             // 操作者ID
+	ConversationID string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"` // 会话ID
+	Seq            int64  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq"`                      // 消息序列号
+	ClientMsgID    string `protobuf:"bytes,4,opt,name=clientMsgID,proto3" json:"clientMsgID"`       // 客户端消息ID
+	SessionType    int32  `protobuf:"varint,5,opt,name=sessionType,proto3" json:"sessionType"`      // 会话类型
+	Emoji          string `protobuf:"bytes,6,opt,name=emoji,proto3" json:"emoji"`                   // 表情
+	Operation      int32  `protobuf:"varint,7,opt,name=operation,proto3" json:"operation"`          // 操作：1添加 2取消
+	Count          int64  `protobuf:"varint,8,opt,name=count,proto3" json:"count"`                  // 操作后该表情的回应人数
+	OperateTime    int64  `protobuf:"varint,9,opt,name=operateTime,proto3" json:"operateTime"`      // 操作时间，毫秒时间戳
+}
+
+func (x *MsgReactionTips) Reset() {
+	*x = MsgReactionTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgReactionTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgReactionTips) ProtoMessage() {}
+
+func (x *MsgReactionTips) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgReactionTips.ProtoReflect.Descriptor instead.
+func (*MsgReactionTips) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MsgReactionTips) GetOpUserID() string {
+	if x != nil {
+		return x.OpUserID
+	}
+	return ""
+}
+
+func (x *MsgReactionTips) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *MsgReactionTips) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *MsgReactionTips) GetClientMsgID() string {
+	if x != nil {
+		return x.ClientMsgID
+	}
+	return ""
+}
+
+func (x *MsgReactionTips) GetSessionType() int32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *MsgReactionTips) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *MsgReactionTips) GetOperation() int32 {
+	if x != nil {
+		return x.Operation
+	}
+	return 0
+}
+
+func (x *MsgReactionTips) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *MsgReactionTips) GetOperateTime() int64 {
+	if x != nil {
+		return x.OperateTime
+	}
+	return 0
+}
+
+// ThreadInfo 回复线程的信息
+type ThreadInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ThreadID        string `protobuf:"bytes,1,opt,name=threadID,proto3" json:"threadID"`                // 线程ID，同时是线程消息的会话ID
+	ConversationID  string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"`    // 根消息所在的会话ID
+	RootSeq         int64  `protobuf:"varint,3,opt,name=rootSeq,proto3" json:"rootSeq"`                 // 根消息的序列号
+	RootClientMsgID string `protobuf:"bytes,4,opt,name=rootClientMsgID,proto3" json:"rootClientMsgID"`  // 根消息的客户端消息ID
+	SessionType     int32  `protobuf:"varint,5,opt,name=sessionType,proto3" json:"sessionType"`         // 根消息的会话类型
+	GroupID         string `protobuf:"bytes,6,opt,name=groupID,proto3" json:"groupID"`                  // 群组ID
+	CreatorUserID   string `protobuf:"bytes,7,opt,name=creatorUserID,proto3" json:"creatorUserID"`      // 创建者ID
+	ReplyCount      int64  `protobuf:"varint,8,opt,name=replyCount,proto3" json:"replyCount"`           // 回复数
+	LastReplySeq    int64  `protobuf:"varint,9,opt,name=lastReplySeq,proto3" json:"lastReplySeq"`       // 最后一条回复的序列号
+	LastReplyUserID string `protobuf:"bytes,10,opt,name=lastReplyUserID,proto3" json:"lastReplyUserID"` // 最后一条回复的发送者ID
+	LastReplyTime   int64  `protobuf:"varint,11,opt,name=lastReplyTime,proto3" json:"lastReplyTime"`    // 最后一条回复的时间，毫秒时间戳
+	CreateTime      int64  `protobuf:"varint,12,opt,name=createTime,proto3" json:"createTime"`          // 创建时间，毫秒时间戳
+}
+
+func (x *ThreadInfo) Reset() {
+	*x = ThreadInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThreadInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadInfo) ProtoMessage() {}
+
+func (x *ThreadInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadInfo.ProtoReflect.Descriptor instead.
+func (*ThreadInfo) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ThreadInfo) GetThreadID() string {
+	if x != nil {
+		return x.ThreadID
+	}
+	return ""
+}
+
+func (x *ThreadInfo) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *ThreadInfo) GetRootSeq() int64 {
+	if x != nil {
+		return x.RootSeq
+	}
+	return 0
+}
+
+func (x *ThreadInfo) GetRootClientMsgID() string {
+	if x != nil {
+		return x.RootClientMsgID
+	}
+	return ""
+}
+
+func (x *ThreadInfo) GetSessionType() int32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *ThreadInfo) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *ThreadInfo) GetCreatorUserID() string {
+	if x != nil {
+		return x.CreatorUserID
+	}
+	return ""
+}
+
+func (x *ThreadInfo) GetReplyCount() int64 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
+}
+
+func (x *ThreadInfo) GetLastReplySeq() int64 {
+	if x != nil {
+		return x.LastReplySeq
+	}
+	return 0
+}
+
+func (x *ThreadInfo) GetLastReplyUserID() string {
+	if x != nil {
+		return x.LastReplyUserID
+	}
+	return ""
+}
+
+func (x *ThreadInfo) GetLastReplyTime() int64 {
+	if x != nil {
+		return x.LastReplyTime
+	}
+	return 0
+}
+
+func (x *ThreadInfo) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+// CreateThreadReq 以一条消息为根创建回复线程的请求参数，线程已存在时返回已有的线程
+type CreateThreadReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`                 // 创建者ID
+	ConversationID string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"` // 根消息所在的会话ID
+	Seq            int64  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq"`                      // 根消息的序列号
+}
+
+func (x *CreateThreadReq) Reset() {
+	*x = CreateThreadReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateThreadReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateThreadReq) ProtoMessage() {}
+
+func (x *CreateThreadReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateThreadReq.ProtoReflect.Descriptor instead.
+func (*CreateThreadReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateThreadReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *CreateThreadReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *CreateThreadReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+// CreateThreadResp 创建回复线程的响应结果
+type CreateThreadResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Thread *ThreadInfo `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread"` // 线程信息
+}
+
+func (x *CreateThreadResp) Reset() {
+	*x = CreateThreadResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateThreadResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateThreadResp) ProtoMessage() {}
+
+func (x *CreateThreadResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateThreadResp.ProtoReflect.Descriptor instead.
+func (*CreateThreadResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateThreadResp) GetThread() *ThreadInfo {
+	if x != nil {
+		return x.Thread
+	}
+	return nil
+}
+
+// SendThreadMsgReq 在线程中回复的请求参数
+type SendThreadMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ThreadID string         `protobuf:"bytes,1,opt,name=threadID,proto3" json:"threadID"` // 线程ID
+	MsgData  *sdkws.MsgData `protobuf:"bytes,2,opt,name=msgData,proto3" json:"msgData"`   // 回复的消息，会话相关字段以线程为准
+}
+
+func (x *SendThreadMsgReq) Reset() {
+	*x = SendThreadMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendThreadMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendThreadMsgReq) ProtoMessage() {}
+
+func (x *SendThreadMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendThreadMsgReq.ProtoReflect.Descriptor instead.
+func (*SendThreadMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SendThreadMsgReq) GetThreadID() string {
+	if x != nil {
+		return x.ThreadID
+	}
+	return ""
+}
+
+func (x *SendThreadMsgReq) GetMsgData() *sdkws.MsgData {
+	if x != nil {
+		return x.MsgData
+	}
+	return nil
+}
+
+// SendThreadMsgResp 在线程中回复的响应结果
+type SendThreadMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerMsgID string `protobuf:"bytes,1,opt,name=serverMsgID,proto3" json:"serverMsgID"` // 服务端消息ID
+	ClientMsgID string `protobuf:"bytes,2,opt,name=clientMsgID,proto3" json:"clientMsgID"` // 客户端消息ID
+	Seq         int64  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq"`                // 消息在线程中的序列号
+	SendTime    int64  `protobuf:"varint,4,opt,name=sendTime,proto3" json:"sendTime"`      // 发送时间，毫秒时间戳
+}
+
+func (x *SendThreadMsgResp) Reset() {
+	*x = SendThreadMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendThreadMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendThreadMsgResp) ProtoMessage() {}
+
+func (x *SendThreadMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendThreadMsgResp.ProtoReflect.Descriptor instead.
+func (*SendThreadMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SendThreadMsgResp) GetServerMsgID() string {
+	if x != nil {
+		return x.ServerMsgID
+	}
+	return ""
+}
+
+func (x *SendThreadMsgResp) GetClientMsgID() string {
+	if x != nil {
+		return x.ClientMsgID
+	}
+	return ""
+}
+
+func (x *SendThreadMsgResp) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *SendThreadMsgResp) GetSendTime() int64 {
+	if x != nil {
+		return x.SendTime
+	}
+	return 0
+}
+
+// PullThreadMsgsReq 拉取线程消息的请求参数
+type PullThreadMsgsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`      // 用户ID
+	ThreadID string `protobuf:"bytes,2,opt,name=threadID,proto3" json:"threadID"`  // 线程ID
+	BeginSeq int64  `protobuf:"varint,3,opt,name=beginSeq,proto3" json:"beginSeq"` // 起始序列号
+	EndSeq   int64  `protobuf:"varint,4,opt,name=endSeq,proto3" json:"endSeq"`     // 结束序列号
+	Num      int64  `protobuf:"varint,5,opt,name=num,proto3" json:"num"`           // 拉取数量
+}
+
+func (x *PullThreadMsgsReq) Reset() {
+	*x = PullThreadMsgsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PullThreadMsgsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullThreadMsgsReq) ProtoMessage() {}
+
+func (x *PullThreadMsgsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullThreadMsgsReq.ProtoReflect.Descriptor instead.
+func (*PullThreadMsgsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PullThreadMsgsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *PullThreadMsgsReq) GetThreadID() string {
+	if x != nil {
+		return x.ThreadID
+	}
+	return ""
+}
+
+func (x *PullThreadMsgsReq) GetBeginSeq() int64 {
+	if x != nil {
+		return x.BeginSeq
+	}
+	return 0
+}
+
+func (x *PullThreadMsgsReq) GetEndSeq() int64 {
+	if x != nil {
+		return x.EndSeq
+	}
+	return 0
+}
+
+func (x *PullThreadMsgsReq) GetNum() int64 {
+	if x != nil {
+		return x.Num
+	}
+	return 0
+}
+
+// PullThreadMsgsResp 拉取线程消息的响应结果
+type PullThreadMsgsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Msgs   []*sdkws.MsgData `protobuf:"bytes,1,rep,name=msgs,proto3" json:"msgs"`      // 消息列表
+	MinSeq int64            `protobuf:"varint,2,opt,name=minSeq,proto3" json:"minSeq"` // 线程的最小序列号
+	MaxSeq int64            `protobuf:"varint,3,opt,name=maxSeq,proto3" json:"maxSeq"` // 线程的最大序列号
+}
+
+func (x *PullThreadMsgsResp) Reset() {
+	*x = PullThreadMsgsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PullThreadMsgsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullThreadMsgsResp) ProtoMessage() {}
+
+func (x *PullThreadMsgsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullThreadMsgsResp.ProtoReflect.Descriptor instead.
+func (*PullThreadMsgsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PullThreadMsgsResp) GetMsgs() []*sdkws.MsgData {
+	if x != nil {
+		return x.Msgs
+	}
+	return nil
+}
+
+func (x *PullThreadMsgsResp) GetMinSeq() int64 {
+	if x != nil {
+		return x.MinSeq
+	}
+	return 0
+}
+
+func (x *PullThreadMsgsResp) GetMaxSeq() int64 {
+	if x != nil {
+		return x.MaxSeq
+	}
+	return 0
+}
+
+// SubscribeThreadReq 关注或取消关注线程的请求参数
+type SubscribeThreadReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID    string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`        // 用户ID
+	ThreadID  string `protobuf:"bytes,2,opt,name=threadID,proto3" json:"threadID"`    // 线程ID
+	Subscribe bool   `protobuf:"varint,3,opt,name=subscribe,proto3" json:"subscribe"` // true关注 false取消关注
+}
+
+func (x *SubscribeThreadReq) Reset() {
+	*x = SubscribeThreadReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeThreadReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeThreadReq) ProtoMessage() {}
+
+func (x *SubscribeThreadReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeThreadReq.ProtoReflect.Descriptor instead.
+func (*SubscribeThreadReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SubscribeThreadReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SubscribeThreadReq) GetThreadID() string {
+	if x != nil {
+		return x.ThreadID
+	}
+	return ""
+}
+
+func (x *SubscribeThreadReq) GetSubscribe() bool {
+	if x != nil {
+		return x.Subscribe
+	}
+	return false
+}
+
+// SubscribeThreadResp 关注或取消关注线程的响应结果
+type SubscribeThreadResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubscribeThreadResp) Reset() {
+	*x = SubscribeThreadResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeThreadResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeThreadResp) ProtoMessage() {}
+
+func (x *SubscribeThreadResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeThreadResp.ProtoReflect.Descriptor instead.
+func (*SubscribeThreadResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{31}
+}
+
+// MarkThreadAsReadReq 标记线程已读的请求参数
+type MarkThreadAsReadReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`          // 用户ID
+	ThreadID   string `protobuf:"bytes,2,opt,name=threadID,proto3" json:"threadID"`      // 线程ID
+	HasReadSeq int64  `protobuf:"varint,3,opt,name=hasReadSeq,proto3" json:"hasReadSeq"` // 已读到的序列号
+}
+
+func (x *MarkThreadAsReadReq) Reset() {
+	*x = MarkThreadAsReadReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkThreadAsReadReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkThreadAsReadReq) ProtoMessage() {}
+
+func (x *MarkThreadAsReadReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkThreadAsReadReq.ProtoReflect.Descriptor instead.
+func (*MarkThreadAsReadReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MarkThreadAsReadReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *MarkThreadAsReadReq) GetThreadID() string {
+	if x != nil {
+		return x.ThreadID
+	}
+	return ""
+}
+
+func (x *MarkThreadAsReadReq) GetHasReadSeq() int64 {
+	if x != nil {
+		return x.HasReadSeq
+	}
+	return 0
+}
+
+// MarkThreadAsReadResp 标记线程已读的响应结果
+type MarkThreadAsReadResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MarkThreadAsReadResp) Reset() {
+	*x = MarkThreadAsReadResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkThreadAsReadResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkThreadAsReadResp) ProtoMessage() {}
+
+func (x *MarkThreadAsReadResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkThreadAsReadResp.ProtoReflect.Descriptor instead.
+func (*MarkThreadAsReadResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{33}
+}
+
+// UserThread 用户关注的线程及未读数
+type UserThread struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Thread      *ThreadInfo `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread"`            // 线程信息
+	MaxSeq      int64       `protobuf:"varint,2,opt,name=maxSeq,proto3" json:"maxSeq"`           // 线程的最大序列号
+	HasReadSeq  int64       `protobuf:"varint,3,opt,name=hasReadSeq,proto3" json:"hasReadSeq"`   // 用户已读到的序列号
+	UnreadCount int64       `protobuf:"varint,4,opt,name=unreadCount,proto3" json:"unreadCount"` // 未读数
+}
+
+func (x *UserThread) Reset() {
+	*x = UserThread{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserThread) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserThread) ProtoMessage() {}
+
+func (x *UserThread) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserThread.ProtoReflect.Descriptor instead.
+func (*UserThread) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UserThread) GetThread() *ThreadInfo {
+	if x != nil {
+		return x.Thread
+	}
+	return nil
+}
+
+func (x *UserThread) GetMaxSeq() int64 {
+	if x != nil {
+		return x.MaxSeq
+	}
+	return 0
+}
+
+func (x *UserThread) GetHasReadSeq() int64 {
+	if x != nil {
+		return x.HasReadSeq
+	}
+	return 0
+}
+
+func (x *UserThread) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+// GetUserThreadsReq 查询用户关注的线程的请求参数
+type GetUserThreadsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string                   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`         // 用户ID
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"` // 分页参数
+}
+
+func (x *GetUserThreadsReq) Reset() {
+	*x = GetUserThreadsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserThreadsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserThreadsReq) ProtoMessage() {}
+
+func (x *GetUserThreadsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserThreadsReq.ProtoReflect.Descriptor instead.
+func (*GetUserThreadsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetUserThreadsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetUserThreadsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// GetUserThreadsResp 查询用户关注的线程的响应结果
+type GetUserThreadsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total   int64         `protobuf:"varint,1,opt,name=total,proto3" json:"total"`    // 总数
+	Threads []*UserThread `protobuf:"bytes,2,rep,name=threads,proto3" json:"threads"` // 线程列表，按最后参与时间倒序
+}
+
+func (x *GetUserThreadsResp) Reset() {
+	*x = GetUserThreadsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserThreadsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserThreadsResp) ProtoMessage() {}
+
+func (x *GetUserThreadsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserThreadsResp.ProtoReflect.Descriptor instead.
+func (*GetUserThreadsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetUserThreadsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetUserThreadsResp) GetThreads() []*UserThread {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+// ThreadReplyTips 线程收到回复的通知内容
+type ThreadReplyTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Thread  *ThreadInfo    `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread"`   // 回复后的线程信息
+	MsgData *sdkws.MsgData `protobuf:"bytes,2,opt,name=msgData,proto3" json:"msgData"` // 回复的消息
+}
+
+func (x *ThreadReplyTips) Reset() {
+	*x = ThreadReplyTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThreadReplyTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadReplyTips) ProtoMessage() {}
+
+func (x *ThreadReplyTips) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadReplyTips.ProtoReflect.Descriptor instead.
+func (*ThreadReplyTips) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ThreadReplyTips) GetThread() *ThreadInfo {
+	if x != nil {
+		return x.Thread
+	}
+	return nil
+}
+
+func (x *ThreadReplyTips) GetMsgData() *sdkws.MsgData {
+	if x != nil {
+		return x.MsgData
+	}
+	return nil
+}
+
+// PinnedMsg 会话中置顶的一条消息
+type PinnedMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq         int64          `protobuf:"varint,1,opt,name=seq,proto3" json:"seq"`                // 消息序列号
+	ClientMsgID string         `protobuf:"bytes,2,opt,name=clientMsgID,proto3" json:"clientMsgID"` // 客户端消息ID
+	PinUserID   string         `protobuf:"bytes,3,opt,name=pinUserID,proto3" json:"pinUserID"`     // 置顶者ID
+	PinTime     int64          `protobuf:"varint,4,opt,name=pinTime,proto3" json:"pinTime"`        // 置顶时间，毫秒时间戳
+	MsgData     *sdkws.MsgData `protobuf:"bytes,5,opt,name=msgData,proto3" json:"msgData"`         // 消息内容，消息已被查询者删除时为空
+}
+
+func (x *PinnedMsg) Reset() {
+	*x = PinnedMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinnedMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinnedMsg) ProtoMessage() {}
+
+func (x *PinnedMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinnedMsg.ProtoReflect.Descriptor instead.
+func (*PinnedMsg) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PinnedMsg) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *PinnedMsg) GetClientMsgID() string {
+	if x != nil {
+		return x.ClientMsgID
+	}
+	return ""
+}
+
+func (x *PinnedMsg) GetPinUserID() string {
+	if x != nil {
+		return x.PinUserID
+	}
+	return ""
+}
+
+func (x *PinnedMsg) GetPinTime() int64 {
+	if x != nil {
+		return x.PinTime
+	}
+	return 0
+}
+
+func (x *PinnedMsg) GetMsgData() *sdkws.MsgData {
+	if x != nil {
+		return x.MsgData
+	}
+	return nil
+}
+
+// PinMsgReq 置顶消息的请求参数
+type PinMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`                 // 操作者ID
+	ConversationID string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"` // 会话ID
+	Seq            int64  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq"`                      // 消息序列号
+}
+
+func (x *PinMsgReq) Reset() {
+	*x = PinMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinMsgReq) ProtoMessage() {}
+
+func (x *PinMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinMsgReq.ProtoReflect.Descriptor instead.
+func (*PinMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PinMsgReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *PinMsgReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *PinMsgReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+// PinMsgResp 置顶消息的响应结果
+type PinMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PinMsgResp) Reset() {
+	*x = PinMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinMsgResp) ProtoMessage() {}
+
+func (x *PinMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinMsgResp.ProtoReflect.Descriptor instead.
+func (*PinMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{40}
+}
+
+// UnpinMsgReq 取消置顶消息的请求参数
+type UnpinMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`                 // 操作者ID
+	ConversationID string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"` // 会话ID
+	Seq            int64  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq"`                      // 消息序列号
+}
+
+func (x *UnpinMsgReq) Reset() {
+	*x = UnpinMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnpinMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinMsgReq) ProtoMessage() {}
+
+func (x *UnpinMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinMsgReq.ProtoReflect.Descriptor instead.
+func (*UnpinMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UnpinMsgReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UnpinMsgReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *UnpinMsgReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+// UnpinMsgResp 取消置顶消息的响应结果
+type UnpinMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnpinMsgResp) Reset() {
+	*x = UnpinMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnpinMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinMsgResp) ProtoMessage() {}
+
+func (x *UnpinMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinMsgResp.ProtoReflect.Descriptor instead.
+func (*UnpinMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{42}
+}
+
+// GetPinnedMsgsReq 查询会话置顶消息的请求参数
+type GetPinnedMsgsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`                 // 查询者ID
+	ConversationID string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"` // 会话ID
+}
+
+func (x *GetPinnedMsgsReq) Reset() {
+	*x = GetPinnedMsgsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPinnedMsgsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPinnedMsgsReq) ProtoMessage() {}
+
+func (x *GetPinnedMsgsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPinnedMsgsReq.ProtoReflect.Descriptor instead.
+func (*GetPinnedMsgsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetPinnedMsgsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetPinnedMsgsReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+// GetPinnedMsgsResp 查询会话置顶消息的响应结果
+type GetPinnedMsgsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PinnedMsgs []*PinnedMsg `protobuf:"bytes,1,rep,name=pinnedMsgs,proto3" json:"pinnedMsgs"` // 置顶消息，按置顶时间排序
+}
+
+func (x *GetPinnedMsgsResp) Reset() {
+	*x = GetPinnedMsgsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPinnedMsgsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPinnedMsgsResp) ProtoMessage() {}
+
+func (x *GetPinnedMsgsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPinnedMsgsResp.ProtoReflect.Descriptor instead.
+func (*GetPinnedMsgsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetPinnedMsgsResp) GetPinnedMsgs() []*PinnedMsg {
+	if x != nil {
+		return x.PinnedMsgs
+	}
+	return nil
+}
+
+// MsgPinTips 置顶消息变更的通知内容
+type MsgPinTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpUserID       string  `protobuf:"bytes,1,opt,name=opUserID,proto3" json:"opUserID"`             // 操作者ID
+	ConversationID string  `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"` // 会话ID
+	SessionType    int32   `protobuf:"varint,3,opt,name=sessionType,proto3" json:"sessionType"`      // 会话类型
+	Seq            int64   `protobuf:"varint,4,opt,name=seq,proto3" json:"seq"`                      // 消息序列号
+	ClientMsgID    string  `protobuf:"bytes,5,opt,name=clientMsgID,proto3" json:"clientMsgID"`       // 客户端消息ID
+	Operation      int32   `protobuf:"varint,6,opt,name=operation,proto3" json:"operation"`          // 操作：1置顶 2取消置顶
+	OperateTime    int64   `protobuf:"varint,7,opt,name=operateTime,proto3" json:"operateTime"`      // 操作时间，毫秒时间戳
+	PinnedSeqs     []int64 `protobuf:"varint,8,rep,packed,name=pinnedSeqs,proto3" json:"pinnedSeqs"` // 操作后会话全部置顶消息的序列号，按置顶时间排序
+}
+
+func (x *MsgPinTips) Reset() {
+	*x = MsgPinTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgPinTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgPinTips) ProtoMessage() {}
+
+func (x *MsgPinTips) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgPinTips.ProtoReflect.Descriptor instead.
+func (*MsgPinTips) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MsgPinTips) GetOpUserID() string {
+	if x != nil {
+		return x.OpUserID
+	}
+	return ""
+}
+
+func (x *MsgPinTips) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *MsgPinTips) GetSessionType() int32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *MsgPinTips) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *MsgPinTips) GetClientMsgID() string {
+	if x != nil {
+		return x.ClientMsgID
+	}
+	return ""
+}
+
+func (x *MsgPinTips) GetOperation() int32 {
+	if x != nil {
+		return x.Operation
+	}
+	return 0
+}
+
+func (x *MsgPinTips) GetOperateTime() int64 {
+	if x != nil {
+		return x.OperateTime
+	}
+	return 0
+}
+
+func (x *MsgPinTips) GetPinnedSeqs() []int64 {
+	if x != nil {
+		return x.PinnedSeqs
+	}
+	return nil
+}
+
+// SearchConversationMsgsReq 全文检索用户所在会话消息的请求参数
+type SearchConversationMsgsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID          string                   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`                   // 查询者ID
+	Keyword         string                   `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword"`                 // 关键词，需要全部命中
+	ConversationIDs []string                 `protobuf:"bytes,3,rep,name=conversationIDs,proto3" json:"conversationIDs"` // 限定查询的会话，为空时查询用户的全部会话
+	Pagination      *sdkws.RequestPagination `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination"`           // 分页参数
+}
+
+func (x *SearchConversationMsgsReq) Reset() {
+	*x = SearchConversationMsgsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchConversationMsgsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchConversationMsgsReq) ProtoMessage() {}
+
+func (x *SearchConversationMsgsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchConversationMsgsReq.ProtoReflect.Descriptor instead.
+func (*SearchConversationMsgsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SearchConversationMsgsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SearchConversationMsgsReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchConversationMsgsReq) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+func (x *SearchConversationMsgsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// MsgHighlight 检索文本中命中的区间，按字符计，end不包含
+type MsgHighlight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start int32 `protobuf:"varint,1,opt,name=start,proto3" json:"start"` // 起始位置
+	End   int32 `protobuf:"varint,2,opt,name=end,proto3" json:"end"`     // 结束位置
+}
+
+func (x *MsgHighlight) Reset() {
+	*x = MsgHighlight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgHighlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgHighlight) ProtoMessage() {}
+
+func (x *MsgHighlight) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgHighlight.ProtoReflect.Descriptor instead.
+func (*MsgHighlight) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *MsgHighlight) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *MsgHighlight) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+// SearchedMsg 一条命中的消息
+type SearchedMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string          `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"` // 会话ID
+	MsgData        *sdkws.MsgData  `protobuf:"bytes,2,opt,name=msgData,proto3" json:"msgData"`               // 消息内容
+	Text           string          `protobuf:"bytes,3,opt,name=text,proto3" json:"text"`                     // 被检索的消息文本
+	Highlights     []*MsgHighlight `protobuf:"bytes,4,rep,name=highlights,proto3" json:"highlights"`         // 文本中命中关键词的区间
+}
+
+func (x *SearchedMsg) Reset() {
+	*x = SearchedMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchedMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchedMsg) ProtoMessage() {}
+
+func (x *SearchedMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchedMsg.ProtoReflect.Descriptor instead.
+func (*SearchedMsg) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SearchedMsg) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *SearchedMsg) GetMsgData() *sdkws.MsgData {
+	if x != nil {
+		return x.MsgData
+	}
+	return nil
+}
+
+func (x *SearchedMsg) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SearchedMsg) GetHighlights() []*MsgHighlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+// SearchConversationMsgsResp 全文检索用户所在会话消息的响应结果
+type SearchConversationMsgsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total int64          `protobuf:"varint,1,opt,name=total,proto3" json:"total"` // 命中的索引总数，包含查询者已删除的消息
+	Msgs  []*SearchedMsg `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs"`    // 命中的消息，按发送时间倒序
+}
+
+func (x *SearchConversationMsgsResp) Reset() {
+	*x = SearchConversationMsgsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchConversationMsgsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchConversationMsgsResp) ProtoMessage() {}
+
+func (x *SearchConversationMsgsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchConversationMsgsResp.ProtoReflect.Descriptor instead.
+func (*SearchConversationMsgsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SearchConversationMsgsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchConversationMsgsResp) GetMsgs() []*SearchedMsg {
+	if x != nil {
+		return x.Msgs
+	}
+	return nil
+}
+
+// GroupMsgReader 已读群消息的成员
+type GroupMsgReader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`      // 成员ID
+	ReadTime int64  `protobuf:"varint,2,opt,name=readTime,proto3" json:"readTime"` // 已读时间，毫秒时间戳
+}
+
+func (x *GroupMsgReader) Reset() {
+	*x = GroupMsgReader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupMsgReader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMsgReader) ProtoMessage() {}
+
+func (x *GroupMsgReader) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMsgReader.ProtoReflect.Descriptor instead.
+func (*GroupMsgReader) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GroupMsgReader) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GroupMsgReader) GetReadTime() int64 {
+	if x != nil {
+		return x.ReadTime
+	}
+	return 0
+}
+
+// GetGroupMsgReadersReq 查询群消息已读、未读成员的请求参数
+type GetGroupMsgReadersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`                 // 查询者ID
+	ConversationID string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"` // 会话ID
+	Seq            int64  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq"`                      // 消息序列号
+}
+
+func (x *GetGroupMsgReadersReq) Reset() {
+	*x = GetGroupMsgReadersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupMsgReadersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupMsgReadersReq) ProtoMessage() {}
+
+func (x *GetGroupMsgReadersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupMsgReadersReq.ProtoReflect.Descriptor instead.
+func (*GetGroupMsgReadersReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetGroupMsgReadersReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetGroupMsgReadersReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetGroupMsgReadersReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+// GetGroupMsgReadersResp 查询群消息已读、未读成员的响应结果，列表最多返回配置的人数
+type GetGroupMsgReadersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReadCount     int64             `protobuf:"varint,1,opt,name=readCount,proto3" json:"readCount"`        // 已读人数，不含发送者
+	UnreadCount   int64             `protobuf:"varint,2,opt,name=unreadCount,proto3" json:"unreadCount"`    // 未读人数，不含发送者
+	Readers       []*GroupMsgReader `protobuf:"bytes,3,rep,name=readers,proto3" json:"readers"`             // 已读成员，按已读时间排序
+	UnreadUserIDs []string          `protobuf:"bytes,4,rep,name=unreadUserIDs,proto3" json:"unreadUserIDs"` // 未读成员ID
+}
+
+func (x *GetGroupMsgReadersResp) Reset() {
+	*x = GetGroupMsgReadersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupMsgReadersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupMsgReadersResp) ProtoMessage() {}
+
+func (x *GetGroupMsgReadersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupMsgReadersResp.ProtoReflect.Descriptor instead.
+func (*GetGroupMsgReadersResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetGroupMsgReadersResp) GetReadCount() int64 {
+	if x != nil {
+		return x.ReadCount
+	}
+	return 0
+}
+
+func (x *GetGroupMsgReadersResp) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *GetGroupMsgReadersResp) GetReaders() []*GroupMsgReader {
+	if x != nil {
+		return x.Readers
+	}
+	return nil
+}
+
+func (x *GetGroupMsgReadersResp) GetUnreadUserIDs() []string {
+	if x != nil {
+		return x.UnreadUserIDs
+	}
+	return nil
+}
+
+// GroupMsgReadTips 群成员已读位置前进的通知内容，客户端把(prevHasReadSeq, hasReadSeq]内其他人发送的消息已读数加一
+type GroupMsgReadTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`  // 会话ID
+	GroupID        string `protobuf:"bytes,2,opt,name=groupID,proto3" json:"groupID"`                // 群ID
+	UserID         string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`                  // 已读的成员ID
+	PrevHasReadSeq int64  `protobuf:"varint,4,opt,name=prevHasReadSeq,proto3" json:"prevHasReadSeq"` // 之前的已读位置
+	HasReadSeq     int64  `protobuf:"varint,5,opt,name=hasReadSeq,proto3" json:"hasReadSeq"`         // 当前的已读位置
+	ReadTime       int64  `protobuf:"varint,6,opt,name=readTime,proto3" json:"readTime"`             // 已读时间，毫秒时间戳
+}
+
+func (x *GroupMsgReadTips) Reset() {
+	*x = GroupMsgReadTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupMsgReadTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMsgReadTips) ProtoMessage() {}
+
+func (x *GroupMsgReadTips) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMsgReadTips.ProtoReflect.Descriptor instead.
+func (*GroupMsgReadTips) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GroupMsgReadTips) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GroupMsgReadTips) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GroupMsgReadTips) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GroupMsgReadTips) GetPrevHasReadSeq() int64 {
+	if x != nil {
+		return x.PrevHasReadSeq
+	}
+	return 0
+}
+
+func (x *GroupMsgReadTips) GetHasReadSeq() int64 {
+	if x != nil {
+		return x.HasReadSeq
+	}
+	return 0
+}
+
+func (x *GroupMsgReadTips) GetReadTime() int64 {
+	if x != nil {
+		return x.ReadTime
+	}
+	return 0
+}
+
+// RetentionPolicy 消息保留策略
+type RetentionPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode           int32  `protobuf:"varint,1,opt,name=mode,proto3" json:"mode"`                    // 保留方式：0沿用上一级 1永久保留 2保留最近days天 3保留最近count条
+	Days           int32  `protobuf:"varint,2,opt,name=days,proto3" json:"days"`                    // 保留天数，mode为2时有效
+	Count          int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count"`                  // 保留条数，mode为3时有效
+	OperatorUserID string `protobuf:"bytes,4,opt,name=operatorUserID,proto3" json:"operatorUserID"` // 最后设置的管理员ID
+	UpdateTime     int64  `protobuf:"varint,5,opt,name=updateTime,proto3" json:"updateTime"`        // 最后设置时间，毫秒时间戳
+}
+
+func (x *RetentionPolicy) Reset() {
+	*x = RetentionPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetentionPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionPolicy) ProtoMessage() {}
+
+func (x *RetentionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionPolicy.ProtoReflect.Descriptor instead.
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RetentionPolicy) GetMode() int32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetOperatorUserID() string {
+	if x != nil {
+		return x.OperatorUserID
+	}
+	return ""
+}
+
+func (x *RetentionPolicy) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+// SetRetentionPolicyReq 设置会话或群消息保留策略的请求参数，conversationID和groupID二选一
+type SetRetentionPolicyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string           `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"` // 会话ID
+	GroupID        string           `protobuf:"bytes,2,opt,name=groupID,proto3" json:"groupID"`               // 群ID，对群会话生效
+	Policy         *RetentionPolicy `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy"`                 // 保留策略，mode为0时清除已设置的策略
+}
+
+func (x *SetRetentionPolicyReq) Reset() {
+	*x = SetRetentionPolicyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRetentionPolicyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRetentionPolicyReq) ProtoMessage() {}
+
+func (x *SetRetentionPolicyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRetentionPolicyReq.ProtoReflect.Descriptor instead.
+func (*SetRetentionPolicyReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SetRetentionPolicyReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *SetRetentionPolicyReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *SetRetentionPolicyReq) GetPolicy() *RetentionPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// SetRetentionPolicyResp 设置消息保留策略的响应结果
+type SetRetentionPolicyResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetRetentionPolicyResp) Reset() {
+	*x = SetRetentionPolicyResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRetentionPolicyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRetentionPolicyResp) ProtoMessage() {}
+
+func (x *SetRetentionPolicyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRetentionPolicyResp.ProtoReflect.Descriptor instead.
+func (*SetRetentionPolicyResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{56}
+}
+
+// GetRetentionPolicyReq 查询消息保留策略的请求参数，conversationID和groupID二选一
+type GetRetentionPolicyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"` // 会话ID
+	GroupID        string `protobuf:"bytes,2,opt,name=groupID,proto3" json:"groupID"`               // 群ID，查询群会话
+}
+
+func (x *GetRetentionPolicyReq) Reset() {
+	*x = GetRetentionPolicyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRetentionPolicyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRetentionPolicyReq) ProtoMessage() {}
+
+func (x *GetRetentionPolicyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRetentionPolicyReq.ProtoReflect.Descriptor instead.
+func (*GetRetentionPolicyReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetRetentionPolicyReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetRetentionPolicyReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+// GetRetentionPolicyResp 查询消息保留策略的响应结果
+type GetRetentionPolicyResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Conversation *RetentionPolicy `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation"` // 会话上设置的策略，未设置为空
+	Group        *RetentionPolicy `protobuf:"bytes,2,opt,name=group,proto3" json:"group"`               // 群上设置的策略，未设置或非群会话为空
+	Effective    *RetentionPolicy `protobuf:"bytes,3,opt,name=effective,proto3" json:"effective"`       // 最终生效的策略
+	Source       string           `protobuf:"bytes,4,opt,name=source,proto3" json:"source"`             // 生效策略的来源：conversation、group、default
+}
+
+func (x *GetRetentionPolicyResp) Reset() {
+	*x = GetRetentionPolicyResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRetentionPolicyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRetentionPolicyResp) ProtoMessage() {}
+
+func (x *GetRetentionPolicyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRetentionPolicyResp.ProtoReflect.Descriptor instead.
+func (*GetRetentionPolicyResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetRetentionPolicyResp) GetConversation() *RetentionPolicy {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
+}
+
+func (x *GetRetentionPolicyResp) GetGroup() *RetentionPolicy {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *GetRetentionPolicyResp) GetEffective() *RetentionPolicy {
+	if x != nil {
+		return x.Effective
+	}
+	return nil
+}
+
+func (x *GetRetentionPolicyResp) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// CreateMsgExportJobReq 创建消息导出任务的请求参数
+type CreateMsgExportJobReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`                 // 以该用户的视角导出，管理员可以指定任意用户
+	ConversationID string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"` // 会话ID，为空时导出该用户的全部会话
+	StartTime      int64  `protobuf:"varint,3,opt,name=startTime,proto3" json:"startTime"`          // 消息发送时间下限，毫秒时间戳，0表示不限
+	EndTime        int64  `protobuf:"varint,4,opt,name=endTime,proto3" json:"endTime"`              // 消息发送时间上限，毫秒时间戳，0表示创建任务的时间
+}
+
+func (x *CreateMsgExportJobReq) Reset() {
+	*x = CreateMsgExportJobReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateMsgExportJobReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMsgExportJobReq) ProtoMessage() {}
+
+func (x *CreateMsgExportJobReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMsgExportJobReq.ProtoReflect.Descriptor instead.
+func (*CreateMsgExportJobReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CreateMsgExportJobReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *CreateMsgExportJobReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *CreateMsgExportJobReq) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *CreateMsgExportJobReq) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+// CreateMsgExportJobResp 创建消息导出任务的响应结果
+type CreateMsgExportJobResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobID string `protobuf:"bytes,1,opt,name=jobID,proto3" json:"jobID"` // 任务ID
+}
+
+func (x *CreateMsgExportJobResp) Reset() {
+	*x = CreateMsgExportJobResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateMsgExportJobResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMsgExportJobResp) ProtoMessage() {}
+
+func (x *CreateMsgExportJobResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMsgExportJobResp.ProtoReflect.Descriptor instead.
+func (*CreateMsgExportJobResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CreateMsgExportJobResp) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+// MsgExportJob 消息导出任务
+type MsgExportJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobID              string `protobuf:"bytes,1,opt,name=jobID,proto3" json:"jobID"`                            // 任务ID
+	UserID             string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`                          // 导出视角的用户ID
+	ConversationID     string `protobuf:"bytes,3,opt,name=conversationID,proto3" json:"conversationID"`          // 会话ID，为空表示全部会话
+	StartTime          int64  `protobuf:"varint,4,opt,name=startTime,proto3" json:"startTime"`                   // 消息发送时间下限，毫秒时间戳
+	EndTime            int64  `protobuf:"varint,5,opt,name=endTime,proto3" json:"endTime"`                       // 消息发送时间上限，毫秒时间戳
+	Status             int32  `protobuf:"varint,6,opt,name=status,proto3" json:"status"`                         // 状态：1排队 2导出中 3完成 4失败
+	TotalConversations int64  `protobuf:"varint,7,opt,name=totalConversations,proto3" json:"totalConversations"` // 需要导出的会话数
+	DoneConversations  int64  `protobuf:"varint,8,opt,name=doneConversations,proto3" json:"doneConversations"`   // 已导出的会话数
+	MsgCount           int64  `protobuf:"varint,9,opt,name=msgCount,proto3" json:"msgCount"`                     // 已导出的消息数
+	Error              string `protobuf:"bytes,10,opt,name=error,proto3" json:"error"`                           // 失败原因
+	CreateTime         int64  `protobuf:"varint,11,opt,name=createTime,proto3" json:"createTime"`                // 创建时间，毫秒时间戳
+	UpdateTime         int64  `protobuf:"varint,12,opt,name=updateTime,proto3" json:"updateTime"`                // 最近进展时间，毫秒时间戳
+	JsonlURL           string `protobuf:"bytes,13,opt,name=jsonlURL,proto3" json:"jsonlURL"`                     // JSON Lines文件的签名下载链接，完成后返回
+	HtmlURL            string `protobuf:"bytes,14,opt,name=htmlURL,proto3" json:"htmlURL"`                       // HTML文件的签名下载链接，完成后返回
+	UrlExpireTime      int64  `protobuf:"varint,15,opt,name=urlExpireTime,proto3" json:"urlExpireTime"`          // 下载链接的过期时间，毫秒时间戳
+}
+
+func (x *MsgExportJob) Reset() {
+	*x = MsgExportJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgExportJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgExportJob) ProtoMessage() {}
+
+func (x *MsgExportJob) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgExportJob.ProtoReflect.Descriptor instead.
+func (*MsgExportJob) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *MsgExportJob) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+func (x *MsgExportJob) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *MsgExportJob) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *MsgExportJob) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *MsgExportJob) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *MsgExportJob) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *MsgExportJob) GetTotalConversations() int64 {
+	if x != nil {
+		return x.TotalConversations
+	}
+	return 0
+}
+
+func (x *MsgExportJob) GetDoneConversations() int64 {
+	if x != nil {
+		return x.DoneConversations
+	}
+	return 0
+}
+
+func (x *MsgExportJob) GetMsgCount() int64 {
+	if x != nil {
+		return x.MsgCount
+	}
+	return 0
+}
+
+func (x *MsgExportJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *MsgExportJob) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *MsgExportJob) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+func (x *MsgExportJob) GetJsonlURL() string {
+	if x != nil {
+		return x.JsonlURL
+	}
+	return ""
+}
+
+func (x *MsgExportJob) GetHtmlURL() string {
+	if x != nil {
+		return x.HtmlURL
+	}
+	return ""
+}
+
+func (x *MsgExportJob) GetUrlExpireTime() int64 {
+	if x != nil {
+		return x.UrlExpireTime
+	}
+	return 0
+}
+
+// GetMsgExportJobReq 查询消息导出任务的请求参数
+type GetMsgExportJobReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobID string `protobuf:"bytes,1,opt,name=jobID,proto3" json:"jobID"` // 任务ID
+}
+
+func (x *GetMsgExportJobReq) Reset() {
+	*x = GetMsgExportJobReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMsgExportJobReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMsgExportJobReq) ProtoMessage() {}
+
+func (x *GetMsgExportJobReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMsgExportJobReq.ProtoReflect.Descriptor instead.
+func (*GetMsgExportJobReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetMsgExportJobReq) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+// GetMsgExportJobResp 查询消息导出任务的响应结果
+type GetMsgExportJobResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job *MsgExportJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job"` // 任务进度，完成后包含下载链接
+}
+
+func (x *GetMsgExportJobResp) Reset() {
+	*x = GetMsgExportJobResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMsgExportJobResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMsgExportJobResp) ProtoMessage() {}
+
+func (x *GetMsgExportJobResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMsgExportJobResp.ProtoReflect.Descriptor instead.
+func (*GetMsgExportJobResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetMsgExportJobResp) GetJob() *MsgExportJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// BroadcastTarget 广播的目标用户
+type BroadcastTarget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type            int32    `protobuf:"varint,1,opt,name=type,proto3" json:"type"`                              // 目标类型：1全部用户 2指定用户 3指定群的成员 4按条件筛选的用户
+	UserIDs         []string `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs"`                         // type为2时的用户ID列表
+	GroupIDs        []string `protobuf:"bytes,3,rep,name=groupIDs,proto3" json:"groupIDs"`                       // type为3时的群ID列表，同时在多个群中的用户只发送一次
+	CreateTimeBegin int64    `protobuf:"varint,4,opt,name=createTimeBegin,proto3" json:"createTimeBegin"`        // type为4时用户注册时间下限，毫秒时间戳，0表示不限
+	CreateTimeEnd   int64    `protobuf:"varint,5,opt,name=createTimeEnd,proto3" json:"createTimeEnd"`            // type为4时用户注册时间上限（不含），毫秒时间戳，0表示不限
+	AppMangerLevels []int32  `protobuf:"varint,6,rep,packed,name=appMangerLevels,proto3" json:"appMangerLevels"` // type为4时用户的appMangerLevel，为空表示不限
+}
+
+func (x *BroadcastTarget) Reset() {
+	*x = BroadcastTarget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BroadcastTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastTarget) ProtoMessage() {}
+
+func (x *BroadcastTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastTarget.ProtoReflect.Descriptor instead.
+func (*BroadcastTarget) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *BroadcastTarget) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *BroadcastTarget) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+func (x *BroadcastTarget) GetGroupIDs() []string {
+	if x != nil {
+		return x.GroupIDs
+	}
+	return nil
+}
+
+func (x *BroadcastTarget) GetCreateTimeBegin() int64 {
+	if x != nil {
+		return x.CreateTimeBegin
+	}
+	return 0
+}
+
+func (x *BroadcastTarget) GetCreateTimeEnd() int64 {
+	if x != nil {
+		return x.CreateTimeEnd
+	}
+	return 0
+}
+
+func (x *BroadcastTarget) GetAppMangerLevels() []int32 {
+	if x != nil {
+		return x.AppMangerLevels
+	}
+	return nil
+}
+
+// CreateBroadcastJobReq 创建广播任务的请求参数
+type CreateBroadcastJobReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MsgData *sdkws.MsgData   `protobuf:"bytes,1,opt,name=msgData,proto3" json:"msgData"` // 消息模板，sessionType为单聊或通知，recvID和clientMsgID由任务为每个接收者生成
+	Target  *BroadcastTarget `protobuf:"bytes,2,opt,name=target,proto3" json:"target"`   // 目标用户
+	Rate    int32            `protobuf:"varint,3,opt,name=rate,proto3" json:"rate"`      // 每秒发送的消息数，0表示使用默认值
+}
+
+func (x *CreateBroadcastJobReq) Reset() {
+	*x = CreateBroadcastJobReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBroadcastJobReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBroadcastJobReq) ProtoMessage() {}
+
+func (x *CreateBroadcastJobReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBroadcastJobReq.ProtoReflect.Descriptor instead.
+func (*CreateBroadcastJobReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CreateBroadcastJobReq) GetMsgData() *sdkws.MsgData {
+	if x != nil {
+		return x.MsgData
+	}
+	return nil
+}
+
+func (x *CreateBroadcastJobReq) GetTarget() *BroadcastTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *CreateBroadcastJobReq) GetRate() int32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+// CreateBroadcastJobResp 创建广播任务的响应结果
+type CreateBroadcastJobResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobID string `protobuf:"bytes,1,opt,name=jobID,proto3" json:"jobID"` // 任务ID
+}
+
+func (x *CreateBroadcastJobResp) Reset() {
+	*x = CreateBroadcastJobResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBroadcastJobResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBroadcastJobResp) ProtoMessage() {}
+
+func (x *CreateBroadcastJobResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBroadcastJobResp.ProtoReflect.Descriptor instead.
+func (*CreateBroadcastJobResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CreateBroadcastJobResp) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+// BroadcastJob 广播任务，按接收者的用户ID升序发送
+type BroadcastJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobID       string           `protobuf:"bytes,1,opt,name=jobID,proto3" json:"jobID"`              // 任务ID
+	OpUserID    string           `protobuf:"bytes,2,opt,name=opUserID,proto3" json:"opUserID"`        // 创建任务的管理员
+	SendID      string           `protobuf:"bytes,3,opt,name=sendID,proto3" json:"sendID"`            // 发送者ID
+	Target      *BroadcastTarget `protobuf:"bytes,4,opt,name=target,proto3" json:"target"`            // 目标用户
+	Rate        int32            `protobuf:"varint,5,opt,name=rate,proto3" json:"rate"`               // 每秒发送的消息数
+	Status      int32            `protobuf:"varint,6,opt,name=status,proto3" json:"status"`           // 状态：1排队 2发送中 3已暂停 4已取消 5已完成 6失败
+	TotalCount  int64            `protobuf:"varint,7,opt,name=totalCount,proto3" json:"totalCount"`   // 创建任务时的目标用户数
+	SentCount   int64            `protobuf:"varint,8,opt,name=sentCount,proto3" json:"sentCount"`     // 发送成功的接收者数
+	FailedCount int64            `protobuf:"varint,9,opt,name=failedCount,proto3" json:"failedCount"` // 发送失败的接收者数
+	Cursor      string           `protobuf:"bytes,10,opt,name=cursor,proto3" json:"cursor"`           // 已处理到的接收者ID
+	Error       string           `protobuf:"bytes,11,opt,name=error,proto3" json:"error"`             // 任务失败的原因
+	CreateTime  int64            `protobuf:"varint,12,opt,name=createTime,proto3" json:"createTime"`  // 创建时间，毫秒时间戳
+	UpdateTime  int64            `protobuf:"varint,13,opt,name=updateTime,proto3" json:"updateTime"`  // 最近进展时间，毫秒时间戳
+	FinishTime  int64            `protobuf:"varint,14,opt,name=finishTime,proto3" json:"finishTime"`  // 结束时间，毫秒时间戳
+}
+
+func (x *BroadcastJob) Reset() {
+	*x = BroadcastJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BroadcastJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastJob) ProtoMessage() {}
+
+func (x *BroadcastJob) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastJob.ProtoReflect.Descriptor instead.
+func (*BroadcastJob) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *BroadcastJob) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+func (x *BroadcastJob) GetOpUserID() string {
+	if x != nil {
+		return x.OpUserID
+	}
+	return ""
+}
+
+func (x *BroadcastJob) GetSendID() string {
+	if x != nil {
+		return x.SendID
+	}
+	return ""
+}
+
+func (x *BroadcastJob) GetTarget() *BroadcastTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *BroadcastJob) GetRate() int32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *BroadcastJob) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *BroadcastJob) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *BroadcastJob) GetSentCount() int64 {
+	if x != nil {
+		return x.SentCount
+	}
+	return 0
+}
+
+func (x *BroadcastJob) GetFailedCount() int64 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *BroadcastJob) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *BroadcastJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BroadcastJob) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *BroadcastJob) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+func (x *BroadcastJob) GetFinishTime() int64 {
+	if x != nil {
+		return x.FinishTime
+	}
+	return 0
+}
+
+// GetBroadcastJobReq 查询广播任务的请求参数
+type GetBroadcastJobReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobID string `protobuf:"bytes,1,opt,name=jobID,proto3" json:"jobID"` // 任务ID
+}
+
+func (x *GetBroadcastJobReq) Reset() {
+	*x = GetBroadcastJobReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBroadcastJobReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBroadcastJobReq) ProtoMessage() {}
+
+func (x *GetBroadcastJobReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBroadcastJobReq.ProtoReflect.Descriptor instead.
+func (*GetBroadcastJobReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetBroadcastJobReq) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+// GetBroadcastJobResp 查询广播任务的响应结果
+type GetBroadcastJobResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job *BroadcastJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job"` // 任务进度
+}
+
+func (x *GetBroadcastJobResp) Reset() {
+	*x = GetBroadcastJobResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBroadcastJobResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBroadcastJobResp) ProtoMessage() {}
+
+func (x *GetBroadcastJobResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBroadcastJobResp.ProtoReflect.Descriptor instead.
+func (*GetBroadcastJobResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetBroadcastJobResp) GetJob() *BroadcastJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// GetBroadcastJobsReq 分页查询广播任务的请求参数
+type GetBroadcastJobsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status     []int32                  `protobuf:"varint,1,rep,packed,name=status,proto3" json:"status"` // 按状态过滤，为空表示全部
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"` // 分页参数
+}
+
+func (x *GetBroadcastJobsReq) Reset() {
+	*x = GetBroadcastJobsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBroadcastJobsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBroadcastJobsReq) ProtoMessage() {}
+
+func (x *GetBroadcastJobsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBroadcastJobsReq.ProtoReflect.Descriptor instead.
+func (*GetBroadcastJobsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetBroadcastJobsReq) GetStatus() []int32 {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetBroadcastJobsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// GetBroadcastJobsResp 广播任务，按创建时间倒序
+type GetBroadcastJobsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total int64           `protobuf:"varint,1,opt,name=total,proto3" json:"total"` // 总数
+	Jobs  []*BroadcastJob `protobuf:"bytes,2,rep,name=jobs,proto3" json:"jobs"`    // 任务列表
+}
+
+func (x *GetBroadcastJobsResp) Reset() {
+	*x = GetBroadcastJobsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBroadcastJobsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBroadcastJobsResp) ProtoMessage() {}
+
+func (x *GetBroadcastJobsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBroadcastJobsResp.ProtoReflect.Descriptor instead.
+func (*GetBroadcastJobsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetBroadcastJobsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetBroadcastJobsResp) GetJobs() []*BroadcastJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+// PauseBroadcastJobReq 暂停排队或发送中的广播任务
+type PauseBroadcastJobReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobID string `protobuf:"bytes,1,opt,name=jobID,proto3" json:"jobID"` // 任务ID
+}
+
+func (x *PauseBroadcastJobReq) Reset() {
+	*x = PauseBroadcastJobReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseBroadcastJobReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseBroadcastJobReq) ProtoMessage() {}
+
+func (x *PauseBroadcastJobReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseBroadcastJobReq.ProtoReflect.Descriptor instead.
+func (*PauseBroadcastJobReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *PauseBroadcastJobReq) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+// PauseBroadcastJobResp 暂停广播任务的响应结果
+type PauseBroadcastJobResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PauseBroadcastJobResp) Reset() {
+	*x = PauseBroadcastJobResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseBroadcastJobResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseBroadcastJobResp) ProtoMessage() {}
+
+func (x *PauseBroadcastJobResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseBroadcastJobResp.ProtoReflect.Descriptor instead.
+func (*PauseBroadcastJobResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{73}
+}
+
+// ResumeBroadcastJobReq 从暂停的位置继续广播任务
+type ResumeBroadcastJobReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobID string `protobuf:"bytes,1,opt,name=jobID,proto3" json:"jobID"` // 任务ID
+}
+
+func (x *ResumeBroadcastJobReq) Reset() {
+	*x = ResumeBroadcastJobReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeBroadcastJobReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeBroadcastJobReq) ProtoMessage() {}
+
+func (x *ResumeBroadcastJobReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeBroadcastJobReq.ProtoReflect.Descriptor instead.
+func (*ResumeBroadcastJobReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ResumeBroadcastJobReq) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+// ResumeBroadcastJobResp 继续广播任务的响应结果
+type ResumeBroadcastJobResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ResumeBroadcastJobResp) Reset() {
+	*x = ResumeBroadcastJobResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeBroadcastJobResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeBroadcastJobResp) ProtoMessage() {}
+
+func (x *ResumeBroadcastJobResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeBroadcastJobResp.ProtoReflect.Descriptor instead.
+func (*ResumeBroadcastJobResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{75}
+}
+
+// CancelBroadcastJobReq 取消未结束的广播任务
+type CancelBroadcastJobReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobID string `protobuf:"bytes,1,opt,name=jobID,proto3" json:"jobID"` // 任务ID
+}
+
+func (x *CancelBroadcastJobReq) Reset() {
+	*x = CancelBroadcastJobReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelBroadcastJobReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBroadcastJobReq) ProtoMessage() {}
+
+func (x *CancelBroadcastJobReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBroadcastJobReq.ProtoReflect.Descriptor instead.
+func (*CancelBroadcastJobReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CancelBroadcastJobReq) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+// CancelBroadcastJobResp 取消广播任务的响应结果
+type CancelBroadcastJobResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelBroadcastJobResp) Reset() {
+	*x = CancelBroadcastJobResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelBroadcastJobResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBroadcastJobResp) ProtoMessage() {}
+
+func (x *CancelBroadcastJobResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBroadcastJobResp.ProtoReflect.Descriptor instead.
+func (*CancelBroadcastJobResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{77}
+}
+
+// BroadcastFailure 广播任务中发送失败的接收者
+type BroadcastFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"` // 接收者ID
+	Error  string `protobuf:"bytes,2,opt,name=error,proto3" json:"error"`   // 失败原因
+	Time   int64  `protobuf:"varint,3,opt,name=time,proto3" json:"time"`    // 失败时间，毫秒时间戳
+}
+
+func (x *BroadcastFailure) Reset() {
+	*x = BroadcastFailure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BroadcastFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastFailure) ProtoMessage() {}
+
+func (x *BroadcastFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastFailure.ProtoReflect.Descriptor instead.
+func (*BroadcastFailure) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *BroadcastFailure) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *BroadcastFailure) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BroadcastFailure) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+// GetBroadcastFailuresReq 分页查询广播任务发送失败的接收者
+type GetBroadcastFailuresReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobID      string                   `protobuf:"bytes,1,opt,name=jobID,proto3" json:"jobID"`           // 任务ID
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"` // 分页参数
+}
+
+func (x *GetBroadcastFailuresReq) Reset() {
+	*x = GetBroadcastFailuresReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBroadcastFailuresReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBroadcastFailuresReq) ProtoMessage() {}
+
+func (x *GetBroadcastFailuresReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBroadcastFailuresReq.ProtoReflect.Descriptor instead.
+func (*GetBroadcastFailuresReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetBroadcastFailuresReq) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+func (x *GetBroadcastFailuresReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// GetBroadcastFailuresResp 发送失败的接收者，按用户ID升序
+type GetBroadcastFailuresResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total    int64               `protobuf:"varint,1,opt,name=total,proto3" json:"total"`      // 总数
+	Failures []*BroadcastFailure `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures"` // 失败列表
+}
+
+func (x *GetBroadcastFailuresResp) Reset() {
+	*x = GetBroadcastFailuresResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBroadcastFailuresResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBroadcastFailuresResp) ProtoMessage() {}
+
+func (x *GetBroadcastFailuresResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBroadcastFailuresResp.ProtoReflect.Descriptor instead.
+func (*GetBroadcastFailuresResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetBroadcastFailuresResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetBroadcastFailuresResp) GetFailures() []*BroadcastFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+// GetUnreadCountReq 查询用户的未读消息数
+type GetUnreadCountReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"` // 用户ID
+}
+
+func (x *GetUnreadCountReq) Reset() {
+	*x = GetUnreadCountReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUnreadCountReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountReq) ProtoMessage() {}
+
+func (x *GetUnreadCountReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountReq.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetUnreadCountReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+// GetUnreadCountResp 用户的未读消息数，即各会话maxSeq与hasReadSeq之差
+type GetUnreadCountResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total               int64            `protobuf:"varint,1,opt,name=total,proto3" json:"total"`                                                                                                                     // 未读总数，不包括接收选项不是正常接收的会话，离线推送时作为iOS角标
+	ConversationUnreads map[string]int64 `protobuf:"bytes,2,rep,name=conversationUnreads,proto3" json:"conversationUnreads,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // 每个会话的未读数，包括不计入总数的会话
+}
+
+func (x *GetUnreadCountResp) Reset() {
+	*x = GetUnreadCountResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUnreadCountResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountResp) ProtoMessage() {}
+
+func (x *GetUnreadCountResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountResp.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetUnreadCountResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetUnreadCountResp) GetConversationUnreads() map[string]int64 {
+	if x != nil {
+		return x.ConversationUnreads
+	}
+	return nil
+}
+
+// GetUsersUnreadCountReq 批量查询用户的未读总数，供推送服务使用
+type GetUsersUnreadCountReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"` // 用户ID列表
+}
+
+func (x *GetUsersUnreadCountReq) Reset() {
+	*x = GetUsersUnreadCountReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsersUnreadCountReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersUnreadCountReq) ProtoMessage() {}
+
+func (x *GetUsersUnreadCountReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersUnreadCountReq.ProtoReflect.Descriptor instead.
+func (*GetUsersUnreadCountReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetUsersUnreadCountReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+// GetUsersUnreadCountResp 批量查询用户未读总数的响应结果
+type GetUsersUnreadCountResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Totals map[string]int64 `protobuf:"bytes,1,rep,name=totals,proto3" json:"totals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // 用户ID到未读总数
+}
+
+func (x *GetUsersUnreadCountResp) Reset() {
+	*x = GetUsersUnreadCountResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsersUnreadCountResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersUnreadCountResp) ProtoMessage() {}
+
+func (x *GetUsersUnreadCountResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersUnreadCountResp.ProtoReflect.Descriptor instead.
+func (*GetUsersUnreadCountResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetUsersUnreadCountResp) GetTotals() map[string]int64 {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+var File_msgext_msgext_proto protoreflect.FileDescriptor
+
+var file_msgext_msgext_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2f, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67,
+	0x65, 0x78, 0x74, 0x1a, 0x11, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2f, 0x73, 0x64, 0x6b, 0x77, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x78, 0x0a, 0x0a, 0x45, 0x64, 0x69, 0x74, 0x4d, 0x73,
+	0x67, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x22, 0x29, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x64, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xa9, 0x02, 0x0a, 0x0b,
+	0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x54, 0x69, 0x70, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65,
+	0x64, 0x69, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x64, 0x69,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x64, 0x69,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x45, 0x64, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x45, 0x64, 0x69, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x45,
+	0x64, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x64, 0x69,
+	0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a,
+	0x0b, 0x70, 0x72, 0x65, 0x76, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x76, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x64, 0x69,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x64, 0x69,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x68, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x45,
+	0x64, 0x69, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x22,
+	0x4e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x65, 0x74, 0x69,
+	0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22,
+	0xec, 0x01, 0x0a, 0x0c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x44,
+	0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e,
+	0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69,
+	0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x5c,
+	0x0a, 0x0e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71,
+	0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e,
+	0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x31, 0x0a, 0x0f,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x22,
+	0x85, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3e, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x65,
+	0x74, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5c, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65,
+	0x78, 0x74, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x52,
+	0x04, 0x6d, 0x73, 0x67, 0x73, 0x22, 0x37, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x1e,
+	0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x22, 0x18,
+	0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x4e, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x53, 0x0a,
+	0x0b, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f,
+	0x6a, 0x69, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x73, 0x22, 0x7b, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x6f,
+	0x6a, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x22,
+	0x4d, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d,
+	0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7e,
+	0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x6a,
+	0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x22, 0x50,
+	0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x65, 0x74,
+	0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x66, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x22, 0x4e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x37, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78,
+	0x74, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x97, 0x02, 0x0a, 0x0f, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x70, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73,
+	0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49,
+	0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d,
+	0x73, 0x67, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x12, 0x1c, 0x0a, 0x09,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0xaa, 0x03, 0x0a, 0x0a, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x44, 0x12, 0x26, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x65, 0x71,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x65, 0x71, 0x12,
+	0x28, 0x0a, 0x0f, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67,
+	0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
+	0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6c,
+	0x61, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x53, 0x65, 0x71, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x53, 0x65, 0x71, 0x12,
+	0x28, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x61, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x63, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x73, 0x65, 0x71, 0x22, 0x44, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x06, 0x74, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d,
+	0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x22, 0x5e, 0x0a, 0x10, 0x53, 0x65,
+	0x6e, 0x64, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x1a,
+	0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x73,
+	0x67, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x65,
+	0x74, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x22, 0x85, 0x01, 0x0a, 0x11, 0x53,
+	0x65, 0x6e, 0x64, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67,
+	0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d,
+	0x73, 0x67, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x11, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x62, 0x65, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x62, 0x65, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x64, 0x53,
+	0x65, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x71,
+	0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6e,
+	0x75, 0x6d, 0x22, 0x6e, 0x0a, 0x12, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x04, 0x6d, 0x73, 0x67, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x73,
+	0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x73,
+	0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61,
+	0x78, 0x53, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x53,
+	0x65, 0x71, 0x22, 0x66, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x69, 0x0a, 0x13, 0x4d, 0x61, 0x72, 0x6b, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x41,
+	0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a,
+	0x68, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x68, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x22, 0x16, 0x0a, 0x14,
+	0x4d, 0x61, 0x72, 0x6b, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x98, 0x01, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65,
+	0x78, 0x74, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x74,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x12, 0x1e, 0x0a,
+	0x0a, 0x68, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x12, 0x20, 0x0a,
+	0x0b, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x6b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x3e, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5e, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x07, 0x74, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x65, 0x74, 0x69,
+	0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x52, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x22, 0x73, 0x0a, 0x0f,
+	0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x69, 0x70, 0x73, 0x12,
+	0x30, 0x0a, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73,
+	0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74,
+	0x61, 0x22, 0xa7, 0x01, 0x0a, 0x09, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65,
+	0x71, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73,
+	0x67, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x70, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x6d,
+	0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61,
+	0x65, 0x74, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x22, 0x5d, 0x0a, 0x09, 0x50,
+	0x69, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x22, 0x0c, 0x0a, 0x0a, 0x50, 0x69,
+	0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x5f, 0x0a, 0x0b, 0x55, 0x6e, 0x70, 0x69,
+	0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x22, 0x0e, 0x0a, 0x0c, 0x55, 0x6e, 0x70,
+	0x69, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x52, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x4c, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d,
+	0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x52,
+	0x0a, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x22, 0x86, 0x02, 0x0a, 0x0a,
+	0x4d, 0x73, 0x67, 0x50, 0x69, 0x6e, 0x54, 0x69, 0x70, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x20,
+	0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73,
+	0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49,
+	0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d,
+	0x73, 0x67, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x53, 0x65,
+	0x71, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64,
+	0x53, 0x65, 0x71, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65,
+	0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x12, 0x3e,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x36,
+	0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0xb5, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x2e,
+	0x0a, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73,
+	0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d,
+	0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x73, 0x67, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x52, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22, 0x61,
+	0x0a, 0x1a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x2d, 0x0a, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x6d, 0x73, 0x67,
+	0x73, 0x22, 0x44, 0x0a, 0x0e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72,
+	0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x69, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73,
+	0x65, 0x71, 0x22, 0xb6, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a,
+	0x09, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x75,
+	0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a,
+	0x07, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x72, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x75, 0x6e,
+	0x72, 0x65, 0x61, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x10,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x64, 0x54, 0x69, 0x70, 0x73,
+	0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72,
+	0x65, 0x76, 0x48, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x48, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53,
+	0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53,
+	0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x97,
+	0x01, 0x0a, 0x0f, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67,
+	0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x18, 0x0a, 0x16, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x59, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x12, 0x26,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
+	0x22, 0xe5, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x41, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74,
+	0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33,
+	0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x05, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x3b, 0x0a, 0x09, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d,
+	0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x09, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2e, 0x0a, 0x16, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x22, 0xe0, 0x03, 0x0a, 0x0c, 0x4d,
+	0x73, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x14, 0x0a, 0x05, 0x6a,
+	0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x2e, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x64, 0x6f,
+	0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x6d, 0x73, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x6d, 0x73, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x6c, 0x55, 0x52, 0x4c, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x6c, 0x55, 0x52, 0x4c, 0x12, 0x18, 0x0a,
+	0x07, 0x68, 0x74, 0x6d, 0x6c, 0x55, 0x52, 0x4c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x68, 0x74, 0x6d, 0x6c, 0x55, 0x52, 0x4c, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x72, 0x6c, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x75, 0x72, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2a, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x22, 0x43, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x4d, 0x73, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x2c, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x73, 0x67,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0xd5,
+	0x01, 0x0a, 0x0f, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x0f,
+	0x61, 0x70, 0x70, 0x4d, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x4d, 0x61, 0x6e, 0x67, 0x65, 0x72,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71,
+	0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e,
+	0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x35, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0x2e, 0x0a, 0x16, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x22, 0xa9, 0x03, 0x0a, 0x0c,
+	0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x14, 0x0a, 0x05,
+	0x6a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d,
+	0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x74,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a,
+	0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f,
+	0x62, 0x49, 0x44, 0x22, 0x43, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x03, 0x6a, 0x6f,
+	0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e,
+	0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74,
+	0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x6d, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x42,
+	0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3e, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x65,
+	0x74, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5c, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65,
+	0x78, 0x74, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x52,
+	0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0x2c, 0x0a, 0x14, 0x50, 0x61, 0x75, 0x73, 0x65, 0x42, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a,
+	0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f,
+	0x62, 0x49, 0x44, 0x22, 0x17, 0x0a, 0x15, 0x50, 0x61, 0x75, 0x73, 0x65, 0x42, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2d, 0x0a, 0x15,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x22, 0x18, 0x0a, 0x16, 0x52,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2d, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42,
+	0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x12, 0x14,
+	0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a,
+	0x6f, 0x62, 0x49, 0x44, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x22, 0x54,
+	0x0a, 0x10, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x22, 0x6f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x3e, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x65, 0x74, 0x69,
+	0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x3a, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x65, 0x74, 0x69,
+	0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x73, 0x22, 0x2b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x22, 0xdf, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x6b, 0x0a,
+	0x13, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x61, 0x65, 0x74,
+	0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72,
+	0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x73, 0x1a, 0x46, 0x0a, 0x18, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x32, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x55, 0x6e,
+	0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x49, 0x0a, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x31, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x1a, 0x39, 0x0a,
+	0x0b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xf8, 0x16, 0x0a, 0x06, 0x6d, 0x73, 0x67,
+	0x45, 0x78, 0x74, 0x12, 0x3e, 0x0a, 0x07, 0x45, 0x64, 0x69, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x18,
+	0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x45, 0x64,
+	0x69, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d,
+	0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69,
+	0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x22, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d,
+	0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x45, 0x64,
+	0x69, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x61,
+	0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d,
+	0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x4a, 0x0a, 0x0b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67,
+	0x12, 0x1c, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1d,
+	0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x59, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x73, 0x12, 0x21, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67,
+	0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5f, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x23,
+	0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65,
+	0x78, 0x74, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x50, 0x0a, 0x0d, 0x52, 0x65, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x1e, 0x2e, 0x61, 0x65, 0x74,
+	0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x61, 0x65, 0x74,
+	0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x53, 0x0a, 0x0e, 0x41,
+	0x64, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e,
+	0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x41, 0x64, 0x64,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x20,
+	0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x41, 0x64,
+	0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x5c, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x61, 0x65, 0x74, 0x69,
+	0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x20, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65,
+	0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x1d, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d,
+	0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x50, 0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x1e, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d,
+	0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d,
+	0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x53, 0x0a, 0x0e, 0x50, 0x75, 0x6c, 0x6c, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x65, 0x74, 0x69,
+	0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x61, 0x65, 0x74,
+	0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0f,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12,
+	0x20, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x1a, 0x21, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x59, 0x0a, 0x10, 0x4d, 0x61, 0x72, 0x6b, 0x54, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x21, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d,
+	0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x54, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x61, 0x65,
+	0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x53, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x73, 0x12, 0x1f, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x20, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x3b, 0x0a, 0x06, 0x50, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x17,
+	0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x69,
+	0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e,
+	0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x41, 0x0a, 0x08, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x19, 0x2e,
+	0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x55, 0x6e, 0x70,
+	0x69, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d,
+	0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x50, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65,
+	0x64, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6b, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x73,
+	0x12, 0x27, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x61, 0x65, 0x74, 0x69,
+	0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x5f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x23, 0x2e, 0x61, 0x65, 0x74, 0x69,
+	0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x24,
+	0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x5f, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x23, 0x2e, 0x61, 0x65, 0x74,
+	0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x1a,
+	0x24, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x23, 0x2e, 0x61, 0x65,
+	0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71,
+	0x1a, 0x24, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5f, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4d, 0x73, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x23, 0x2e, 0x61,
+	0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x71, 0x1a, 0x24, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x73,
+	0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x20, 0x2e, 0x61, 0x65, 0x74,
+	0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x61,
+	0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d,
+	0x73, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x5f, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x23, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x61, 0x65, 0x74,
+	0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x56, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74,
+	0x4a, 0x6f, 0x62, 0x12, 0x20, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65,
+	0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x12, 0x59, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42,
+	0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x21, 0x2e, 0x61,
+	0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42,
+	0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x22, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x11, 0x50, 0x61, 0x75, 0x73, 0x65, 0x42, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x22, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d,
+	0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x42, 0x72, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x61,
+	0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x61, 0x75, 0x73,
+	0x65, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x5f, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x42, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x23, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e,
+	0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x42, 0x72, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x61,
+	0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x5f, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x23, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d,
+	0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e,
+	0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x65, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x61, 0x65,
+	0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x26, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x53, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x61,
+	0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e,
+	0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x62, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x55, 0x6e, 0x72, 0x65, 0x61,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d,
+	0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x55, 0x6e,
+	0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x61,
+	0x65, 0x74, 0x69, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x4d, 0x65, 0x69, 0x6b, 0x77, 0x65, 0x69, 0x2f, 0x61, 0x65, 0x74, 0x69, 0x6d, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x6d, 0x73, 0x67,
+	0x65, 0x78, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_msgext_msgext_proto_rawDescOnce sync.Once
+	file_msgext_msgext_proto_rawDescData = file_msgext_msgext_proto_rawDesc
+)
+
+func file_msgext_msgext_proto_rawDescGZIP() []byte {
+	file_msgext_msgext_proto_rawDescOnce.Do(func() {
+		file_msgext_msgext_proto_rawDescData = protoimpl.X.CompressGZIP(file_msgext_msgext_proto_rawDescData)
+	})
+	return file_msgext_msgext_proto_rawDescData
+}
+
+var file_msgext_msgext_proto_msgTypes = make([]protoimpl.MessageInfo, 87)
+var file_msgext_msgext_proto_goTypes = []interface{}{
+	(*EditMsgReq)(nil),                 // 0: aetim.msgext.EditMsgReq
+	(*EditMsgResp)(nil),                // 1: aetim.msgext.EditMsgResp
+	(*MsgEditTips)(nil),                // 2: aetim.msgext.MsgEditTips
+	(*MsgEditRecord)(nil),              // 3: aetim.msgext.MsgEditRecord
+	(*GetMsgEditHistoryReq)(nil),       // 4: aetim.msgext.GetMsgEditHistoryReq
+	(*GetMsgEditHistoryResp)(nil),      // 5: aetim.msgext.GetMsgEditHistoryResp
+	(*ScheduledMsg)(nil),               // 6: aetim.msgext.ScheduledMsg
+	(*ScheduleMsgReq)(nil),             // 7: aetim.msgext.ScheduleMsgReq
+	(*ScheduleMsgResp)(nil),            // 8: aetim.msgext.ScheduleMsgResp
+	(*GetScheduledMsgsReq)(nil),        // 9: aetim.msgext.GetScheduledMsgsReq
+	(*GetScheduledMsgsResp)(nil),       // 10: aetim.msgext.GetScheduledMsgsResp
+	(*CancelScheduledMsgReq)(nil),      // 11: aetim.msgext.CancelScheduledMsgReq
+	(*CancelScheduledMsgResp)(nil),     // 12: aetim.msgext.CancelScheduledMsgResp
+	(*RescheduleMsgReq)(nil),           // 13: aetim.msgext.RescheduleMsgReq
+	(*RescheduleMsgResp)(nil),          // 14: aetim.msgext.RescheduleMsgResp
+	(*MsgReaction)(nil),                // 15: aetim.msgext.MsgReaction
+	(*AddMsgReactionReq)(nil),          // 16: aetim.msgext.AddMsgReactionReq
+	(*AddMsgReactionResp)(nil),         // 17: aetim.msgext.AddMsgReactionResp
+	(*RemoveMsgReactionReq)(nil),       // 18: aetim.msgext.RemoveMsgReactionReq
+	(*RemoveMsgReactionResp)(nil),      // 19: aetim.msgext.RemoveMsgReactionResp
+	(*GetMsgReactionsReq)(nil),         // 20: aetim.msgext.GetMsgReactionsReq
+	(*GetMsgReactionsResp)(nil),        // 21: aetim.msgext.GetMsgReactionsResp
+	(*MsgReactionTips)(nil),            // 22: aetim.msgext.MsgReactionTips
+	(*ThreadInfo)(nil),                 // 23: aetim.msgext.ThreadInfo
+	(*CreateThreadReq)(nil),            // 24: aetim.msgext.CreateThreadReq
+	(*CreateThreadResp)(nil),           // 25: aetim.msgext.CreateThreadResp
+	(*SendThreadMsgReq)(nil),           // 26: aetim.msgext.SendThreadMsgReq
+	(*SendThreadMsgResp)(nil),          // 27: aetim.msgext.SendThreadMsgResp
+	(*PullThreadMsgsReq)(nil),          // 28: aetim.msgext.PullThreadMsgsReq
+	(*PullThreadMsgsResp)(nil),         // 29: aetim.msgext.PullThreadMsgsResp
+	(*SubscribeThreadReq)(nil),         // 30: aetim.msgext.SubscribeThreadReq
+	(*SubscribeThreadResp)(nil),        // 31: aetim.msgext.SubscribeThreadResp
+	(*MarkThreadAsReadReq)(nil),        // 32: aetim.msgext.MarkThreadAsReadReq
+	(*MarkThreadAsReadResp)(nil),       // 33: aetim.msgext.MarkThreadAsReadResp
+	(*UserThread)(nil),                 // 34: aetim.msgext.UserThread
+	(*GetUserThreadsReq)(nil),          // 35: aetim.msgext.GetUserThreadsReq
+	(*GetUserThreadsResp)(nil),         // 36: aetim.msgext.GetUserThreadsResp
+	(*ThreadReplyTips)(nil),            // 37: aetim.msgext.ThreadReplyTips
+	(*PinnedMsg)(nil),                  // 38: aetim.msgext.PinnedMsg
+	(*PinMsgReq)(nil),                  // 39: aetim.msgext.PinMsgReq
+	(*PinMsgResp)(nil),                 // 40: aetim.msgext.PinMsgResp
+	(*UnpinMsgReq)(nil),                // 41: aetim.msgext.UnpinMsgReq
+	(*UnpinMsgResp)(nil),               // 42: aetim.msgext.UnpinMsgResp
+	(*GetPinnedMsgsReq)(nil),           // 43: aetim.msgext.GetPinnedMsgsReq
+	(*GetPinnedMsgsResp)(nil),          // 44: aetim.msgext.GetPinnedMsgsResp
+	(*MsgPinTips)(nil),                 // 45: aetim.msgext.MsgPinTips
+	(*SearchConversationMsgsReq)(nil),  // 46: aetim.msgext.SearchConversationMsgsReq
+	(*MsgHighlight)(nil),               // 47: aetim.msgext.MsgHighlight
+	(*SearchedMsg)(nil),                // 48: aetim.msgext.SearchedMsg
+	(*SearchConversationMsgsResp)(nil), // 49: aetim.msgext.SearchConversationMsgsResp
+	(*GroupMsgReader)(nil),             // 50: aetim.msgext.GroupMsgReader
+	(*GetGroupMsgReadersReq)(nil),      // 51: aetim.msgext.GetGroupMsgReadersReq
+	(*GetGroupMsgReadersResp)(nil),     // 52: aetim.msgext.GetGroupMsgReadersResp
+	(*GroupMsgReadTips)(nil),           // 53: aetim.msgext.GroupMsgReadTips
+	(*RetentionPolicy)(nil),            // 54: aetim.msgext.RetentionPolicy
+	(*SetRetentionPolicyReq)(nil),      // 55: aetim.msgext.SetRetentionPolicyReq
+	(*SetRetentionPolicyResp)(nil),     // 56: aetim.msgext.SetRetentionPolicyResp
+	(*GetRetentionPolicyReq)(nil),      // 57: aetim.msgext.GetRetentionPolicyReq
+	(*GetRetentionPolicyResp)(nil),     // 58: aetim.msgext.GetRetentionPolicyResp
+	(*CreateMsgExportJobReq)(nil),      // 59: aetim.msgext.CreateMsgExportJobReq
+	(*CreateMsgExportJobResp)(nil),     // 60: aetim.msgext.CreateMsgExportJobResp
+	(*MsgExportJob)(nil),               // 61: aetim.msgext.MsgExportJob
+	(*GetMsgExportJobReq)(nil),         // 62: aetim.msgext.GetMsgExportJobReq
+	(*GetMsgExportJobResp)(nil),        // 63: aetim.msgext.GetMsgExportJobResp
+	(*BroadcastTarget)(nil),            // 64: aetim.msgext.BroadcastTarget
+	(*CreateBroadcastJobReq)(nil),      // 65: aetim.msgext.CreateBroadcastJobReq
+	(*CreateBroadcastJobResp)(nil),     // 66: aetim.msgext.CreateBroadcastJobResp
+	(*BroadcastJob)(nil),               // 67: aetim.msgext.BroadcastJob
+	(*GetBroadcastJobReq)(nil),         // 68: aetim.msgext.GetBroadcastJobReq
+	(*GetBroadcastJobResp)(nil),        // 69: aetim.msgext.GetBroadcastJobResp
+	(*GetBroadcastJobsReq)(nil),        // 70: aetim.msgext.GetBroadcastJobsReq
+	(*GetBroadcastJobsResp)(nil),       // 71: aetim.msgext.GetBroadcastJobsResp
+	(*PauseBroadcastJobReq)(nil),       // 72: aetim.msgext.PauseBroadcastJobReq
+	(*PauseBroadcastJobResp)(nil),      // 73: aetim.msgext.PauseBroadcastJobResp
+	(*ResumeBroadcastJobReq)(nil),      // 74: aetim.msgext.ResumeBroadcastJobReq
+	(*ResumeBroadcastJobResp)(nil),     // 75: aetim.msgext.ResumeBroadcastJobResp
+	(*CancelBroadcastJobReq)(nil),      // 76: aetim.msgext.CancelBroadcastJobReq
+	(*CancelBroadcastJobResp)(nil),     // 77: aetim.msgext.CancelBroadcastJobResp
+	(*BroadcastFailure)(nil),           // 78: aetim.msgext.BroadcastFailure
+	(*GetBroadcastFailuresReq)(nil),    // 79: aetim.msgext.GetBroadcastFailuresReq
+	(*GetBroadcastFailuresResp)(nil),   // 80: aetim.msgext.GetBroadcastFailuresResp
+	(*GetUnreadCountReq)(nil),          // 81: aetim.msgext.GetUnreadCountReq
+	(*GetUnreadCountResp)(nil),         // 82: aetim.msgext.GetUnreadCountResp
+	(*GetUsersUnreadCountReq)(nil),     // 83: aetim.msgext.GetUsersUnreadCountReq
+	(*GetUsersUnreadCountResp)(nil),    // 84: aetim.msgext.GetUsersUnreadCountResp
+	nil,                                // 85: aetim.msgext.GetUnreadCountResp.ConversationUnreadsEntry
+	nil,                                // 86: aetim.msgext.GetUsersUnreadCountResp.TotalsEntry
+	(*sdkws.MsgData)(nil),              // 87: aetim.sdkws.MsgData
+	(*sdkws.RequestPagination)(nil),    // 88: aetim.sdkws.RequestPagination
+}
+var file_msgext_msgext_proto_depIdxs = []int32{
+	3,  // 0: aetim.msgext.GetMsgEditHistoryResp.records:type_name -> aetim.msgext.MsgEditRecord
+	87, // 1: aetim.msgext.ScheduledMsg.msgData:type_name -> aetim.sdkws.MsgData
+	87, // 2: aetim.msgext.ScheduleMsgReq.msgData:type_name -> aetim.sdkws.MsgData
+	88, // 3: aetim.msgext.GetScheduledMsgsReq.pagination:type_name -> aetim.sdkws.RequestPagination
+	6,  // 4: aetim.msgext.GetScheduledMsgsResp.msgs:type_name -> aetim.msgext.ScheduledMsg
+	15, // 5: aetim.msgext.AddMsgReactionResp.reactions:type_name -> aetim.msgext.MsgReaction
+	15, // 6: aetim.msgext.RemoveMsgReactionResp.reactions:type_name -> aetim.msgext.MsgReaction
+	15, // 7: aetim.msgext.GetMsgReactionsResp.reactions:type_name -> aetim.msgext.MsgReaction
+	23, // 8: aetim.msgext.CreateThreadResp.thread:type_name -> aetim.msgext.ThreadInfo
+	87, // 9: aetim.msgext.SendThreadMsgReq.msgData:type_name -> aetim.sdkws.MsgData
+	87, // 10: aetim.msgext.PullThreadMsgsResp.msgs:type_name -> aetim.sdkws.MsgData
+	23, // 11: aetim.msgext.UserThread.thread:type_name -> aetim.msgext.ThreadInfo
+	88, // 12: aetim.msgext.GetUserThreadsReq.pagination:type_name -> aetim.sdkws.RequestPagination
+	34, // 13: aetim.msgext.GetUserThreadsResp.threads:type_name -> aetim.msgext.UserThread
+	23, // 14: aetim.msgext.ThreadReplyTips.thread:type_name -> aetim.msgext.ThreadInfo
+	87, // 15: aetim.msgext.ThreadReplyTips.msgData:type_name -> aetim.sdkws.MsgData
+	87, // 16: aetim.msgext.PinnedMsg.msgData:type_name -> aetim.sdkws.MsgData
+	38, // 17: aetim.msgext.GetPinnedMsgsResp.pinnedMsgs:type_name -> aetim.msgext.PinnedMsg
+	88, // 18: aetim.msgext.SearchConversationMsgsReq.pagination:type_name -> aetim.sdkws.RequestPagination
+	87, // 19: aetim.msgext.SearchedMsg.msgData:type_name -> aetim.sdkws.MsgData
+	47, // 20: aetim.msgext.SearchedMsg.highlights:type_name -> aetim.msgext.MsgHighlight
+	48, // 21: aetim.msgext.SearchConversationMsgsResp.msgs:type_name -> aetim.msgext.SearchedMsg
+	50, // 22: aetim.msgext.GetGroupMsgReadersResp.readers:type_name -> aetim.msgext.GroupMsgReader
+	54, // 23: aetim.msgext.SetRetentionPolicyReq.policy:type_name -> aetim.msgext.RetentionPolicy
+	54, // 24: aetim.msgext.GetRetentionPolicyResp.conversation:type_name -> aetim.msgext.RetentionPolicy
+	54, // 25: aetim.msgext.GetRetentionPolicyResp.group:type_name -> aetim.msgext.RetentionPolicy
+	54, // 26: aetim.msgext.GetRetentionPolicyResp.effective:type_name -> aetim.msgext.RetentionPolicy
+	61, // 27: aetim.msgext.GetMsgExportJobResp.job:type_name -> aetim.msgext.MsgExportJob
+	87, // 28: aetim.msgext.CreateBroadcastJobReq.msgData:type_name -> aetim.sdkws.MsgData
+	64, // 29: aetim.msgext.CreateBroadcastJobReq.target:type_name -> aetim.msgext.BroadcastTarget
+	64, // 30: aetim.msgext.BroadcastJob.target:type_name -> aetim.msgext.BroadcastTarget
+	67, // 31: aetim.msgext.GetBroadcastJobResp.job:type_name -> aetim.msgext.BroadcastJob
+	88, // 32: aetim.msgext.GetBroadcastJobsReq.pagination:type_name -> aetim.sdkws.RequestPagination
+	67, // 33: aetim.msgext.GetBroadcastJobsResp.jobs:type_name -> aetim.msgext.BroadcastJob
+	88, // 34: aetim.msgext.GetBroadcastFailuresReq.pagination:type_name -> aetim.sdkws.RequestPagination
+	78, // 35: aetim.msgext.GetBroadcastFailuresResp.failures:type_name -> aetim.msgext.BroadcastFailure
+	85, // 36: aetim.msgext.GetUnreadCountResp.conversationUnreads:type_name -> aetim.msgext.GetUnreadCountResp.ConversationUnreadsEntry
+	86, // 37: aetim.msgext.GetUsersUnreadCountResp.totals:type_name -> aetim.msgext.GetUsersUnreadCountResp.TotalsEntry
+	0,  // 38: aetim.msgext.msgExt.EditMsg:input_type -> aetim.msgext.EditMsgReq
+	4,  // 39: aetim.msgext.msgExt.GetMsgEditHistory:input_type -> aetim.msgext.GetMsgEditHistoryReq
+	7,  // 40: aetim.msgext.msgExt.ScheduleMsg:input_type -> aetim.msgext.ScheduleMsgReq
+	9,  // 41: aetim.msgext.msgExt.GetScheduledMsgs:input_type -> aetim.msgext.GetScheduledMsgsReq
+	11, // 42: aetim.msgext.msgExt.CancelScheduledMsg:input_type -> aetim.msgext.CancelScheduledMsgReq
+	13, // 43: aetim.msgext.msgExt.RescheduleMsg:input_type -> aetim.msgext.RescheduleMsgReq
+	16, // 44: aetim.msgext.msgExt.AddMsgReaction:input_type -> aetim.msgext.AddMsgReactionReq
+	18, // 45: aetim.msgext.msgExt.RemoveMsgReaction:input_type -> aetim.msgext.RemoveMsgReactionReq
+	20, // 46: aetim.msgext.msgExt.GetMsgReactions:input_type -> aetim.msgext.GetMsgReactionsReq
+	24, // 47: aetim.msgext.msgExt.CreateThread:input_type -> aetim.msgext.CreateThreadReq
+	26, // 48: aetim.msgext.msgExt.SendThreadMsg:input_type -> aetim.msgext.SendThreadMsgReq
+	28, // 49: aetim.msgext.msgExt.PullThreadMsgs:input_type -> aetim.msgext.PullThreadMsgsReq
+	30, // 50: aetim.msgext.msgExt.SubscribeThread:input_type -> aetim.msgext.SubscribeThreadReq
+	32, // 51: aetim.msgext.msgExt.MarkThreadAsRead:input_type -> aetim.msgext.MarkThreadAsReadReq
+	35, // 52: aetim.msgext.msgExt.GetUserThreads:input_type -> aetim.msgext.GetUserThreadsReq
+	39, // 53: aetim.msgext.msgExt.PinMsg:input_type -> aetim.msgext.PinMsgReq
+	41, // 54: aetim.msgext.msgExt.UnpinMsg:input_type -> aetim.msgext.UnpinMsgReq
+	43, // 55: aetim.msgext.msgExt.GetPinnedMsgs:input_type -> aetim.msgext.GetPinnedMsgsReq
+	46, // 56: aetim.msgext.msgExt.SearchConversationMsgs:input_type -> aetim.msgext.SearchConversationMsgsReq
+	51, // 57: aetim.msgext.msgExt.GetGroupMsgReaders:input_type -> aetim.msgext.GetGroupMsgReadersReq
+	55, // 58: aetim.msgext.msgExt.SetRetentionPolicy:input_type -> aetim.msgext.SetRetentionPolicyReq
+	57, // 59: aetim.msgext.msgExt.GetRetentionPolicy:input_type -> aetim.msgext.GetRetentionPolicyReq
+	59, // 60: aetim.msgext.msgExt.CreateMsgExportJob:input_type -> aetim.msgext.CreateMsgExportJobReq
+	62, // 61: aetim.msgext.msgExt.GetMsgExportJob:input_type -> aetim.msgext.GetMsgExportJobReq
+	65, // 62: aetim.msgext.msgExt.CreateBroadcastJob:input_type -> aetim.msgext.CreateBroadcastJobReq
+	68, // 63: aetim.msgext.msgExt.GetBroadcastJob:input_type -> aetim.msgext.GetBroadcastJobReq
+	70, // 64: aetim.msgext.msgExt.GetBroadcastJobs:input_type -> aetim.msgext.GetBroadcastJobsReq
+	72, // 65: aetim.msgext.msgExt.PauseBroadcastJob:input_type -> aetim.msgext.PauseBroadcastJobReq
+	74, // 66: aetim.msgext.msgExt.ResumeBroadcastJob:input_type -> aetim.msgext.ResumeBroadcastJobReq
+	76, // 67: aetim.msgext.msgExt.CancelBroadcastJob:input_type -> aetim.msgext.CancelBroadcastJobReq
+	79, // 68: aetim.msgext.msgExt.GetBroadcastFailures:input_type -> aetim.msgext.GetBroadcastFailuresReq
+	81, // 69: aetim.msgext.msgExt.GetUnreadCount:input_type -> aetim.msgext.GetUnreadCountReq
+	83, // 70: aetim.msgext.msgExt.GetUsersUnreadCount:input_type -> aetim.msgext.GetUsersUnreadCountReq
+	1,  // 71: aetim.msgext.msgExt.EditMsg:output_type -> aetim.msgext.EditMsgResp
+	5,  // 72: aetim.msgext.msgExt.GetMsgEditHistory:output_type -> aetim.msgext.GetMsgEditHistoryResp
+	8,  // 73: aetim.msgext.msgExt.ScheduleMsg:output_type -> aetim.msgext.ScheduleMsgResp
+	10, // 74: aetim.msgext.msgExt.GetScheduledMsgs:output_type -> aetim.msgext.GetScheduledMsgsResp
+	12, // 75: aetim.msgext.msgExt.CancelScheduledMsg:output_type -> aetim.msgext.CancelScheduledMsgResp
+	14, // 76: aetim.msgext.msgExt.RescheduleMsg:output_type -> aetim.msgext.RescheduleMsgResp
+	17, // 77: aetim.msgext.msgExt.AddMsgReaction:output_type -> aetim.msgext.AddMsgReactionResp
+	19, // 78: aetim.msgext.msgExt.RemoveMsgReaction:output_type -> aetim.msgext.RemoveMsgReactionResp
+	21, // 79: aetim.msgext.msgExt.GetMsgReactions:output_type -> aetim.msgext.GetMsgReactionsResp
+	25, // 80: aetim.msgext.msgExt.CreateThread:output_type -> aetim.msgext.CreateThreadResp
+	27, // 81: aetim.msgext.msgExt.SendThreadMsg:output_type -> aetim.msgext.SendThreadMsgResp
+	29, // 82: aetim.msgext.msgExt.PullThreadMsgs:output_type -> aetim.msgext.PullThreadMsgsResp
+	31, // 83: aetim.msgext.msgExt.SubscribeThread:output_type -> aetim.msgext.SubscribeThreadResp
+	33, // 84: aetim.msgext.msgExt.MarkThreadAsRead:output_type -> aetim.msgext.MarkThreadAsReadResp
+	36, // 85: aetim.msgext.msgExt.GetUserThreads:output_type -> aetim.msgext.GetUserThreadsResp
+	40, // 86: aetim.msgext.msgExt.PinMsg:output_type -> aetim.msgext.PinMsgResp
+	42, // 87: aetim.msgext.msgExt.UnpinMsg:output_type -> aetim.msgext.UnpinMsgResp
+	44, // 88: aetim.msgext.msgExt.GetPinnedMsgs:output_type -> aetim.msgext.GetPinnedMsgsResp
+	49, // 89: aetim.msgext.msgExt.SearchConversationMsgs:output_type -> aetim.msgext.SearchConversationMsgsResp
+	52, // 90: aetim.msgext.msgExt.GetGroupMsgReaders:output_type -> aetim.msgext.GetGroupMsgReadersResp
+	56, // 91: aetim.msgext.msgExt.SetRetentionPolicy:output_type -> aetim.msgext.SetRetentionPolicyResp
+	58, // 92: aetim.msgext.msgExt.GetRetentionPolicy:output_type -> aetim.msgext.GetRetentionPolicyResp
+	60, // 93: aetim.msgext.msgExt.CreateMsgExportJob:output_type -> aetim.msgext.CreateMsgExportJobResp
+	63, // 94: aetim.msgext.msgExt.GetMsgExportJob:output_type -> aetim.msgext.GetMsgExportJobResp
+	66, // 95: aetim.msgext.msgExt.CreateBroadcastJob:output_type -> aetim.msgext.CreateBroadcastJobResp
+	69, // 96: aetim.msgext.msgExt.GetBroadcastJob:output_type -> aetim.msgext.GetBroadcastJobResp
+	71, // 97: aetim.msgext.msgExt.GetBroadcastJobs:output_type -> aetim.msgext.GetBroadcastJobsResp
+	73, // 98: aetim.msgext.msgExt.PauseBroadcastJob:output_type -> aetim.msgext.PauseBroadcastJobResp
+	75, // 99: aetim.msgext.msgExt.ResumeBroadcastJob:output_type -> aetim.msgext.ResumeBroadcastJobResp
+	77, // 100: aetim.msgext.msgExt.CancelBroadcastJob:output_type -> aetim.msgext.CancelBroadcastJobResp
+	80, // 101: aetim.msgext.msgExt.GetBroadcastFailures:output_type -> aetim.msgext.GetBroadcastFailuresResp
+	82, // 102: aetim.msgext.msgExt.GetUnreadCount:output_type -> aetim.msgext.GetUnreadCountResp
+	84, // 103: aetim.msgext.msgExt.GetUsersUnreadCount:output_type -> aetim.msgext.GetUsersUnreadCountResp
+	71, // [71:104] is the sub-list for method output_type
+	38, // [38:71] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
+}
+
+func init() { file_msgext_msgext_proto_init() }
+func file_msgext_msgext_proto_init() {
+	if File_msgext_msgext_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_msgext_msgext_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgEditTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgEditRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMsgEditHistoryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMsgEditHistoryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScheduledMsgsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScheduledMsgsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescheduleMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescheduleMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgReaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddMsgReactionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddMsgReactionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveMsgReactionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveMsgReactionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMsgReactionsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMsgReactionsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgReactionTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThreadInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateThreadReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateThreadResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendThreadMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendThreadMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullThreadMsgsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullThreadMsgsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeThreadReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeThreadResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkThreadAsReadReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkThreadAsReadResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserThread); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserThreadsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserThreadsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThreadReplyTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinnedMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpinMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpinMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPinnedMsgsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPinnedMsgsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgPinTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchConversationMsgsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgHighlight); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchedMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchConversationMsgsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupMsgReader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupMsgReadersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupMsgReadersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupMsgReadTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetentionPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRetentionPolicyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRetentionPolicyResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRetentionPolicyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRetentionPolicyResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMsgExportJobReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMsgExportJobResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgExportJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMsgExportJobReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMsgExportJobResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastTarget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBroadcastJobReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBroadcastJobResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBroadcastJobReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBroadcastJobResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBroadcastJobsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBroadcastJobsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseBroadcastJobReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseBroadcastJobResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeBroadcastJobReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeBroadcastJobResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelBroadcastJobReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelBroadcastJobResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastFailure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBroadcastFailuresReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBroadcastFailuresResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUnreadCountReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUnreadCountResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsersUnreadCountReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsersUnreadCountResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_msgext_msgext_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   87,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_msgext_msgext_proto_goTypes,
+		DependencyIndexes: file_msgext_msgext_proto_depIdxs,
+		MessageInfos:      file_msgext_msgext_proto_msgTypes,
+	}.Build()
+	File_msgext_msgext_proto = out.File
+	file_msgext_msgext_proto_rawDesc = nil
+	file_msgext_msgext_proto_goTypes = nil
+	file_msgext_msgext_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// MsgExtClient is the client API for MsgExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgExtClient interface {
+	EditMsg(ctx context.Context, in *EditMsgReq, opts ...grpc.CallOption) (*EditMsgResp, error)
+	GetMsgEditHistory(ctx context.Context, in *GetMsgEditHistoryReq, opts ...grpc.CallOption) (*GetMsgEditHistoryResp, error)
+	ScheduleMsg(ctx context.Context, in *ScheduleMsgReq, opts ...grpc.CallOption) (*ScheduleMsgResp, error)
+	GetScheduledMsgs(ctx context.Context, in *GetScheduledMsgsReq, opts ...grpc.CallOption) (*GetScheduledMsgsResp, error)
+	CancelScheduledMsg(ctx context.Context, in *CancelScheduledMsgReq, opts ...grpc.CallOption) (*CancelScheduledMsgResp, error)
+	RescheduleMsg(ctx context.Context, in *RescheduleMsgReq, opts ...grpc.CallOption) (*RescheduleMsgResp, error)
+	AddMsgReaction(ctx context.Context, in *AddMsgReactionReq, opts ...grpc.CallOption) (*AddMsgReactionResp, error)
+	RemoveMsgReaction(ctx context.Context, in *RemoveMsgReactionReq, opts ...grpc.CallOption) (*RemoveMsgReactionResp, error)
+	GetMsgReactions(ctx context.Context, in *GetMsgReactionsReq, opts ...grpc.CallOption) (*GetMsgReactionsResp, error)
+	CreateThread(ctx context.Context, in *CreateThreadReq, opts ...grpc.CallOption) (*CreateThreadResp, error)
+	SendThreadMsg(ctx context.Context, in *SendThreadMsgReq, opts ...grpc.CallOption) (*SendThreadMsgResp, error)
+	PullThreadMsgs(ctx context.Context, in *PullThreadMsgsReq, opts ...grpc.CallOption) (*PullThreadMsgsResp, error)
+	SubscribeThread(ctx context.Context, in *SubscribeThreadReq, opts ...grpc.CallOption) (*SubscribeThreadResp, error)
+	MarkThreadAsRead(ctx context.Context, in *MarkThreadAsReadReq, opts ...grpc.CallOption) (*MarkThreadAsReadResp, error)
+	GetUserThreads(ctx context.Context, in *GetUserThreadsReq, opts ...grpc.CallOption) (*GetUserThreadsResp, error)
+	PinMsg(ctx context.Context, in *PinMsgReq, opts ...grpc.CallOption) (*PinMsgResp, error)
+	UnpinMsg(ctx context.Context, in *UnpinMsgReq, opts ...grpc.CallOption) (*UnpinMsgResp, error)
+	GetPinnedMsgs(ctx context.Context, in *GetPinnedMsgsReq, opts ...grpc.CallOption) (*GetPinnedMsgsResp, error)
+	SearchConversationMsgs(ctx context.Context, in *SearchConversationMsgsReq, opts ...grpc.CallOption) (*SearchConversationMsgsResp, error)
+	GetGroupMsgReaders(ctx context.Context, in *GetGroupMsgReadersReq, opts ...grpc.CallOption) (*GetGroupMsgReadersResp, error)
+	SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyReq, opts ...grpc.CallOption) (*SetRetentionPolicyResp, error)
+	GetRetentionPolicy(ctx context.Context, in *GetRetentionPolicyReq, opts ...grpc.CallOption) (*GetRetentionPolicyResp, error)
+	CreateMsgExportJob(ctx context.Context, in *CreateMsgExportJobReq, opts ...grpc.CallOption) (*CreateMsgExportJobResp, error)
+	GetMsgExportJob(ctx context.Context, in *GetMsgExportJobReq, opts ...grpc.CallOption) (*GetMsgExportJobResp, error)
+	CreateBroadcastJob(ctx context.Context, in *CreateBroadcastJobReq, opts ...grpc.CallOption) (*CreateBroadcastJobResp, error)
+	GetBroadcastJob(ctx context.Context, in *GetBroadcastJobReq, opts ...grpc.CallOption) (*GetBroadcastJobResp, error)
+	GetBroadcastJobs(ctx context.Context, in *GetBroadcastJobsReq, opts ...grpc.CallOption) (*GetBroadcastJobsResp, error)
+	PauseBroadcastJob(ctx context.Context, in *PauseBroadcastJobReq, opts ...grpc.CallOption) (*PauseBroadcastJobResp, error)
+	ResumeBroadcastJob(ctx context.Context, in *ResumeBroadcastJobReq, opts ...grpc.CallOption) (*ResumeBroadcastJobResp, error)
+	CancelBroadcastJob(ctx context.Context, in *CancelBroadcastJobReq, opts ...grpc.CallOption) (*CancelBroadcastJobResp, error)
+	GetBroadcastFailures(ctx context.Context, in *GetBroadcastFailuresReq, opts ...grpc.CallOption) (*GetBroadcastFailuresResp, error)
+	GetUnreadCount(ctx context.Context, in *GetUnreadCountReq, opts ...grpc.CallOption) (*GetUnreadCountResp, error)
+	GetUsersUnreadCount(ctx context.Context, in *GetUsersUnreadCountReq, opts ...grpc.CallOption) (*GetUsersUnreadCountResp, error)
+}
+
+type msgExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgExtClient(cc grpc.ClientConnInterface) MsgExtClient {
+	return &msgExtClient{cc}
+}
+
+func (c *msgExtClient) EditMsg(ctx context.Context, in *EditMsgReq, opts ...grpc.CallOption) (*EditMsgResp, error) {
+	out := new(EditMsgResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/EditMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetMsgEditHistory(ctx context.Context, in *GetMsgEditHistoryReq, opts ...grpc.CallOption) (*GetMsgEditHistoryResp, error) {
+	out := new(GetMsgEditHistoryResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/GetMsgEditHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) ScheduleMsg(ctx context.Context, in *ScheduleMsgReq, opts ...grpc.CallOption) (*ScheduleMsgResp, error) {
+	out := new(ScheduleMsgResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/ScheduleMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetScheduledMsgs(ctx context.Context, in *GetScheduledMsgsReq, opts ...grpc.CallOption) (*GetScheduledMsgsResp, error) {
+	out := new(GetScheduledMsgsResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/GetScheduledMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) CancelScheduledMsg(ctx context.Context, in *CancelScheduledMsgReq, opts ...grpc.CallOption) (*CancelScheduledMsgResp, error) {
+	out := new(CancelScheduledMsgResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/CancelScheduledMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) RescheduleMsg(ctx context.Context, in *RescheduleMsgReq, opts ...grpc.CallOption) (*RescheduleMsgResp, error) {
+	out := new(RescheduleMsgResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/RescheduleMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) AddMsgReaction(ctx context.Context, in *AddMsgReactionReq, opts ...grpc.CallOption) (*AddMsgReactionResp, error) {
+	out := new(AddMsgReactionResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/AddMsgReaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) RemoveMsgReaction(ctx context.Context, in *RemoveMsgReactionReq, opts ...grpc.CallOption) (*RemoveMsgReactionResp, error) {
+	out := new(RemoveMsgReactionResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/RemoveMsgReaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetMsgReactions(ctx context.Context, in *GetMsgReactionsReq, opts ...grpc.CallOption) (*GetMsgReactionsResp, error) {
+	out := new(GetMsgReactionsResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/GetMsgReactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) CreateThread(ctx context.Context, in *CreateThreadReq, opts ...grpc.CallOption) (*CreateThreadResp, error) {
+	out := new(CreateThreadResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/CreateThread", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) SendThreadMsg(ctx context.Context, in *SendThreadMsgReq, opts ...grpc.CallOption) (*SendThreadMsgResp, error) {
+	out := new(SendThreadMsgResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/SendThreadMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) PullThreadMsgs(ctx context.Context, in *PullThreadMsgsReq, opts ...grpc.CallOption) (*PullThreadMsgsResp, error) {
+	out := new(PullThreadMsgsResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/PullThreadMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) SubscribeThread(ctx context.Context, in *SubscribeThreadReq, opts ...grpc.CallOption) (*SubscribeThreadResp, error) {
+	out := new(SubscribeThreadResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/SubscribeThread", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) MarkThreadAsRead(ctx context.Context, in *MarkThreadAsReadReq, opts ...grpc.CallOption) (*MarkThreadAsReadResp, error) {
+	out := new(MarkThreadAsReadResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/MarkThreadAsRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetUserThreads(ctx context.Context, in *GetUserThreadsReq, opts ...grpc.CallOption) (*GetUserThreadsResp, error) {
+	out := new(GetUserThreadsResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/GetUserThreads", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) PinMsg(ctx context.Context, in *PinMsgReq, opts ...grpc.CallOption) (*PinMsgResp, error) {
+	out := new(PinMsgResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/PinMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) UnpinMsg(ctx context.Context, in *UnpinMsgReq, opts ...grpc.CallOption) (*UnpinMsgResp, error) {
+	out := new(UnpinMsgResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/UnpinMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetPinnedMsgs(ctx context.Context, in *GetPinnedMsgsReq, opts ...grpc.CallOption) (*GetPinnedMsgsResp, error) {
+	out := new(GetPinnedMsgsResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/GetPinnedMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) SearchConversationMsgs(ctx context.Context, in *SearchConversationMsgsReq, opts ...grpc.CallOption) (*SearchConversationMsgsResp, error) {
+	out := new(SearchConversationMsgsResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/SearchConversationMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetGroupMsgReaders(ctx context.Context, in *GetGroupMsgReadersReq, opts ...grpc.CallOption) (*GetGroupMsgReadersResp, error) {
+	out := new(GetGroupMsgReadersResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/GetGroupMsgReaders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyReq, opts ...grpc.CallOption) (*SetRetentionPolicyResp, error) {
+	out := new(SetRetentionPolicyResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/SetRetentionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetRetentionPolicy(ctx context.Context, in *GetRetentionPolicyReq, opts ...grpc.CallOption) (*GetRetentionPolicyResp, error) {
+	out := new(GetRetentionPolicyResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/GetRetentionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) CreateMsgExportJob(ctx context.Context, in *CreateMsgExportJobReq, opts ...grpc.CallOption) (*CreateMsgExportJobResp, error) {
+	out := new(CreateMsgExportJobResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/CreateMsgExportJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetMsgExportJob(ctx context.Context, in *GetMsgExportJobReq, opts ...grpc.CallOption) (*GetMsgExportJobResp, error) {
+	out := new(GetMsgExportJobResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/GetMsgExportJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) CreateBroadcastJob(ctx context.Context, in *CreateBroadcastJobReq, opts ...grpc.CallOption) (*CreateBroadcastJobResp, error) {
+	out := new(CreateBroadcastJobResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/CreateBroadcastJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetBroadcastJob(ctx context.Context, in *GetBroadcastJobReq, opts ...grpc.CallOption) (*GetBroadcastJobResp, error) {
+	out := new(GetBroadcastJobResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/GetBroadcastJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetBroadcastJobs(ctx context.Context, in *GetBroadcastJobsReq, opts ...grpc.CallOption) (*GetBroadcastJobsResp, error) {
+	out := new(GetBroadcastJobsResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/GetBroadcastJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) PauseBroadcastJob(ctx context.Context, in *PauseBroadcastJobReq, opts ...grpc.CallOption) (*PauseBroadcastJobResp, error) {
+	out := new(PauseBroadcastJobResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/PauseBroadcastJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) ResumeBroadcastJob(ctx context.Context, in *ResumeBroadcastJobReq, opts ...grpc.CallOption) (*ResumeBroadcastJobResp, error) {
+	out := new(ResumeBroadcastJobResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/ResumeBroadcastJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) CancelBroadcastJob(ctx context.Context, in *CancelBroadcastJobReq, opts ...grpc.CallOption) (*CancelBroadcastJobResp, error) {
+	out := new(CancelBroadcastJobResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/CancelBroadcastJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetBroadcastFailures(ctx context.Context, in *GetBroadcastFailuresReq, opts ...grpc.CallOption) (*GetBroadcastFailuresResp, error) {
+	out := new(GetBroadcastFailuresResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/GetBroadcastFailures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetUnreadCount(ctx context.Context, in *GetUnreadCountReq, opts ...grpc.CallOption) (*GetUnreadCountResp, error) {
+	out := new(GetUnreadCountResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/GetUnreadCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetUsersUnreadCount(ctx context.Context, in *GetUsersUnreadCountReq, opts ...grpc.CallOption) (*GetUsersUnreadCountResp, error) {
+	out := new(GetUsersUnreadCountResp)
+	err := c.cc.Invoke(ctx, "/aetim.msgext.msgExt/GetUsersUnreadCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgExtServer is the server API for MsgExt service.
+type MsgExtServer interface {
+	EditMsg(context.Context, *EditMsgReq) (*EditMsgResp, error)
+	GetMsgEditHistory(context.Context, *GetMsgEditHistoryReq) (*GetMsgEditHistoryResp, error)
+	ScheduleMsg(context.Context, *ScheduleMsgReq) (*ScheduleMsgResp, error)
+	GetScheduledMsgs(context.Context, *GetScheduledMsgsReq) (*GetScheduledMsgsResp, error)
+	CancelScheduledMsg(context.Context, *CancelScheduledMsgReq) (*CancelScheduledMsgResp, error)
+	RescheduleMsg(context.Context, *RescheduleMsgReq) (*RescheduleMsgResp, error)
+	AddMsgReaction(context.Context, *AddMsgReactionReq) (*AddMsgReactionResp, error)
+	RemoveMsgReaction(context.Context, *RemoveMsgReactionReq) (*RemoveMsgReactionResp, error)
+	GetMsgReactions(context.Context, *GetMsgReactionsReq) (*GetMsgReactionsResp, error)
+	CreateThread(context.Context, *CreateThreadReq) (*CreateThreadResp, error)
+	SendThreadMsg(context.Context, *SendThreadMsgReq) (*SendThreadMsgResp, error)
+	PullThreadMsgs(context.Context, *PullThreadMsgsReq) (*PullThreadMsgsResp, error)
+	SubscribeThread(context.Context, *SubscribeThreadReq) (*SubscribeThreadResp, error)
+	MarkThreadAsRead(context.Context, *MarkThreadAsReadReq) (*MarkThreadAsReadResp, error)
+	GetUserThreads(context.Context, *GetUserThreadsReq) (*GetUserThreadsResp, error)
+	PinMsg(context.Context, *PinMsgReq) (*PinMsgResp, error)
+	UnpinMsg(context.Context, *UnpinMsgReq) (*UnpinMsgResp, error)
+	GetPinnedMsgs(context.Context, *GetPinnedMsgsReq) (*GetPinnedMsgsResp, error)
+	SearchConversationMsgs(context.Context, *SearchConversationMsgsReq) (*SearchConversationMsgsResp, error)
+	GetGroupMsgReaders(context.Context, *GetGroupMsgReadersReq) (*GetGroupMsgReadersResp, error)
+	SetRetentionPolicy(context.Context, *SetRetentionPolicyReq) (*SetRetentionPolicyResp, error)
+	GetRetentionPolicy(context.Context, *GetRetentionPolicyReq) (*GetRetentionPolicyResp, error)
+	CreateMsgExportJob(context.Context, *CreateMsgExportJobReq) (*CreateMsgExportJobResp, error)
+	GetMsgExportJob(context.Context, *GetMsgExportJobReq) (*GetMsgExportJobResp, error)
+	CreateBroadcastJob(context.Context, *CreateBroadcastJobReq) (*CreateBroadcastJobResp, error)
+	GetBroadcastJob(context.Context, *GetBroadcastJobReq) (*GetBroadcastJobResp, error)
+	GetBroadcastJobs(context.Context, *GetBroadcastJobsReq) (*GetBroadcastJobsResp, error)
+	PauseBroadcastJob(context.Context, *PauseBroadcastJobReq) (*PauseBroadcastJobResp, error)
+	ResumeBroadcastJob(context.Context, *ResumeBroadcastJobReq) (*ResumeBroadcastJobResp, error)
+	CancelBroadcastJob(context.Context, *CancelBroadcastJobReq) (*CancelBroadcastJobResp, error)
+	GetBroadcastFailures(context.Context, *GetBroadcastFailuresReq) (*GetBroadcastFailuresResp, error)
+	GetUnreadCount(context.Context, *GetUnreadCountReq) (*GetUnreadCountResp, error)
+	GetUsersUnreadCount(context.Context, *GetUsersUnreadCountReq) (*GetUsersUnreadCountResp, error)
+}
+
+// UnimplementedMsgExtServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgExtServer struct {
+}
+
+func (*UnimplementedMsgExtServer) EditMsg(context.Context, *EditMsgReq) (*EditMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditMsg not implemented")
+}
+func (*UnimplementedMsgExtServer) GetMsgEditHistory(context.Context, *GetMsgEditHistoryReq) (*GetMsgEditHistoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMsgEditHistory not implemented")
+}
+func (*UnimplementedMsgExtServer) ScheduleMsg(context.Context, *ScheduleMsgReq) (*ScheduleMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMsg not implemented")
+}
+func (*UnimplementedMsgExtServer) GetScheduledMsgs(context.Context, *GetScheduledMsgsReq) (*GetScheduledMsgsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledMsgs not implemented")
+}
+func (*UnimplementedMsgExtServer) CancelScheduledMsg(context.Context, *CancelScheduledMsgReq) (*CancelScheduledMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMsg not implemented")
+}
+func (*UnimplementedMsgExtServer) RescheduleMsg(context.Context, *RescheduleMsgReq) (*RescheduleMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleMsg not implemented")
+}
+func (*UnimplementedMsgExtServer) AddMsgReaction(context.Context, *AddMsgReactionReq) (*AddMsgReactionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMsgReaction not implemented")
+}
+func (*UnimplementedMsgExtServer) RemoveMsgReaction(context.Context, *RemoveMsgReactionReq) (*RemoveMsgReactionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMsgReaction not implemented")
+}
+func (*UnimplementedMsgExtServer) GetMsgReactions(context.Context, *GetMsgReactionsReq) (*GetMsgReactionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMsgReactions not implemented")
+}
+func (*UnimplementedMsgExtServer) CreateThread(context.Context, *CreateThreadReq) (*CreateThreadResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateThread not implemented")
+}
+func (*UnimplementedMsgExtServer) SendThreadMsg(context.Context, *SendThreadMsgReq) (*SendThreadMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendThreadMsg not implemented")
+}
+func (*UnimplementedMsgExtServer) PullThreadMsgs(context.Context, *PullThreadMsgsReq) (*PullThreadMsgsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullThreadMsgs not implemented")
+}
+func (*UnimplementedMsgExtServer) SubscribeThread(context.Context, *SubscribeThreadReq) (*SubscribeThreadResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeThread not implemented")
+}
+func (*UnimplementedMsgExtServer) MarkThreadAsRead(context.Context, *MarkThreadAsReadReq) (*MarkThreadAsReadResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkThreadAsRead not implemented")
+}
+func (*UnimplementedMsgExtServer) GetUserThreads(context.Context, *GetUserThreadsReq) (*GetUserThreadsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserThreads not implemented")
+}
+func (*UnimplementedMsgExtServer) PinMsg(context.Context, *PinMsgReq) (*PinMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinMsg not implemented")
+}
+func (*UnimplementedMsgExtServer) UnpinMsg(context.Context, *UnpinMsgReq) (*UnpinMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinMsg not implemented")
+}
+func (*UnimplementedMsgExtServer) GetPinnedMsgs(context.Context, *GetPinnedMsgsReq) (*GetPinnedMsgsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPinnedMsgs not implemented")
+}
+func (*UnimplementedMsgExtServer) SearchConversationMsgs(context.Context, *SearchConversationMsgsReq) (*SearchConversationMsgsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchConversationMsgs not implemented")
+}
+func (*UnimplementedMsgExtServer) GetGroupMsgReaders(context.Context, *GetGroupMsgReadersReq) (*GetGroupMsgReadersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupMsgReaders not implemented")
+}
+func (*UnimplementedMsgExtServer) SetRetentionPolicy(context.Context, *SetRetentionPolicyReq) (*SetRetentionPolicyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRetentionPolicy not implemented")
+}
+func (*UnimplementedMsgExtServer) GetRetentionPolicy(context.Context, *GetRetentionPolicyReq) (*GetRetentionPolicyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRetentionPolicy not implemented")
+}
+func (*UnimplementedMsgExtServer) CreateMsgExportJob(context.Context, *CreateMsgExportJobReq) (*CreateMsgExportJobResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMsgExportJob not implemented")
+}
+func (*UnimplementedMsgExtServer) GetMsgExportJob(context.Context, *GetMsgExportJobReq) (*GetMsgExportJobResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMsgExportJob not implemented")
+}
+func (*UnimplementedMsgExtServer) CreateBroadcastJob(context.Context, *CreateBroadcastJobReq) (*CreateBroadcastJobResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBroadcastJob not implemented")
+}
+func (*UnimplementedMsgExtServer) GetBroadcastJob(context.Context, *GetBroadcastJobReq) (*GetBroadcastJobResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBroadcastJob not implemented")
+}
+func (*UnimplementedMsgExtServer) GetBroadcastJobs(context.Context, *GetBroadcastJobsReq) (*GetBroadcastJobsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBroadcastJobs not implemented")
+}
+func (*UnimplementedMsgExtServer) PauseBroadcastJob(context.Context, *PauseBroadcastJobReq) (*PauseBroadcastJobResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseBroadcastJob not implemented")
+}
+func (*UnimplementedMsgExtServer) ResumeBroadcastJob(context.Context, *ResumeBroadcastJobReq) (*ResumeBroadcastJobResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeBroadcastJob not implemented")
+}
+func (*UnimplementedMsgExtServer) CancelBroadcastJob(context.Context, *CancelBroadcastJobReq) (*CancelBroadcastJobResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBroadcastJob not implemented")
+}
+func (*UnimplementedMsgExtServer) GetBroadcastFailures(context.Context, *GetBroadcastFailuresReq) (*GetBroadcastFailuresResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBroadcastFailures not implemented")
+}
+func (*UnimplementedMsgExtServer) GetUnreadCount(context.Context, *GetUnreadCountReq) (*GetUnreadCountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadCount not implemented")
+}
+func (*UnimplementedMsgExtServer) GetUsersUnreadCount(context.Context, *GetUsersUnreadCountReq) (*GetUsersUnreadCountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersUnreadCount not implemented")
+}
+
+func RegisterMsgExtServer(s *grpc.Server, srv MsgExtServer) {
+	s.RegisterService(&_MsgExt_serviceDesc, srv)
+}
+
+func _MsgExt_EditMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).EditMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/EditMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).EditMsg(ctx, req.(*EditMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetMsgEditHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMsgEditHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetMsgEditHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/GetMsgEditHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetMsgEditHistory(ctx, req.(*GetMsgEditHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_ScheduleMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).ScheduleMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/ScheduleMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).ScheduleMsg(ctx, req.(*ScheduleMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetScheduledMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetScheduledMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/GetScheduledMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetScheduledMsgs(ctx, req.(*GetScheduledMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_CancelScheduledMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).CancelScheduledMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/CancelScheduledMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).CancelScheduledMsg(ctx, req.(*CancelScheduledMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_RescheduleMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).RescheduleMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/RescheduleMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).RescheduleMsg(ctx, req.(*RescheduleMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_AddMsgReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMsgReactionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).AddMsgReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/AddMsgReaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).AddMsgReaction(ctx, req.(*AddMsgReactionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_RemoveMsgReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMsgReactionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).RemoveMsgReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/RemoveMsgReaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).RemoveMsgReaction(ctx, req.(*RemoveMsgReactionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetMsgReactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMsgReactionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetMsgReactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/GetMsgReactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetMsgReactions(ctx, req.(*GetMsgReactionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_CreateThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateThreadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).CreateThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/CreateThread",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).CreateThread(ctx, req.(*CreateThreadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_SendThreadMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendThreadMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).SendThreadMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/SendThreadMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).SendThreadMsg(ctx, req.(*SendThreadMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_PullThreadMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullThreadMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).PullThreadMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/PullThreadMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).PullThreadMsgs(ctx, req.(*PullThreadMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_SubscribeThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeThreadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).SubscribeThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/SubscribeThread",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).SubscribeThread(ctx, req.(*SubscribeThreadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_MarkThreadAsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkThreadAsReadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).MarkThreadAsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/MarkThreadAsRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).MarkThreadAsRead(ctx, req.(*MarkThreadAsReadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetUserThreads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserThreadsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetUserThreads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/GetUserThreads",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetUserThreads(ctx, req.(*GetUserThreadsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_PinMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).PinMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/PinMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).PinMsg(ctx, req.(*PinMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_UnpinMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).UnpinMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/UnpinMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).UnpinMsg(ctx, req.(*UnpinMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetPinnedMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPinnedMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetPinnedMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/GetPinnedMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetPinnedMsgs(ctx, req.(*GetPinnedMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_SearchConversationMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchConversationMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).SearchConversationMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/SearchConversationMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).SearchConversationMsgs(ctx, req.(*SearchConversationMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetGroupMsgReaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupMsgReadersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetGroupMsgReaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/GetGroupMsgReaders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetGroupMsgReaders(ctx, req.(*GetGroupMsgReadersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_SetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRetentionPolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).SetRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/SetRetentionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).SetRetentionPolicy(ctx, req.(*SetRetentionPolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRetentionPolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/GetRetentionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetRetentionPolicy(ctx, req.(*GetRetentionPolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_CreateMsgExportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMsgExportJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).CreateMsgExportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/CreateMsgExportJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).CreateMsgExportJob(ctx, req.(*CreateMsgExportJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetMsgExportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMsgExportJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetMsgExportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/GetMsgExportJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetMsgExportJob(ctx, req.(*GetMsgExportJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_CreateBroadcastJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBroadcastJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).CreateBroadcastJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/CreateBroadcastJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).CreateBroadcastJob(ctx, req.(*CreateBroadcastJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetBroadcastJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBroadcastJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetBroadcastJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/GetBroadcastJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetBroadcastJob(ctx, req.(*GetBroadcastJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetBroadcastJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBroadcastJobsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetBroadcastJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/GetBroadcastJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetBroadcastJobs(ctx, req.(*GetBroadcastJobsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_PauseBroadcastJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseBroadcastJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).PauseBroadcastJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/PauseBroadcastJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).PauseBroadcastJob(ctx, req.(*PauseBroadcastJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_ResumeBroadcastJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeBroadcastJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).ResumeBroadcastJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/ResumeBroadcastJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).ResumeBroadcastJob(ctx, req.(*ResumeBroadcastJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_CancelBroadcastJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBroadcastJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).CancelBroadcastJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/CancelBroadcastJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).CancelBroadcastJob(ctx, req.(*CancelBroadcastJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetBroadcastFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBroadcastFailuresReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetBroadcastFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/GetBroadcastFailures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetBroadcastFailures(ctx, req.(*GetBroadcastFailuresReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetUnreadCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadCountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetUnreadCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/GetUnreadCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetUnreadCount(ctx, req.(*GetUnreadCountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetUsersUnreadCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersUnreadCountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetUsersUnreadCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aetim.msgext.msgExt/GetUsersUnreadCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetUsersUnreadCount(ctx, req.(*GetUsersUnreadCountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MsgExt_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "aetim.msgext.msgExt",
+	HandlerType: (*MsgExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EditMsg",
+			Handler:    _MsgExt_EditMsg_Handler,
+		},
+		{
+			MethodName: "GetMsgEditHistory",
+			Handler:    _MsgExt_GetMsgEditHistory_Handler,
+		},
+		{
+			MethodName: "ScheduleMsg",
+			Handler:    _MsgExt_ScheduleMsg_Handler,
+		},
+		{
+			MethodName: "GetScheduledMsgs",
+			Handler:    _MsgExt_GetScheduledMsgs_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMsg",
+			Handler:    _MsgExt_CancelScheduledMsg_Handler,
+		},
+		{
+			MethodName: "RescheduleMsg",
+			Handler:    _MsgExt_RescheduleMsg_Handler,
+		},
+		{
+			MethodName: "AddMsgReaction",
+			Handler:    _MsgExt_AddMsgReaction_Handler,
+		},
+		{
+			MethodName: "RemoveMsgReaction",
+			Handler:    _MsgExt_RemoveMsgReaction_Handler,
+		},
+		{
+			MethodName: "GetMsgReactions",
+			Handler:    _MsgExt_GetMsgReactions_Handler,
+		},
+		{
+			MethodName: "CreateThread",
+			Handler:    _MsgExt_CreateThread_Handler,
+		},
+		{
+			MethodName: "SendThreadMsg",
+			Handler:    _MsgExt_SendThreadMsg_Handler,
+		},
+		{
+			MethodName: "PullThreadMsgs",
+			Handler:    _MsgExt_PullThreadMsgs_Handler,
+		},
+		{
+			MethodName: "SubscribeThread",
+			Handler:    _MsgExt_SubscribeThread_Handler,
+		},
+		{
+			MethodName: "MarkThreadAsRead",
+			Handler:    _MsgExt_MarkThreadAsRead_Handler,
+		},
+		{
+			MethodName: "GetUserThreads",
+			Handler:    _MsgExt_GetUserThreads_Handler,
+		},
+		{
+			MethodName: "PinMsg",
+			Handler:    _MsgExt_PinMsg_Handler,
+		},
+		{
+			MethodName: "UnpinMsg",
+			Handler:    _MsgExt_UnpinMsg_Handler,
+		},
+		{
+			MethodName: "GetPinnedMsgs",
+			Handler:    _MsgExt_GetPinnedMsgs_Handler,
+		},
+		{
+			MethodName: "SearchConversationMsgs",
+			Handler:    _MsgExt_SearchConversationMsgs_Handler,
+		},
+		{
+			MethodName: "GetGroupMsgReaders",
+			Handler:    _MsgExt_GetGroupMsgReaders_Handler,
+		},
+		{
+			MethodName: "SetRetentionPolicy",
+			Handler:    _MsgExt_SetRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "GetRetentionPolicy",
+			Handler:    _MsgExt_GetRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "CreateMsgExportJob",
+			Handler:    _MsgExt_CreateMsgExportJob_Handler,
+		},
+		{
+			MethodName: "GetMsgExportJob",
+			Handler:    _MsgExt_GetMsgExportJob_Handler,
+		},
+		{
+			MethodName: "CreateBroadcastJob",
+			Handler:    _MsgExt_CreateBroadcastJob_Handler,
+		},
+		{
+			MethodName: "GetBroadcastJob",
+			Handler:    _MsgExt_GetBroadcastJob_Handler,
+		},
+		{
+			MethodName: "GetBroadcastJobs",
+			Handler:    _MsgExt_GetBroadcastJobs_Handler,
+		},
+		{
+			MethodName: "PauseBroadcastJob",
+			Handler:    _MsgExt_PauseBroadcastJob_Handler,
+		},
+		{
+			MethodName: "ResumeBroadcastJob",
+			Handler:    _MsgExt_ResumeBroadcastJob_Handler,
+		},
+		{
+			MethodName: "CancelBroadcastJob",
+			Handler:    _MsgExt_CancelBroadcastJob_Handler,
+		},
+		{
+			MethodName: "GetBroadcastFailures",
+			Handler:    _MsgExt_GetBroadcastFailures_Handler,
+		},
+		{
+			MethodName: "GetUnreadCount",
+			Handler:    _MsgExt_GetUnreadCount_Handler,
+		},
+		{
+			MethodName: "GetUsersUnreadCount",
+			Handler:    _MsgExt_GetUsersUnreadCount_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "msgext/msgext.proto",
+}
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"github.com/Meikwei/aetim/pkg/common/config"
+	"github.com/Meikwei/aetim/pkg/protocol/msgext"
 	"github.com/Meikwei/go-tools/discovery"
 	"github.com/Meikwei/go-tools/log"
 	"github.com/Meikwei/go-tools/mcontext"
@@ -30,6 +31,7 @@ import (
 	"github.com/Meikwei/go-tools/utils/timeutil"
 	"github.com/Meikwei/protocol/constant"
 	"github.com/Meikwei/protocol/msg"
+	"github.com/Meikwei/protocol/rtc"
 	"github.com/Meikwei/protocol/sdkws"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
@@ -145,9 +147,11 @@ func newSessionTypeConf() map[int32]int32 {
 
 // Message 表示一个消息结构体，包含了与消息服务相关的gRPC连接和客户端
 type Message struct {
-	conn   grpc.ClientConnInterface // gRPC连接接口
-	Client msg.MsgClient            // 消息服务的gRPC客户端
-	discov discovery.SvcDiscoveryRegistry // 服务发现注册接口，用于获取gRPC连接
+	conn      grpc.ClientConnInterface       // gRPC连接接口
+	Client    msg.MsgClient                  // 消息服务的gRPC客户端
+	RtcClient rtc.RtcServiceClient           // 音视频信令服务的gRPC客户端，与消息服务共用连接
+	ExtClient msgext.MsgExtClient            // 消息扩展服务的gRPC客户端，与消息服务共用连接
+	discov    discovery.SvcDiscoveryRegistry // 服务发现注册接口，用于获取gRPC连接
 }
 
 // NewMessage 创建一个新的Message实例。
@@ -163,7 +167,8 @@ func NewMessage(discov discovery.SvcDiscoveryRegistry, rpcRegisterName string) *
 	}
 	// 根据获取的连接创建消息服务的gRPC客户端
 	client := msg.NewMsgClient(conn)
-	return &Message{discov: discov, conn: conn, Client: client}
+	return &Message{discov: discov, conn: conn, Client: client, RtcClient: rtc.NewRtcServiceClient(conn),
+		ExtClient: msgext.NewMsgExtClient(conn)}
 }
 
 // MessageRpcClient 是Message的一个别名，用于创建RPC客户端
@@ -188,6 +193,16 @@ func (m *MessageRpcClient) SendMsg(ctx context.Context, req *msg.SendMsgReq) (*m
 	return resp, nil
 }
 
+// DeleteMsgs deletes messages of a conversation for a user, or for everyone when IsSyncOther is set.
+func (m *MessageRpcClient) DeleteMsgs(ctx context.Context, req *msg.DeleteMsgsReq) (*msg.DeleteMsgsResp, error) {
+	return m.Client.DeleteMsgs(ctx, req)
+}
+
+// SignalMessageAssemble forwards a call signal to the signaling service hosted by the msg RPC.
+func (m *MessageRpcClient) SignalMessageAssemble(ctx context.Context, req *rtc.SignalMessageAssembleReq) (*rtc.SignalMessageAssembleResp, error) {
+	return m.RtcClient.SignalMessageAssemble(ctx, req)
+}
+
 // GetMaxSeq retrieves the maximum sequence number from the gRPC client.
 // Errors during the gRPC call are wrapped to provide additional context.
 func (m *MessageRpcClient) GetMaxSeq(ctx context.Context, req *sdkws.GetMaxSeqReq) (*sdkws.GetMaxSeqResp, error) {
@@ -19,6 +19,11 @@ func GenGroupConversationID(groupID string) string {
 	return "sg_" + groupID
 }
 
+// GetGroupIDByConversationID returns the group ID of a group conversation ID.
+func GetGroupIDByConversationID(conversationID string) (string, bool) {
+	return strings.CutPrefix(conversationID, "sg_")
+}
+
 func GenConversationUniqueKeyForSingle(sendID, recvID string) string {
 	l := []string{sendID, recvID}
 	sort.Strings(l)
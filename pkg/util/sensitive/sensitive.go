@@ -0,0 +1,253 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sensitive matches sensitive words in message text with an Aho-Corasick automaton.
+package sensitive
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"sync/atomic"
+	"unicode"
+
+	"github.com/Meikwei/go-tools/errs"
+)
+
+// Policy is what happens to a message containing a sensitive word. A larger policy is stricter.
+type Policy int32
+
+const (
+	PolicyNone Policy = iota
+	// PolicyMask replaces the matched words with MaskRune and delivers the message.
+	PolicyMask
+	// PolicySenderOnly accepts the message but does not deliver it to anyone but the sender.
+	PolicySenderOnly
+	// PolicyReject refuses the message.
+	PolicyReject
+)
+
+// MaskRune replaces each rune of a masked word.
+const MaskRune = '*'
+
+var policyNames = map[string]Policy{
+	"mask":       PolicyMask,
+	"senderOnly": PolicySenderOnly,
+	"reject":     PolicyReject,
+}
+
+// ParsePolicy parses the policy names used in the config, word files and Mongo.
+func ParsePolicy(name string) (Policy, error) {
+	policy, ok := policyNames[name]
+	if !ok {
+		return PolicyNone, errs.ErrArgs.WrapMsg("unknown sensitive word policy", "policy", name)
+	}
+	return policy, nil
+}
+
+func (p Policy) String() string {
+	for name, policy := range policyNames {
+		if policy == p {
+			return name
+		}
+	}
+	return "none"
+}
+
+// Rule is a sensitive word and its policy.
+type Rule struct {
+	Word   string
+	Policy Policy
+}
+
+// Hit is a matched word, Start and End are rune offsets of the text, End exclusive.
+type Hit struct {
+	Word   string
+	Policy Policy
+	Start  int
+	End    int
+}
+
+// Result is the outcome of checking a text.
+type Result struct {
+	Policy Policy // the strictest policy of the hits
+	Hits   []Hit
+	Masked string // the text with the words of mask hits masked, set when Policy is PolicyMask
+}
+
+// ParseRules reads one rule per line in the form "word" or "word|policy". Empty lines and lines starting
+// with # are skipped, words without a policy get defaultPolicy.
+func ParseRules(r io.Reader, defaultPolicy Policy) ([]Rule, error) {
+	var rules []Rule
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		rule := Rule{Word: line, Policy: defaultPolicy}
+		if i := strings.LastIndexByte(line, '|'); i >= 0 {
+			policy, err := ParsePolicy(strings.TrimSpace(line[i+1:]))
+			if err != nil {
+				return nil, err
+			}
+			rule = Rule{Word: strings.TrimSpace(line[:i]), Policy: policy}
+		}
+		if rule.Word != "" {
+			rules = append(rules, rule)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errs.WrapMsg(err, "read sensitive words")
+	}
+	return rules, nil
+}
+
+type node struct {
+	children map[rune]int
+	fail     int
+	// output lists the rules ending at this node, including those reached through fail links
+	output []int
+	depth  int
+}
+
+// Matcher is an immutable Aho-Corasick automaton over lowercased runes.
+type Matcher struct {
+	nodes []node
+	rules []Rule
+}
+
+// NewMatcher builds a matcher. A word listed more than once keeps its strictest policy.
+func NewMatcher(rules []Rule) *Matcher {
+	m := &Matcher{nodes: []node{{children: map[rune]int{}}}}
+	wordIndex := make(map[string]int)
+	for _, rule := range rules {
+		word := strings.Map(unicode.ToLower, strings.TrimSpace(rule.Word))
+		if word == "" {
+			continue
+		}
+		if i, ok := wordIndex[word]; ok {
+			if rule.Policy > m.rules[i].Policy {
+				m.rules[i].Policy = rule.Policy
+			}
+			continue
+		}
+		wordIndex[word] = len(m.rules)
+		m.rules = append(m.rules, Rule{Word: word, Policy: rule.Policy})
+		cur := 0
+		for _, r := range word {
+			next, ok := m.nodes[cur].children[r]
+			if !ok {
+				next = len(m.nodes)
+				m.nodes = append(m.nodes, node{children: map[rune]int{}, depth: m.nodes[cur].depth + 1})
+				m.nodes[cur].children[r] = next
+			}
+			cur = next
+		}
+		m.nodes[cur].output = append(m.nodes[cur].output, wordIndex[word])
+	}
+	// breadth first so that the fail target of a node is complete before the node
+	queue := make([]int, 0, len(m.nodes))
+	for _, child := range m.nodes[0].children {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[cur].children {
+			fail := m.nodes[cur].fail
+			for fail > 0 {
+				if _, ok := m.nodes[fail].children[r]; ok {
+					break
+				}
+				fail = m.nodes[fail].fail
+			}
+			if next, ok := m.nodes[fail].children[r]; ok && next != child {
+				m.nodes[child].fail = next
+			}
+			m.nodes[child].output = append(m.nodes[child].output, m.nodes[m.nodes[child].fail].output...)
+			queue = append(queue, child)
+		}
+	}
+	return m
+}
+
+// Len returns the number of distinct words.
+func (m *Matcher) Len() int {
+	return len(m.rules)
+}
+
+// Check matches text against all words, case-insensitively.
+func (m *Matcher) Check(text string) *Result {
+	res := &Result{}
+	if len(m.rules) == 0 || text == "" {
+		return res
+	}
+	runes := []rune(text)
+	cur := 0
+	for i, r := range runes {
+		r = unicode.ToLower(r)
+		for cur > 0 {
+			if _, ok := m.nodes[cur].children[r]; ok {
+				break
+			}
+			cur = m.nodes[cur].fail
+		}
+		if next, ok := m.nodes[cur].children[r]; ok {
+			cur = next
+		}
+		for _, ruleIndex := range m.nodes[cur].output {
+			rule := m.rules[ruleIndex]
+			length := len([]rune(rule.Word))
+			res.Hits = append(res.Hits, Hit{Word: rule.Word, Policy: rule.Policy, Start: i + 1 - length, End: i + 1})
+			if rule.Policy > res.Policy {
+				res.Policy = rule.Policy
+			}
+		}
+	}
+	if res.Policy == PolicyMask {
+		for _, hit := range res.Hits {
+			for i := hit.Start; i < hit.End; i++ {
+				runes[i] = MaskRune
+			}
+		}
+		res.Masked = string(runes)
+	}
+	return res
+}
+
+// Filter holds the current matcher and allows replacing it while messages are being checked.
+type Filter struct {
+	matcher atomic.Pointer[Matcher]
+}
+
+func NewFilter() *Filter {
+	f := &Filter{}
+	f.matcher.Store(NewMatcher(nil))
+	return f
+}
+
+// Reload replaces the words of the filter.
+func (f *Filter) Reload(rules []Rule) {
+	f.matcher.Store(NewMatcher(rules))
+}
+
+// Len returns the number of distinct words currently loaded.
+func (f *Filter) Len() int {
+	return f.matcher.Load().Len()
+}
+
+func (f *Filter) Check(text string) *Result {
+	return f.matcher.Load().Check(text)
+}
@@ -0,0 +1,78 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensitive
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMatcherCheck(t *testing.T) {
+	m := NewMatcher([]Rule{
+		{Word: "he", Policy: PolicyMask},
+		{Word: "she", Policy: PolicyMask},
+		{Word: "hers", Policy: PolicyMask},
+		{Word: "坏人", Policy: PolicyMask},
+	})
+	res := m.Check("uSHErs是坏人")
+	if res.Policy != PolicyMask {
+		t.Fatalf("Policy = %v, want mask", res.Policy)
+	}
+	var words []string
+	for _, hit := range res.Hits {
+		words = append(words, hit.Word)
+	}
+	if want := []string{"she", "he", "hers", "坏人"}; !reflect.DeepEqual(words, want) {
+		t.Errorf("hits = %v, want %v", words, want)
+	}
+	if want := "u*****是**"; res.Masked != want {
+		t.Errorf("Masked = %q, want %q", res.Masked, want)
+	}
+}
+
+func TestMatcherStrictestPolicy(t *testing.T) {
+	m := NewMatcher([]Rule{
+		{Word: "spam", Policy: PolicyMask},
+		{Word: "scam", Policy: PolicySenderOnly},
+		{Word: "SPAM", Policy: PolicyReject},
+	})
+	if got := m.Len(); got != 2 {
+		t.Errorf("Len() = %d, want 2", got)
+	}
+	if got := m.Check("no scam").Policy; got != PolicySenderOnly {
+		t.Errorf("Check(scam).Policy = %v", got)
+	}
+	if got := m.Check("spam and scam").Policy; got != PolicyReject {
+		t.Errorf("Check(spam and scam).Policy = %v", got)
+	}
+	if res := m.Check("clean"); res.Policy != PolicyNone || len(res.Hits) != 0 {
+		t.Errorf("Check(clean) = %+v", res)
+	}
+}
+
+func TestParseRules(t *testing.T) {
+	rules, err := ParseRules(strings.NewReader("# comment\nfoo\n\nbar | reject\nbaz|senderOnly\n"), PolicyMask)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Rule{{Word: "foo", Policy: PolicyMask}, {Word: "bar", Policy: PolicyReject}, {Word: "baz", Policy: PolicySenderOnly}}
+	if !reflect.DeepEqual(rules, want) {
+		t.Errorf("ParseRules() = %v, want %v", rules, want)
+	}
+	if _, err := ParseRules(strings.NewReader("foo|drop"), PolicyMask); err == nil {
+		t.Error("ParseRules() with unknown policy should fail")
+	}
+}